@@ -15,9 +15,46 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/v1/customer": {
+        "/v1/category": {
+            "get": {
+                "description": "List the categories in the order the menu shows them",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Categories"
+                ],
+                "summary": "List Categories",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Only the active categories, the ones the menu shows",
+                        "name": "active",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/domain.Category"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {}
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            },
             "post": {
-                "description": "Insert Customer",
+                "description": "Insert a category of the menu. Categories are active unless told otherwise",
                 "consumes": [
                     "application/json"
                 ],
@@ -25,30 +62,41 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Customers"
+                    "Categories"
                 ],
-                "summary": "Insert Customer",
+                "summary": "Insert Category",
                 "parameters": [
                     {
-                        "description": "teste",
-                        "name": "CustomerToInsert",
+                        "description": "Category to insert",
+                        "name": "Category",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/CustomerDto"
+                            "$ref": "#/definitions/CategoryDto"
                         }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Key that makes retries return the original category instead of creating a new one",
+                        "name": "Idempotency-Key",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/domain.Customer"
-                            }
+                            "$ref": "#/definitions/domain.Category"
                         }
                     },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {}
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {}
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {}
@@ -56,9 +104,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/customer/cpf/{cpf}": {
-            "get": {
-                "description": "Retrieve a customer by their CPF",
+        "/v1/category/{id}": {
+            "put": {
+                "description": "Update a category. A new name is given to its items, combo slots and promotions too",
                 "consumes": [
                     "application/json"
                 ],
@@ -66,25 +114,85 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Customers"
+                    "Categories"
                 ],
-                "summary": "Get Customer by CPF",
+                "summary": "Update Category",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "CPF of the customer",
-                        "name": "cpf",
+                        "type": "integer",
+                        "description": "ID da categoria",
+                        "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Category to update",
+                        "name": "Category",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/CategoryDto"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/domain.Customer"
+                            "$ref": "#/definitions/domain.Category"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {}
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {}
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {}
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a category without items",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Categories"
+                ],
+                "summary": "Delete Category",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID da categoria",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "category deleted successfully",
+                        "schema": {
+                            "type": "string"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {}
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {}
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {}
@@ -92,24 +200,24 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/item": {
+        "/v1/combo": {
             "get": {
-                "description": "List All Items",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "List every combo with its slots and the items allowed in each one",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Items"
+                    "Combos"
                 ],
-                "summary": "List Items",
+                "summary": "List Combos",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/domain.Item"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/domain.Combo"
+                            }
                         }
                     },
                     "500": {
@@ -119,7 +227,7 @@ const docTemplate = `{
                 }
             },
             "post": {
-                "description": "Insert Item",
+                "description": "Insert a combo. Each slot lists the items of its category the customer may choose",
                 "consumes": [
                     "application/json"
                 ],
@@ -127,30 +235,37 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Items"
+                    "Combos"
                 ],
-                "summary": "Insert Item",
+                "summary": "Insert Combo",
                 "parameters": [
                     {
-                        "description": "teste",
-                        "name": "ItemToInsert",
+                        "description": "Combo to insert",
+                        "name": "Combo",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/ItemDto"
+                            "$ref": "#/definitions/ComboDto"
                         }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Key that makes retries return the original combo instead of creating a new one",
+                        "name": "Idempotency-Key",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/domain.Item"
-                            }
+                            "$ref": "#/definitions/domain.Combo"
                         }
                     },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {}
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {}
@@ -158,9 +273,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/item/{id}": {
+        "/v1/combo/{id}": {
             "put": {
-                "description": "Update Item",
+                "description": "Update a combo, replacing its slots",
                 "consumes": [
                     "application/json"
                 ],
@@ -168,24 +283,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Items"
+                    "Combos"
                 ],
-                "summary": "Update Item",
+                "summary": "Update Combo",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "ID do item",
+                        "description": "ID do combo",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "teste",
-                        "name": "ItemToInsert",
+                        "description": "Combo to update",
+                        "name": "Combo",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/ItemDto"
+                            "$ref": "#/definitions/ComboDto"
                         }
                     }
                 ],
@@ -193,12 +308,17 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/domain.Item"
-                            }
+                            "$ref": "#/definitions/domain.Combo"
                         }
                     },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {}
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {}
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {}
@@ -206,21 +326,18 @@ const docTemplate = `{
                 }
             },
             "delete": {
-                "description": "Delete Item",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Delete Combo",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Items"
+                    "Combos"
                 ],
-                "summary": "Delete Item",
+                "summary": "Delete Combo",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "ID do item",
+                        "description": "ID do combo",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -228,11 +345,15 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "item deleted successfully",
+                        "description": "combo deleted successfully",
                         "schema": {
                             "type": "string"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {}
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {}
@@ -240,9 +361,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/orders": {
-            "get": {
-                "description": "List All Orders",
+        "/v1/customer": {
+            "post": {
+                "description": "Insert Customer",
                 "consumes": [
                     "application/json"
                 ],
@@ -250,14 +371,34 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Orders"
+                    "Customers"
+                ],
+                "summary": "Insert Customer",
+                "parameters": [
+                    {
+                        "description": "teste",
+                        "name": "CustomerToInsert",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/CustomerDto"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Key that makes retries return the original customer instead of creating a new one",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
                 ],
-                "summary": "List Orders",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/domain.Order"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/domain.Customer"
+                            }
                         }
                     },
                     "500": {
@@ -267,9 +408,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/orders/checkout": {
-            "post": {
-                "description": "Insert Order",
+        "/v1/customer/cpf/{cpf}": {
+            "get": {
+                "description": "Retrieve a customer by their CPF",
                 "consumes": [
                     "application/json"
                 ],
@@ -277,28 +418,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Orders"
+                    "Customers"
                 ],
-                "summary": "Insert Order",
+                "summary": "Get Customer by CPF",
                 "parameters": [
                     {
-                        "description": "Order to create",
-                        "name": "Order",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/OrderDto"
-                        }
+                        "type": "string",
+                        "description": "CPF of the customer",
+                        "name": "cpf",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/presenters.OrderPresenter"
-                            }
+                            "$ref": "#/definitions/domain.Customer"
                         }
                     },
                     "500": {
@@ -308,197 +444,2921 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/orders/{id}": {
-            "patch": {
-                "description": "Update Order Status",
-                "consumes": [
-                    "application/json"
-                ],
+        "/v1/customer/{id}/orders": {
+            "get": {
+                "description": "Every order the customer placed at the store with its lines, newest first",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Orders"
+                    "Customers"
                 ],
-                "summary": "Update Order Status",
+                "summary": "List Customer Orders",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "ID do item",
+                        "description": "ID do cliente",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Status to update Order",
-                        "name": "Order",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/OrderStatusDto"
-                        }
+                        "type": "integer",
+                        "description": "Store of the request, the default store when missing",
+                        "name": "X-Store-ID",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/domain.Order"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/domain.Order"
+                            }
                         }
                     },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {}
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {}
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {}
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "CustomerDto": {
-            "type": "object",
-            "properties": {
-                "cpf": {
-                    "type": "string"
-                },
-                "email": {
-                    "type": "string"
-                },
-                "id": {
-                    "type": "integer"
-                },
-                "name": {
-                    "type": "string"
-                }
-            }
         },
-        "ItemDto": {
-            "type": "object",
-            "properties": {
-                "category": {
-                    "type": "string"
-                },
+        "/v1/ingredient": {
+            "get": {
+                "description": "List every ingredient with its stock at the store",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Ingredients"
+                ],
+                "summary": "List Ingredients",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Store of the request, the default store when missing",
+                        "name": "X-Store-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/domain.Ingredient"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            },
+            "post": {
+                "description": "Insert an ingredient with its unit of measure and its stock at the store",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Ingredients"
+                ],
+                "summary": "Insert Ingredient",
+                "parameters": [
+                    {
+                        "description": "Ingredient to insert",
+                        "name": "Ingredient",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/IngredientDto"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Key that makes retries return the original ingredient instead of creating a new one",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Store of the request, the default store when missing",
+                        "name": "X-Store-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.Ingredient"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {}
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            }
+        },
+        "/v1/ingredient/low-stock": {
+            "get": {
+                "description": "List the ingredients below their low stock threshold at the store, the closest to running out first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Ingredients"
+                ],
+                "summary": "Low Stock Report",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Store of the request, the default store when missing",
+                        "name": "X-Store-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/domain.Ingredient"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            }
+        },
+        "/v1/ingredient/{id}": {
+            "put": {
+                "description": "Update an ingredient. The stock given replaces the one stored at the store, as after counting it",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Ingredients"
+                ],
+                "summary": "Update Ingredient",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID do ingrediente",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Ingredient to update",
+                        "name": "Ingredient",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/IngredientDto"
+                        }
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Store of the request, the default store when missing",
+                        "name": "X-Store-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.Ingredient"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {}
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {}
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete an ingredient, taking it out of the recipes that use it",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Ingredients"
+                ],
+                "summary": "Delete Ingredient",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID do ingrediente",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Store of the request, the default store when missing",
+                        "name": "X-Store-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "ingredient deleted successfully",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {}
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            }
+        },
+        "/v1/item": {
+            "get": {
+                "description": "List the items on sale now at the store, priced by the menus of the store open now",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Items"
+                ],
+                "summary": "List Items",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Category of the items",
+                        "name": "category",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Also list the items paused or out of their menus, for the admin",
+                        "name": "include_off_sale",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Store of the request, the default store when missing",
+                        "name": "X-Store-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.Item"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {}
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            },
+            "post": {
+                "description": "Insert Item",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Items"
+                ],
+                "summary": "Insert Item",
+                "parameters": [
+                    {
+                        "description": "teste",
+                        "name": "ItemToInsert",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/ItemDto"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Key that makes retries return the original item instead of creating a new one",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Store of the request, the default store when missing",
+                        "name": "X-Store-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/domain.Item"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            }
+        },
+        "/v1/item/{id}": {
+            "put": {
+                "description": "Update Item",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Items"
+                ],
+                "summary": "Update Item",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID do item",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "teste",
+                        "name": "ItemToInsert",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/ItemDto"
+                        }
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Store of the request, the default store when missing",
+                        "name": "X-Store-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/domain.Item"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete Item",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Items"
+                ],
+                "summary": "Delete Item",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID do item",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Store of the request, the default store when missing",
+                        "name": "X-Store-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "item deleted successfully",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            }
+        },
+        "/v1/item/{id}/availability": {
+            "patch": {
+                "description": "Pause an item, taking it off sale without deleting it, or make it available again",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Items"
+                ],
+                "summary": "Set Item Availability",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID do item",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Availability of the item",
+                        "name": "Availability",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/ItemAvailabilityDto"
+                        }
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Store of the request, the default store when missing",
+                        "name": "X-Store-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.Item"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {}
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {}
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            }
+        },
+        "/v1/menu": {
+            "get": {
+                "description": "List every menu of the store with its time window and items",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Menus"
+                ],
+                "summary": "List Menus",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Store of the request, the default store when missing",
+                        "name": "X-Store-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/domain.Menu"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            },
+            "post": {
+                "description": "Insert a menu of the store open in a window of the day. Items of exclusive menus are only sold while one of them is open, and menu prices replace the item prices while the menu is open",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Menus"
+                ],
+                "summary": "Insert Menu",
+                "parameters": [
+                    {
+                        "description": "Menu to insert",
+                        "name": "Menu",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/MenuDto"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Key that makes retries return the original menu instead of creating a new one",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Store of the request, the default store when missing",
+                        "name": "X-Store-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.Menu"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {}
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            }
+        },
+        "/v1/menu/{id}": {
+            "put": {
+                "description": "Update a menu, replacing its items",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Menus"
+                ],
+                "summary": "Update Menu",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID do menu",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Menu to update",
+                        "name": "Menu",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/MenuDto"
+                        }
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Store of the request, the default store when missing",
+                        "name": "X-Store-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.Menu"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {}
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {}
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete Menu",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Menus"
+                ],
+                "summary": "Delete Menu",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID do menu",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Store of the request, the default store when missing",
+                        "name": "X-Store-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "menu deleted successfully",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {}
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            }
+        },
+        "/v1/orders": {
+            "get": {
+                "description": "List the orders of the store in the kitchen board ordering. Without status only the paid orders still on the kitchen board are listed. When there are more orders, the X-Next-Cursor header holds the cursor of the next page",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Orders"
+                ],
+                "summary": "List Orders",
+                "parameters": [
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Statuses to list, repeated or comma separated",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Customer ID",
+                        "name": "customer_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Created at or after, as a date (YYYY-MM-DD) or a RFC 3339 timestamp",
+                        "name": "created_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Created before, as a RFC 3339 timestamp, or up to the end of the date (YYYY-MM-DD)",
+                        "name": "created_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Pickup code",
+                        "name": "pickup_code",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "X-Next-Cursor of the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size, at most 100. 20 by default, except for the kitchen board without other filters, which is listed whole",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Store of the request, the default store when missing",
+                        "name": "X-Store-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/domain.Order"
+                            }
+                        },
+                        "headers": {
+                            "X-Next-Cursor": {
+                                "type": "string",
+                                "description": "Cursor of the next page, missing on the last page"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {}
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            }
+        },
+        "/v1/orders/checkout": {
+            "post": {
+                "description": "Insert Order. While the store is closed the order is refused with 409 and the next opening of the store",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Orders"
+                ],
+                "summary": "Insert Order",
+                "parameters": [
+                    {
+                        "description": "Order to create",
+                        "name": "Order",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/OrderDto"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Key that makes retries return the original order instead of creating a new one",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Store of the request, the default store when missing",
+                        "name": "X-Store-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/presenters.OrderPresenter"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {}
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {}
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            }
+        },
+        "/v1/orders/stream": {
+            "get": {
+                "description": "Server-Sent Events for the kitchen board: an orders.snapshot event with the current orders, then an order.created event when the payment of an order of the store is approved and an event for every later change, on any replica",
+                "produces": [
+                    "text/event-stream"
+                ],
+                "tags": [
+                    "Orders"
+                ],
+                "summary": "Stream Orders",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Store of the request, the default store when missing",
+                        "name": "X-Store-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.OrderEvent"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            }
+        },
+        "/v1/orders/{code}/tracking": {
+            "get": {
+                "description": "Current status of an order, how many orders the kitchen still has to prepare before it and when it should be ready",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Orders"
+                ],
+                "summary": "Track Order",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tracking code printed on the receipt",
+                        "name": "code",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Store of the request, the default store when missing",
+                        "name": "X-Store-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenters.OrderTrackingPresenter"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {}
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            }
+        },
+        "/v1/orders/{id}": {
+            "patch": {
+                "description": "Update Order Status",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Orders"
+                ],
+                "summary": "Update Order Status",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID do item",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Status to update Order",
+                        "name": "Order",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/OrderStatusDto"
+                        }
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Store of the request, the default store when missing",
+                        "name": "X-Store-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.Order"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {}
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {}
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            }
+        },
+        "/v1/orders/{id}/cancel": {
+            "post": {
+                "description": "Cancel an order that is not ready yet and request the payment reversal",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Orders"
+                ],
+                "summary": "Cancel Order",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID do pedido",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Who canceled the order and why",
+                        "name": "Order",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/OrderCancelDto"
+                        }
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Store of the request, the default store when missing",
+                        "name": "X-Store-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.Order"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {}
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {}
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {}
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            }
+        },
+        "/v1/orders/{id}/payment-status": {
+            "post": {
+                "description": "Callback used by the payment service, for the orders of every store, to report an approved (APROVADO), rejected (RECUSADO) or expired (EXPIRADO) payment. Orders with a rejected or expired payment are canceled",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Orders"
+                ],
+                "summary": "Update Order Payment Status",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID do pedido",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Payment result",
+                        "name": "Order",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/OrderPaymentStatusDto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.Order"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {}
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {}
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {}
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            }
+        },
+        "/v1/orders/{id}/reorder": {
+            "post": {
+                "description": "Check out a new order with the items of an earlier one. Items that are no longer available or out of stock are left out and listed in dropped_items",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Orders"
+                ],
+                "summary": "Reorder",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID do pedido",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Key that makes retries return the original order instead of creating a new one",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Store of the request, the default store when missing",
+                        "name": "X-Store-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenters.ReorderPresenter"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {}
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {}
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {}
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            }
+        },
+        "/v1/orders/{id}/timeline": {
+            "get": {
+                "description": "Every status an order went through, oldest first, with who changed it and when",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Orders"
+                ],
+                "summary": "Order Timeline",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID do pedido",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Store of the request, the default store when missing",
+                        "name": "X-Store-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/domain.OrderStatusHistory"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {}
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {}
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            }
+        },
+        "/v1/promotion": {
+            "get": {
+                "description": "List every promotion and coupon of the store with how many times it was used",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Promotions"
+                ],
+                "summary": "List Promotions",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Store of the request, the default store when missing",
+                        "name": "X-Store-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/domain.Promotion"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            },
+            "post": {
+                "description": "Insert a promotion. Promotions with a code are coupons, the others apply to every order they fit",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Promotions"
+                ],
+                "summary": "Insert Promotion",
+                "parameters": [
+                    {
+                        "description": "Promotion to insert",
+                        "name": "Promotion",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/PromotionDto"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Key that makes retries return the original promotion instead of creating a new one",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Store of the request, the default store when missing",
+                        "name": "X-Store-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.Promotion"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {}
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {}
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            }
+        },
+        "/v1/promotion/{id}": {
+            "put": {
+                "description": "Update a promotion. The uses it already had are kept",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Promotions"
+                ],
+                "summary": "Update Promotion",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID da promoção",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Promotion to update",
+                        "name": "Promotion",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/PromotionDto"
+                        }
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Store of the request, the default store when missing",
+                        "name": "X-Store-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.Promotion"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {}
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {}
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {}
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete Promotion",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Promotions"
+                ],
+                "summary": "Delete Promotion",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID da promoção",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Store of the request, the default store when missing",
+                        "name": "X-Store-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "promotion deleted successfully",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {}
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            }
+        },
+        "/v1/store/calendar": {
+            "get": {
+                "description": "List the calendar days from today on, such as holidays, which close the store or change its hours on the date",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Store"
+                ],
+                "summary": "List Store Calendar",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Store of the request, the default store when missing",
+                        "name": "X-Store-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/domain.StoreCalendarDay"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            },
+            "post": {
+                "description": "Close the store on a date, or open it on other hours than the weekly ones",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Store"
+                ],
+                "summary": "Insert Store Calendar Day",
+                "parameters": [
+                    {
+                        "description": "Calendar day to insert",
+                        "name": "Day",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/StoreCalendarDayDto"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Key that makes retries return the original calendar day instead of creating a new one",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Store of the request, the default store when missing",
+                        "name": "X-Store-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.StoreCalendarDay"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {}
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {}
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            }
+        },
+        "/v1/store/calendar/{id}": {
+            "put": {
+                "description": "Update a calendar day of the store",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Store"
+                ],
+                "summary": "Update Store Calendar Day",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID do dia",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Calendar day to update",
+                        "name": "Day",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/StoreCalendarDayDto"
+                        }
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Store of the request, the default store when missing",
+                        "name": "X-Store-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.StoreCalendarDay"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {}
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {}
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {}
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a calendar day, so the weekly hours apply on the date again",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Store"
+                ],
+                "summary": "Delete Store Calendar Day",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID do dia",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Store of the request, the default store when missing",
+                        "name": "X-Store-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "store calendar day deleted successfully",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {}
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            }
+        },
+        "/v1/store/hours": {
+            "get": {
+                "description": "List the opening hours of the store by day of the week, from Sunday (0) to Saturday (6). Without hours the store is open all day, every day",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Store"
+                ],
+                "summary": "List Store Hours",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Store of the request, the default store when missing",
+                        "name": "X-Store-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/domain.StoreHours"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            },
+            "put": {
+                "description": "Replace the opening hours of the whole week. Days of the week left out are closed",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Store"
+                ],
+                "summary": "Update Store Hours",
+                "parameters": [
+                    {
+                        "description": "Hours of the week",
+                        "name": "Hours",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/StoreHoursDto"
+                        }
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Store of the request, the default store when missing",
+                        "name": "X-Store-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/domain.StoreHours"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {}
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            }
+        },
+        "/v1/store/status": {
+            "get": {
+                "description": "Tell if the store takes orders now and, while closed, when it opens again",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Store"
+                ],
+                "summary": "Store Status",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Store of the request, the default store when missing",
+                        "name": "X-Store-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.StoreStatus"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            }
+        },
+        "/v1/stores": {
+            "get": {
+                "description": "List the stores of the franchise",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Store"
+                ],
+                "summary": "List Stores",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/domain.Store"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            },
+            "post": {
+                "description": "Open a new store of the franchise. Its orders take the store time zone and pickup code prefix",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Store"
+                ],
+                "summary": "Insert Store",
+                "parameters": [
+                    {
+                        "description": "Store to insert",
+                        "name": "Store",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/StoreDto"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Key that makes retries return the original store instead of creating a new one",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.Store"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {}
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            }
+        },
+        "/v1/stores/{id}": {
+            "put": {
+                "description": "Update a store. Inactive stores take no requests",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Store"
+                ],
+                "summary": "Update Store",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID da loja",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Store to update",
+                        "name": "Store",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/StoreDto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.Store"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {}
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {}
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "CategoryDto": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "display_order": {
+                    "type": "integer"
+                },
+                "image_url": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "ComboDto": {
+            "type": "object",
+            "properties": {
+                "image_url": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "percentage_off": {
+                    "type": "number"
+                },
+                "price": {
+                    "type": "number"
+                },
+                "pricing_rule": {
+                    "description": "PricingRule is PRECO_FIXO, which sells the combo for the price, or PERCENTUAL, which takes the\npercentage off the price of the items chosen.",
+                    "type": "string"
+                },
+                "slots": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ComboSlotDto"
+                    }
+                }
+            }
+        },
+        "ComboSlotDto": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "type": "string"
+                },
+                "item_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "CustomerDto": {
+            "type": "object",
+            "properties": {
+                "cpf": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "IngredientDto": {
+            "type": "object",
+            "properties": {
+                "low_stock_threshold": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "stock": {
+                    "type": "number"
+                },
+                "unit": {
+                    "type": "string"
+                }
+            }
+        },
+        "ItemAvailabilityDto": {
+            "type": "object",
+            "properties": {
+                "available": {
+                    "description": "Available false pauses the item, taking it off sale until it is made available again.",
+                    "type": "boolean"
+                }
+            }
+        },
+        "ItemDto": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "type": "string"
+                },
+                "image_url": {
+                    "type": "string"
+                },
+                "modifiers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ItemModifierDto"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "price": {
+                    "type": "number"
+                },
+                "recipe": {
+                    "description": "Recipe lists the ingredients one unit of the item uses, in the unit of each ingredient.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/RecipeIngredientDto"
+                    }
+                },
+                "stock": {
+                    "description": "Stock is the number of units left at the store. When omitted the stock of the item is not tracked\nthere.",
+                    "type": "integer"
+                },
+                "variants": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ItemVariantDto"
+                    }
+                }
+            }
+        },
+        "ItemModifierDto": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "price": {
+                    "type": "number"
+                }
+            }
+        },
+        "ItemVariantDto": {
+            "type": "object",
+            "properties": {
+                "available": {
+                    "description": "Available defaults to true when omitted.",
+                    "type": "boolean"
+                },
+                "label": {
+                    "type": "string"
+                },
+                "price_delta": {
+                    "type": "number"
+                }
+            }
+        },
+        "MenuDto": {
+            "type": "object",
+            "properties": {
+                "end_time": {
+                    "type": "string"
+                },
+                "exclusive": {
+                    "description": "Exclusive makes the items of the menu be sold only while one of their exclusive menus is open.",
+                    "type": "boolean"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/MenuItemDto"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "start_time": {
+                    "description": "StartTime and EndTime are the HH:MM window of the day the menu is open, in the store time zone.\nWindows ending before they start go past midnight, and windows ending when they start last the whole\nday, such as the prices of a store.",
+                    "type": "string"
+                }
+            }
+        },
+        "MenuItemDto": {
+            "type": "object",
+            "properties": {
+                "item_id": {
+                    "type": "integer"
+                },
+                "price": {
+                    "description": "Price replaces the price of the item while the menu is open. When omitted the item keeps its price.",
+                    "type": "number"
+                }
+            }
+        },
+        "OrderCancelDto": {
+            "type": "object",
+            "properties": {
+                "canceled_by": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                }
+            }
+        },
+        "OrderComboDto": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/OrderComboItemDto"
+                    }
+                },
+                "quantity": {
+                    "type": "integer"
+                }
+            }
+        },
+        "OrderComboItemDto": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "modifiers": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "variant_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "OrderDto": {
+            "type": "object",
+            "properties": {
+                "combos": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/OrderComboDto"
+                    }
+                },
+                "coupon": {
+                    "type": "string"
+                },
+                "customer_id": {
+                    "type": "integer"
+                },
+                "customer_name": {
+                    "type": "string"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/OrderItemDto"
+                    }
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "OrderItemDto": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "modifiers": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "quantity": {
+                    "type": "integer"
+                },
+                "variant_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "OrderPaymentStatusDto": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "OrderStatusDto": {
+            "type": "object",
+            "properties": {
+                "changed_by": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "PromotionDto": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "buy_quantity": {
+                    "type": "integer"
+                },
+                "category": {
+                    "type": "string"
+                },
+                "code": {
+                    "description": "Code makes the promotion a coupon. Promotions without a code apply to every order they fit.",
+                    "type": "string"
+                },
+                "ends_at": {
+                    "type": "string"
+                },
+                "first_order_only": {
+                    "type": "boolean"
+                },
+                "free_quantity": {
+                    "type": "integer"
+                },
+                "kind": {
+                    "description": "Kind is PERCENTUAL_CATEGORIA, LEVE_X_GANHE_Y or VALOR_FIXO.",
+                    "type": "string"
+                },
+                "minimum_total": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "per_customer_limit": {
+                    "type": "integer"
+                },
+                "percentage_off": {
+                    "type": "number"
+                },
+                "starts_at": {
+                    "type": "string"
+                },
+                "usage_limit": {
+                    "type": "integer"
+                }
+            }
+        },
+        "RecipeIngredientDto": {
+            "type": "object",
+            "properties": {
+                "ingredient_id": {
+                    "type": "integer"
+                },
+                "quantity": {
+                    "type": "number"
+                }
+            }
+        },
+        "StoreCalendarDayDto": {
+            "type": "object",
+            "properties": {
+                "close_time": {
+                    "type": "string"
+                },
+                "closed": {
+                    "type": "boolean"
+                },
+                "date": {
+                    "description": "Date is YYYY-MM-DD in the store time zone.",
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "open_time": {
+                    "type": "string"
+                }
+            }
+        },
+        "StoreDayHoursDto": {
+            "type": "object",
+            "properties": {
+                "close_time": {
+                    "type": "string"
+                },
+                "open_time": {
+                    "description": "OpenTime and CloseTime are HH:MM in the store time zone. Hours closing before they open go past\nmidnight, into the next day.",
+                    "type": "string"
+                },
+                "weekday": {
+                    "description": "Weekday goes from 0 (Sunday) to 6 (Saturday).",
+                    "type": "integer"
+                }
+            }
+        },
+        "StoreDto": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "pickup_code_prefix": {
+                    "description": "PickupCodePrefix starts the pickup codes called out at the counter, up to 3 letters or digits.",
+                    "type": "string"
+                },
+                "time_zone": {
+                    "description": "TimeZone is the IANA time zone of the store, such as America/Sao_Paulo.",
+                    "type": "string"
+                }
+            }
+        },
+        "StoreHoursDto": {
+            "type": "object",
+            "properties": {
+                "days": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/StoreDayHoursDto"
+                    }
+                }
+            }
+        },
+        "domain.Category": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "display_order": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "image_url": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.Combo": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
                 "image_url": {
                     "type": "string"
                 },
-                "name": {
+                "name": {
+                    "type": "string"
+                },
+                "percentage_off": {
+                    "type": "number"
+                },
+                "price": {
+                    "type": "number"
+                },
+                "pricing_rule": {
+                    "type": "string"
+                },
+                "slots": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.ComboSlot"
+                    }
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.ComboSlot": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.ComboSlotItem"
+                    }
+                }
+            }
+        },
+        "domain.ComboSlotItem": {
+            "type": "object",
+            "properties": {
+                "item_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "domain.Customer": {
+            "type": "object",
+            "properties": {
+                "cpf": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "deletedAt": {
+                    "$ref": "#/definitions/gorm.DeletedAt"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.Ingredient": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "low_stock_threshold": {
+                    "description": "LowStockThreshold is the stock below which the ingredient shows up in the low stock report. Zero\nleaves the ingredient out of the report.",
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "stock": {
+                    "type": "number"
+                },
+                "unit": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.Item": {
+            "type": "object",
+            "properties": {
+                "available": {
+                    "type": "boolean"
+                },
+                "category": {
+                    "type": "string"
+                },
+                "categoryID": {
+                    "type": "integer"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "deletedAt": {
+                    "$ref": "#/definitions/gorm.DeletedAt"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "imageUrl": {
+                    "type": "string"
+                },
+                "modifiers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.ItemModifier"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "paused": {
+                    "type": "boolean"
+                },
+                "price": {
+                    "type": "number"
+                },
+                "recipe": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.RecipeIngredient"
+                    }
+                },
+                "stock": {
+                    "type": "integer"
+                },
+                "updatedAt": {
+                    "type": "string"
+                },
+                "variants": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.ItemVariant"
+                    }
+                }
+            }
+        },
+        "domain.ItemModifier": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "price": {
+                    "type": "number"
+                }
+            }
+        },
+        "domain.ItemVariant": {
+            "type": "object",
+            "properties": {
+                "available": {
+                    "type": "boolean"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "label": {
+                    "type": "string"
+                },
+                "priceDelta": {
+                    "type": "number"
+                }
+            }
+        },
+        "domain.Menu": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "end_time": {
+                    "type": "string"
+                },
+                "exclusive": {
+                    "type": "boolean"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.MenuItem"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "start_time": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.MenuItem": {
+            "type": "object",
+            "properties": {
+                "item_id": {
+                    "type": "integer"
+                },
+                "price": {
+                    "type": "number"
+                }
+            }
+        },
+        "domain.Order": {
+            "type": "object",
+            "properties": {
+                "canceled_at": {
+                    "type": "string"
+                },
+                "canceled_by": {
+                    "type": "string"
+                },
+                "cancellation_reason": {
+                    "type": "string"
+                },
+                "combos": {
+                    "description": "Combos holds the combos chosen at checkout until they are expanded into order lines.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_8soat-grupo35_fastfood-order_internal_entities.OrderCombo"
+                    }
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "customer_id": {
+                    "type": "integer"
+                },
+                "customer_name": {
+                    "type": "string"
+                },
+                "discount": {
+                    "type": "number"
+                },
+                "discounts": {
+                    "description": "Discounts is the breakdown of the Discount by promotion.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.OrderDiscount"
+                    }
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.OrderItem"
+                    }
+                },
+                "payment_status": {
+                    "type": "string"
+                },
+                "pickup_code": {
+                    "type": "string"
+                },
+                "preparation_started_at": {
+                    "type": "string"
+                },
+                "ready_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "store_id": {
+                    "type": "integer"
+                },
+                "subtotal": {
+                    "type": "number"
+                },
+                "total": {
+                    "type": "number"
+                },
+                "tracking_code": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.OrderDiscount": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "code": {
                     "type": "string"
                 },
-                "price": {
-                    "type": "number"
+                "promotion_id": {
+                    "type": "integer"
+                },
+                "promotion_name": {
+                    "type": "string"
                 }
             }
         },
-        "OrderDto": {
+        "domain.OrderEvent": {
             "type": "object",
             "properties": {
-                "customer_id": {
+                "customer_name": {
+                    "type": "string"
+                },
+                "occurred_at": {
+                    "type": "string"
+                },
+                "order_id": {
                     "type": "integer"
                 },
-                "items": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/OrderItemDto"
-                    }
+                "payment_status": {
+                    "type": "string"
+                },
+                "pickup_code": {
+                    "type": "string"
                 },
                 "status": {
                     "type": "string"
+                },
+                "store_id": {
+                    "type": "integer"
+                },
+                "type": {
+                    "type": "string"
                 }
             }
         },
-        "OrderItemDto": {
+        "domain.OrderItem": {
             "type": "object",
             "properties": {
+                "combo_group": {
+                    "type": "integer"
+                },
+                "combo_id": {
+                    "description": "ComboID is set on the lines of a combo. The lines of the same combo share the combo group, its\nposition in the checkout, and the Discount is their share of the combo discount for the whole quantity.",
+                    "type": "integer"
+                },
+                "combo_name": {
+                    "type": "string"
+                },
+                "discount": {
+                    "type": "number"
+                },
                 "id": {
                     "type": "integer"
                 },
+                "item_name": {
+                    "type": "string"
+                },
+                "modifiers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.OrderItemModifier"
+                    }
+                },
+                "notes": {
+                    "type": "string"
+                },
                 "quantity": {
                     "type": "integer"
+                },
+                "unit_price": {
+                    "description": "UnitPrice is the item price plus the variant price delta and the price of the modifiers, for one unit.",
+                    "type": "number"
+                },
+                "variant_id": {
+                    "description": "VariantID is required when the item has variants. The label is kept to show it after the variant changes.",
+                    "type": "integer"
+                },
+                "variant_label": {
+                    "type": "string"
                 }
             }
         },
-        "OrderStatusDto": {
+        "domain.OrderItemModifier": {
             "type": "object",
             "properties": {
-                "status": {
+                "name": {
                     "type": "string"
+                },
+                "price": {
+                    "type": "number"
                 }
             }
         },
-        "domain.Customer": {
+        "domain.OrderStatusHistory": {
             "type": "object",
             "properties": {
-                "cpf": {
-                    "type": "string"
-                },
-                "createdAt": {
+                "changed_at": {
                     "type": "string"
                 },
-                "deletedAt": {
-                    "$ref": "#/definitions/gorm.DeletedAt"
-                },
-                "email": {
+                "changed_by": {
                     "type": "string"
                 },
-                "id": {
+                "order_id": {
                     "type": "integer"
                 },
-                "name": {
+                "previous_status": {
                     "type": "string"
                 },
-                "updatedAt": {
+                "status": {
                     "type": "string"
                 }
             }
         },
-        "domain.Item": {
+        "domain.Promotion": {
             "type": "object",
             "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "buy_quantity": {
+                    "description": "BuyQuantity and FreeQuantity make every group of BuyQuantity + FreeQuantity units of the category\ncost as BuyQuantity units, the cheapest ones being free.",
+                    "type": "integer"
+                },
                 "category": {
+                    "description": "Category is the item category discounted by the PERCENTUAL_CATEGORIA and LEVE_X_GANHE_Y promotions.",
                     "type": "string"
                 },
-                "createdAt": {
+                "code": {
+                    "description": "Code is the coupon code, empty for automatic promotions. Codes are unique in the store.",
                     "type": "string"
                 },
-                "deletedAt": {
-                    "$ref": "#/definitions/gorm.DeletedAt"
+                "created_at": {
+                    "type": "string"
+                },
+                "ends_at": {
+                    "type": "string"
+                },
+                "first_order_only": {
+                    "type": "boolean"
+                },
+                "free_quantity": {
+                    "type": "integer"
                 },
                 "id": {
                     "type": "integer"
                 },
-                "imageUrl": {
+                "kind": {
                     "type": "string"
                 },
+                "minimum_total": {
+                    "type": "number"
+                },
                 "name": {
                     "type": "string"
                 },
-                "price": {
+                "per_customer_limit": {
+                    "type": "integer"
+                },
+                "percentage_off": {
                     "type": "number"
                 },
-                "updatedAt": {
+                "starts_at": {
+                    "description": "StartsAt and EndsAt limit when the promotion is valid. EndsAt is exclusive and both are optional.",
+                    "type": "string"
+                },
+                "times_used": {
+                    "type": "integer"
+                },
+                "updated_at": {
                     "type": "string"
+                },
+                "usage_limit": {
+                    "description": "UsageLimit and PerCustomerLimit cap the uses of the promotion, in every order and in the orders of\neach customer. Zero means unlimited. Canceled orders do not give their uses back.",
+                    "type": "integer"
                 }
             }
         },
-        "domain.Order": {
+        "domain.RecipeIngredient": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "ingredientID": {
+                    "type": "integer"
+                },
+                "quantity": {
+                    "type": "number"
+                }
+            }
+        },
+        "domain.Store": {
             "type": "object",
             "properties": {
+                "active": {
+                    "type": "boolean"
+                },
                 "created_at": {
                     "type": "string"
                 },
-                "customer_id": {
+                "id": {
                     "type": "integer"
                 },
+                "name": {
+                    "type": "string"
+                },
+                "pickup_code_prefix": {
+                    "type": "string"
+                },
+                "time_zone": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.StoreCalendarDay": {
+            "type": "object",
+            "properties": {
+                "close_time": {
+                    "type": "string"
+                },
+                "closed": {
+                    "type": "boolean"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
                 "id": {
                     "type": "integer"
                 },
-                "items": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/domain.OrderItem"
-                    }
-                },
-                "status": {
+                "open_time": {
                     "type": "string"
                 },
                 "updated_at": {
@@ -506,12 +3366,43 @@ const docTemplate = `{
                 }
             }
         },
-        "domain.OrderItem": {
+        "domain.StoreHours": {
+            "type": "object",
+            "properties": {
+                "close_time": {
+                    "type": "string"
+                },
+                "open_time": {
+                    "type": "string"
+                },
+                "weekday": {
+                    "$ref": "#/definitions/time.Weekday"
+                }
+            }
+        },
+        "domain.StoreStatus": {
+            "type": "object",
+            "properties": {
+                "next_opening": {
+                    "type": "string"
+                },
+                "open": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "github_com_8soat-grupo35_fastfood-order_internal_entities.OrderCombo": {
             "type": "object",
             "properties": {
                 "id": {
                     "type": "integer"
                 },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.OrderItem"
+                    }
+                },
                 "quantity": {
                     "type": "integer"
                 }
@@ -529,13 +3420,149 @@ const docTemplate = `{
                 }
             }
         },
+        "presenters.DroppedOrderItemPresenter": {
+            "type": "object",
+            "properties": {
+                "combo_name": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "item_name": {
+                    "type": "string"
+                },
+                "quantity": {
+                    "type": "integer"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "variant_label": {
+                    "type": "string"
+                }
+            }
+        },
+        "presenters.OrderDiscountPresenter": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "code": {
+                    "type": "string"
+                },
+                "promotion_name": {
+                    "type": "string"
+                }
+            }
+        },
         "presenters.OrderPresenter": {
             "type": "object",
             "properties": {
+                "customer_name": {
+                    "type": "string"
+                },
+                "discounts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presenters.OrderDiscountPresenter"
+                    }
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "pickup_code": {
+                    "type": "string"
+                },
+                "total": {
+                    "description": "Total is what the customer pays, after the Discounts of the promotions applied at checkout.",
+                    "type": "number"
+                },
+                "tracking_code": {
+                    "type": "string"
+                }
+            }
+        },
+        "presenters.OrderTrackingPresenter": {
+            "type": "object",
+            "properties": {
+                "customer_name": {
+                    "type": "string"
+                },
+                "estimated_ready_at": {
+                    "type": "string"
+                },
+                "orders_ahead": {
+                    "type": "integer"
+                },
+                "payment_status": {
+                    "type": "string"
+                },
+                "pickup_code": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "tracking_code": {
+                    "type": "string"
+                }
+            }
+        },
+        "presenters.ReorderPresenter": {
+            "type": "object",
+            "properties": {
+                "customer_name": {
+                    "type": "string"
+                },
+                "discounts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presenters.OrderDiscountPresenter"
+                    }
+                },
+                "dropped_items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presenters.DroppedOrderItemPresenter"
+                    }
+                },
                 "id": {
                     "type": "integer"
+                },
+                "pickup_code": {
+                    "type": "string"
+                },
+                "total": {
+                    "description": "Total is what the customer pays, after the Discounts of the promotions applied at checkout.",
+                    "type": "number"
+                },
+                "tracking_code": {
+                    "type": "string"
                 }
             }
+        },
+        "time.Weekday": {
+            "type": "integer",
+            "enum": [
+                0,
+                1,
+                2,
+                3,
+                4,
+                5,
+                6
+            ],
+            "x-enum-varnames": [
+                "Sunday",
+                "Monday",
+                "Tuesday",
+                "Wednesday",
+                "Thursday",
+                "Friday",
+                "Saturday"
+            ]
         }
     }
 }`
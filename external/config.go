@@ -10,9 +10,13 @@ import (
 )
 
 type Config struct {
-	ServerHost     string
-	DatabaseConfig DatabaseConfig
-	HttpConfig     HttpConfig
+	ServerHost           string
+	DatabaseConfig       DatabaseConfig
+	HttpConfig           HttpConfig
+	OutboxConfig         OutboxConfig
+	StreamConfig         StreamConfig
+	StoreConfig          StoreConfig
+	IdempotencyKeyConfig IdempotencyKeyConfig
 }
 
 type DatabaseConfig struct {
@@ -28,6 +32,24 @@ type HttpConfig struct {
 	Timeout    time.Duration
 }
 
+type OutboxConfig struct {
+	DispatchInterval time.Duration
+	BatchSize        int
+}
+
+type StreamConfig struct {
+	ListenRetryInterval time.Duration
+}
+
+type IdempotencyKeyConfig struct {
+	PurgeInterval time.Duration
+}
+
+// StoreConfig names the store of the requests without the X-Store-ID header.
+type StoreConfig struct {
+	DefaultStoreID uint32
+}
+
 var (
 	runOnce sync.Once
 	config  Config
@@ -52,6 +74,19 @@ func GetConfig() Config {
 				ServiceURL: cfg.GetString("FASTFOOD_PAYMENT_APP_URL"),
 				Timeout:    cfg.GetDuration("HTTP_TIMEOUT"),
 			},
+			OutboxConfig: OutboxConfig{
+				DispatchInterval: cfg.GetDuration("OUTBOX_DISPATCH_INTERVAL"),
+				BatchSize:        cfg.GetInt("OUTBOX_BATCH_SIZE"),
+			},
+			StreamConfig: StreamConfig{
+				ListenRetryInterval: cfg.GetDuration("STREAM_LISTEN_RETRY_INTERVAL"),
+			},
+			StoreConfig: StoreConfig{
+				DefaultStoreID: cfg.GetUint32("STORE_DEFAULT_ID"),
+			},
+			IdempotencyKeyConfig: IdempotencyKeyConfig{
+				PurgeInterval: cfg.GetDuration("IDEMPOTENCY_KEY_PURGE_INTERVAL"),
+			},
 		}
 	})
 
@@ -83,4 +118,9 @@ func initDefaults(config *viper.Viper) {
 	config.SetDefault("DATABASE_DBNAME", "root")
 	config.SetDefault("FASTFOOD_PAYMENT_APP_URL", "http://localhost:8080")
 	config.SetDefault("HTTP_TIMEOUT", 5*time.Second)
+	config.SetDefault("OUTBOX_DISPATCH_INTERVAL", 5*time.Second)
+	config.SetDefault("OUTBOX_BATCH_SIZE", 50)
+	config.SetDefault("STREAM_LISTEN_RETRY_INTERVAL", 5*time.Second)
+	config.SetDefault("STORE_DEFAULT_ID", 1)
+	config.SetDefault("IDEMPOTENCY_KEY_PURGE_INTERVAL", time.Hour)
 }
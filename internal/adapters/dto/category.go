@@ -0,0 +1,9 @@
+package dto
+
+// CategoryDto is a category of the menu. Categories with a lower display order show up first.
+type CategoryDto struct {
+	Name         string `json:"name"`
+	DisplayOrder int    `json:"display_order"`
+	Active       *bool  `json:"active"`
+	ImageUrl     string `json:"image_url"`
+} //@name CategoryDto
@@ -0,0 +1,17 @@
+package dto
+
+type ComboDto struct {
+	Name     string `json:"name"`
+	ImageUrl string `json:"image_url"`
+	// PricingRule is PRECO_FIXO, which sells the combo for the price, or PERCENTUAL, which takes the
+	// percentage off the price of the items chosen.
+	PricingRule   string         `json:"pricing_rule"`
+	Price         float64        `json:"price"`
+	PercentageOff float32        `json:"percentage_off"`
+	Slots         []ComboSlotDto `json:"slots"`
+} //@name ComboDto
+
+type ComboSlotDto struct {
+	Category string   `json:"category"`
+	ItemIDs  []uint32 `json:"item_ids"`
+} //@name ComboSlotDto
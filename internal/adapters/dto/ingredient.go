@@ -0,0 +1,9 @@
+package dto
+
+// IngredientDto is an ingredient of the recipes. The unit is UN, G, KG, ML or L.
+type IngredientDto struct {
+	Name              string  `json:"name"`
+	Unit              string  `json:"unit"`
+	Stock             float64 `json:"stock"`
+	LowStockThreshold float64 `json:"low_stock_threshold"`
+} //@name IngredientDto
@@ -1,8 +1,37 @@
 package dto
 
 type ItemDto struct {
-	Name     string  `json:"name"`
-	Category string  `json:"category"`
-	Price    float32 `json:"price"`
-	ImageUrl string  `json:"image_url"`
+	Name      string            `json:"name"`
+	Category  string            `json:"category"`
+	Price     float64           `json:"price"`
+	ImageUrl  string            `json:"image_url"`
+	Variants  []ItemVariantDto  `json:"variants"`
+	Modifiers []ItemModifierDto `json:"modifiers"`
+	// Recipe lists the ingredients one unit of the item uses, in the unit of each ingredient.
+	Recipe []RecipeIngredientDto `json:"recipe"`
+	// Stock is the number of units left at the store. When omitted the stock of the item is not tracked
+	// there.
+	Stock *uint32 `json:"stock"`
 } //@name ItemDto
+
+type ItemAvailabilityDto struct {
+	// Available false pauses the item, taking it off sale until it is made available again.
+	Available *bool `json:"available"`
+} //@name ItemAvailabilityDto
+
+type ItemVariantDto struct {
+	Label      string  `json:"label"`
+	PriceDelta float64 `json:"price_delta"`
+	// Available defaults to true when omitted.
+	Available *bool `json:"available"`
+} //@name ItemVariantDto
+
+type RecipeIngredientDto struct {
+	IngredientID uint32  `json:"ingredient_id"`
+	Quantity     float64 `json:"quantity"`
+} //@name RecipeIngredientDto
+
+type ItemModifierDto struct {
+	Name  string  `json:"name"`
+	Price float64 `json:"price"`
+} //@name ItemModifierDto
@@ -0,0 +1,19 @@
+package dto
+
+type MenuDto struct {
+	Name string `json:"name"`
+	// StartTime and EndTime are the HH:MM window of the day the menu is open, in the store time zone.
+	// Windows ending before they start go past midnight, and windows ending when they start last the whole
+	// day, such as the prices of a store.
+	StartTime string `json:"start_time"`
+	EndTime   string `json:"end_time"`
+	// Exclusive makes the items of the menu be sold only while one of their exclusive menus is open.
+	Exclusive bool          `json:"exclusive"`
+	Items     []MenuItemDto `json:"items"`
+} //@name MenuDto
+
+type MenuItemDto struct {
+	ItemID uint32 `json:"item_id"`
+	// Price replaces the price of the item while the menu is open. When omitted the item keeps its price.
+	Price *float64 `json:"price"`
+} //@name MenuItemDto
@@ -1,24 +1,61 @@
 package dto
 
 type OrderItemDto struct {
-	Id       uint32 `json:"id"`
-	Quantity uint32 `json:"quantity"`
+	Id        uint32   `json:"id"`
+	Quantity  uint32   `json:"quantity"`
+	VariantID *uint32  `json:"variant_id"`
+	Notes     string   `json:"notes"`
+	Modifiers []string `json:"modifiers"`
 } //@name OrderItemDto
 
+// OrderComboDto chooses one item for each slot of the combo. The items are ordered in the quantity of the combo.
+type OrderComboDto struct {
+	Id       uint32              `json:"id"`
+	Quantity uint32              `json:"quantity"`
+	Items    []OrderComboItemDto `json:"items"`
+} //@name OrderComboDto
+
+type OrderComboItemDto struct {
+	Id        uint32   `json:"id"`
+	VariantID *uint32  `json:"variant_id"`
+	Notes     string   `json:"notes"`
+	Modifiers []string `json:"modifiers"`
+} //@name OrderComboItemDto
+
 type OrderDto struct {
-	Items      []OrderItemDto `json:"items"`
-	CustomerID uint32         `json:"customer_id"`
-	Status     string         `json:"status"`
+	Items        []OrderItemDto  `json:"items"`
+	Combos       []OrderComboDto `json:"combos"`
+	CustomerID   *uint32         `json:"customer_id"`
+	CustomerName string          `json:"customer_name"`
+	Coupon       string          `json:"coupon"`
+	Status       string          `json:"status"`
 } //@name OrderDto
 
 type OrderStatusDto struct {
-	Status string `json:"status"`
+	Status    string `json:"status"`
+	ChangedBy string `json:"changed_by"`
 } //@name OrderStatusDto
 
+type OrderCancelDto struct {
+	CanceledBy string `json:"canceled_by"`
+	Reason     string `json:"reason"`
+} //@name OrderCancelDto
+
+type OrderFilterDto struct {
+	Status      []string `query:"status"`
+	CustomerID  uint32   `query:"customer_id"`
+	CreatedFrom string   `query:"created_from"`
+	CreatedTo   string   `query:"created_to"`
+	PickupCode  string   `query:"pickup_code"`
+	Cursor      string   `query:"cursor"`
+	Limit       int      `query:"limit"`
+} //@name OrderFilterDto
+
 type OrderPaymentStatusDto struct {
 	Status string `json:"status"`
 } //@name OrderPaymentStatusDto
 
 type OrderPaymentDto struct {
-	OrderID int `json:"orderId"`
+	OrderID int     `json:"orderId"`
+	Amount  float64 `json:"amount"`
 } //@name OrderPaymentDto
@@ -0,0 +1,22 @@
+package dto
+
+import "time"
+
+type PromotionDto struct {
+	Name string `json:"name"`
+	// Code makes the promotion a coupon. Promotions without a code apply to every order they fit.
+	Code string `json:"code"`
+	// Kind is PERCENTUAL_CATEGORIA, LEVE_X_GANHE_Y or VALOR_FIXO.
+	Kind             string     `json:"kind"`
+	Category         string     `json:"category"`
+	PercentageOff    float32    `json:"percentage_off"`
+	BuyQuantity      uint32     `json:"buy_quantity"`
+	FreeQuantity     uint32     `json:"free_quantity"`
+	Amount           float64    `json:"amount"`
+	MinimumTotal     float64    `json:"minimum_total"`
+	FirstOrderOnly   bool       `json:"first_order_only"`
+	StartsAt         *time.Time `json:"starts_at"`
+	EndsAt           *time.Time `json:"ends_at"`
+	UsageLimit       uint32     `json:"usage_limit"`
+	PerCustomerLimit uint32     `json:"per_customer_limit"`
+} //@name PromotionDto
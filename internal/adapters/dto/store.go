@@ -0,0 +1,36 @@
+package dto
+
+// StoreHoursDto is the whole week of opening hours of the store. Days of the week left out are closed.
+type StoreHoursDto struct {
+	Days []StoreDayHoursDto `json:"days"`
+} //@name StoreHoursDto
+
+type StoreDayHoursDto struct {
+	// Weekday goes from 0 (Sunday) to 6 (Saturday).
+	Weekday int `json:"weekday"`
+	// OpenTime and CloseTime are HH:MM in the store time zone. Hours closing before they open go past
+	// midnight, into the next day.
+	OpenTime  string `json:"open_time"`
+	CloseTime string `json:"close_time"`
+} //@name StoreDayHoursDto
+
+// StoreCalendarDayDto is an exception to the weekly hours on a date, such as a holiday. The store is
+// either closed the whole day or open on the hours given instead of the weekly ones.
+type StoreCalendarDayDto struct {
+	// Date is YYYY-MM-DD in the store time zone.
+	Date        string `json:"date"`
+	Closed      bool   `json:"closed"`
+	OpenTime    string `json:"open_time"`
+	CloseTime   string `json:"close_time"`
+	Description string `json:"description"`
+} //@name StoreCalendarDayDto
+
+// StoreDto is a unit of the franchise. Stores are active unless told otherwise.
+type StoreDto struct {
+	Name string `json:"name"`
+	// TimeZone is the IANA time zone of the store, such as America/Sao_Paulo.
+	TimeZone string `json:"time_zone"`
+	// PickupCodePrefix starts the pickup codes called out at the counter, up to 3 letters or digits.
+	PickupCodePrefix string `json:"pickup_code_prefix"`
+	Active           *bool  `json:"active"`
+} //@name StoreDto
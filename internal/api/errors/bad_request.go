@@ -1,9 +1,63 @@
 package custom_errors
 
+import (
+	"errors"
+	"sort"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
 type BadRequestError struct {
-	Message string
+	Message string        `json:"message"`
+	Details []ErrorDetail `json:"details,omitempty"`
+}
+
+type ErrorDetail struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
 }
 
 func (b *BadRequestError) Error() string {
 	return b.Message
 }
+
+// NewValidationError keeps the validation message and lists every failed field, using dotted paths
+// such as "items.0.quantity" for nested values.
+func NewValidationError(err error) *BadRequestError {
+	return &BadRequestError{
+		Message: err.Error(),
+		Details: errorDetails("", err),
+	}
+}
+
+func errorDetails(field string, err error) (details []ErrorDetail) {
+	var validationErrors validation.Errors
+	if !errors.As(err, &validationErrors) {
+		if field == "" {
+			return nil
+		}
+
+		return []ErrorDetail{{Field: field, Message: err.Error()}}
+	}
+
+	keys := make([]string, 0, len(validationErrors))
+	for key := range validationErrors {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if validationErrors[key] == nil {
+			continue
+		}
+
+		path := key
+		if field != "" {
+			path = field + "." + key
+		}
+
+		details = append(details, errorDetails(path, validationErrors[key])...)
+	}
+
+	return details
+}
@@ -0,0 +1,35 @@
+package custom_errors
+
+import (
+	"errors"
+	"testing"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewValidationErrorFlattensNestedErrors(t *testing.T) {
+	err := validation.Errors{
+		"customer_id": errors.New("customer 9 not found"),
+		"items": validation.Errors{
+			"1": validation.Errors{"quantity": errors.New("must be no greater than 20")},
+			"0": validation.Errors{"id": errors.New("item 3 not found")},
+		},
+	}
+
+	badRequestError := NewValidationError(err)
+
+	assert.Equal(t, err.Error(), badRequestError.Message)
+	assert.Equal(t, []ErrorDetail{
+		{Field: "customer_id", Message: "customer 9 not found"},
+		{Field: "items.0.id", Message: "item 3 not found"},
+		{Field: "items.1.quantity", Message: "must be no greater than 20"},
+	}, badRequestError.Details)
+}
+
+func TestNewValidationErrorKeepsPlainErrors(t *testing.T) {
+	badRequestError := NewValidationError(errors.New("invalid order"))
+
+	assert.Equal(t, "invalid order", badRequestError.Message)
+	assert.Empty(t, badRequestError.Details)
+}
@@ -0,0 +1,9 @@
+package custom_errors
+
+type ConflictError struct {
+	Message string
+}
+
+func (b *ConflictError) Error() string {
+	return b.Message
+}
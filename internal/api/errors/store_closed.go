@@ -0,0 +1,14 @@
+package custom_errors
+
+import "time"
+
+// StoreClosedError refuses an order while the store is closed, telling when it opens again if it does
+// within a year.
+type StoreClosedError struct {
+	Message     string     `json:"message"`
+	NextOpening *time.Time `json:"next_opening,omitempty"`
+}
+
+func (b *StoreClosedError) Error() string {
+	return b.Message
+}
@@ -0,0 +1,66 @@
+package events
+
+import (
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+	"sync"
+)
+
+// subscriberBuffer is how many events a slow stream client may fall behind before it is disconnected.
+const subscriberBuffer = 32
+
+// Hub fans the order events received by this replica out to the stream clients connected to it, each
+// client only getting the events of the store it subscribed to.
+type Hub struct {
+	mutex       sync.Mutex
+	subscribers map[chan entities.OrderEvent]uint32
+}
+
+func NewHub() *Hub {
+	return &Hub{
+		subscribers: make(map[chan entities.OrderEvent]uint32),
+	}
+}
+
+// Subscribe returns the channel of the new client of the store and the function that must be called once
+// it disconnects.
+func (h *Hub) Subscribe(storeId uint32) (<-chan entities.OrderEvent, func()) {
+	subscriber := make(chan entities.OrderEvent, subscriberBuffer)
+
+	h.mutex.Lock()
+	h.subscribers[subscriber] = storeId
+	h.mutex.Unlock()
+
+	return subscriber, func() {
+		h.remove(subscriber)
+	}
+}
+
+// Broadcast never blocks: a client that cannot keep up has its channel closed, so it reconnects and
+// gets a fresh snapshot instead of silently missing events.
+func (h *Hub) Broadcast(event entities.OrderEvent) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	for subscriber, storeId := range h.subscribers {
+		if storeId != event.StoreID {
+			continue
+		}
+
+		select {
+		case subscriber <- event:
+		default:
+			delete(h.subscribers, subscriber)
+			close(subscriber)
+		}
+	}
+}
+
+func (h *Hub) remove(subscriber chan entities.OrderEvent) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	if _, found := h.subscribers[subscriber]; found {
+		delete(h.subscribers, subscriber)
+		close(subscriber)
+	}
+}
@@ -0,0 +1,63 @@
+package events
+
+import (
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestHubBroadcastsToEverySubscriber(t *testing.T) {
+	hub := NewHub()
+	first, unsubscribeFirst := hub.Subscribe(1)
+	second, unsubscribeSecond := hub.Subscribe(1)
+	defer unsubscribeFirst()
+	defer unsubscribeSecond()
+
+	hub.Broadcast(entities.OrderEvent{Type: entities.ORDER_CREATED_EVENT, OrderID: 1, StoreID: 1})
+
+	assert.Equal(t, uint32(1), (<-first).OrderID)
+	assert.Equal(t, uint32(1), (<-second).OrderID)
+}
+
+func TestHubOnlyBroadcastsToSubscribersOfTheStore(t *testing.T) {
+	hub := NewHub()
+	subscriber, unsubscribe := hub.Subscribe(2)
+
+	hub.Broadcast(entities.OrderEvent{OrderID: 1, StoreID: 1})
+	hub.Broadcast(entities.OrderEvent{OrderID: 2, StoreID: 2})
+	unsubscribe()
+
+	received := []uint32{}
+	for event := range subscriber {
+		received = append(received, event.OrderID)
+	}
+	assert.Equal(t, []uint32{2}, received)
+}
+
+func TestHubClosesUnsubscribedChannel(t *testing.T) {
+	hub := NewHub()
+	subscriber, unsubscribe := hub.Subscribe(1)
+
+	unsubscribe()
+	unsubscribe()
+	hub.Broadcast(entities.OrderEvent{OrderID: 1, StoreID: 1})
+
+	_, open := <-subscriber
+	assert.False(t, open)
+}
+
+func TestHubDisconnectsSlowSubscriber(t *testing.T) {
+	hub := NewHub()
+	subscriber, unsubscribe := hub.Subscribe(1)
+	defer unsubscribe()
+
+	for i := 0; i <= subscriberBuffer; i++ {
+		hub.Broadcast(entities.OrderEvent{OrderID: uint32(i), StoreID: 1})
+	}
+
+	received := 0
+	for range subscriber {
+		received++
+	}
+	assert.Equal(t, subscriberBuffer, received)
+}
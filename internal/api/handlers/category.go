@@ -0,0 +1,140 @@
+package handlers
+
+import (
+	"github.com/8soat-grupo35/fastfood-order/internal/adapters/dto"
+	"github.com/8soat-grupo35/fastfood-order/internal/controllers"
+	controllersInterface "github.com/8soat-grupo35/fastfood-order/internal/interfaces/controllers"
+	"gorm.io/gorm"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+type CategoryHandler struct {
+	categoryController controllersInterface.CategoryController
+}
+
+func NewCategoryHandler(db *gorm.DB) CategoryHandler {
+	return CategoryHandler{
+		categoryController: controllers.NewCategoryController(db),
+	}
+}
+
+// GetAll godoc
+// @Summary      List Categories
+// @Description  List the categories in the order the menu shows them
+// @Tags         Categories
+// @Produce      json
+// @Param        active query bool false "Only the active categories, the ones the menu shows"
+// @Router       /v1/category [get]
+// @Success 200  {array} domain.Category
+// @Failure 400  {object} error
+// @Failure 500  {object} error
+func (h *CategoryHandler) GetAll(echo echo.Context) error {
+	onlyActive := false
+	if active := echo.QueryParam("active"); active != "" {
+		var err error
+		onlyActive, err = strconv.ParseBool(active)
+		if err != nil {
+			return echo.JSON(http.StatusBadRequest, err.Error())
+		}
+	}
+
+	categories, err := h.categoryController.GetAll(onlyActive)
+
+	if err != nil {
+		return echo.JSON(httpStatusFromError(err), err.Error())
+	}
+
+	return echo.JSON(http.StatusOK, categories)
+}
+
+// Create godoc
+// @Summary      Insert Category
+// @Description  Insert a category of the menu. Categories are active unless told otherwise
+// @Tags         Categories
+// @Accept       json
+// @Produce      json
+// @Param        Category	body dto.CategoryDto true "Category to insert"
+// @Param        Idempotency-Key header string false "Key that makes retries return the original category instead of creating a new one"
+// @Router       /v1/category [post]
+// @Success 200  {object} domain.Category
+// @Failure 400  {object} error
+// @Failure 409  {object} error
+// @Failure 500  {object} error
+func (h *CategoryHandler) Create(echo echo.Context) error {
+	categoryDto := dto.CategoryDto{}
+
+	err := echo.Bind(&categoryDto)
+	if err != nil {
+		return echo.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	category, err := h.categoryController.Create(categoryDto)
+	if err != nil {
+		return echo.JSON(httpStatusFromError(err), errorResponse(err))
+	}
+
+	return echo.JSON(http.StatusOK, category)
+}
+
+// Update godoc
+// @Summary      Update Category
+// @Description  Update a category. A new name is given to its items, combo slots and promotions too
+// @Tags         Categories
+// @Accept       json
+// @Produce      json
+// @Param        id     path int          true "ID da categoria"
+// @Param        Category	body dto.CategoryDto true "Category to update"
+// @Router       /v1/category/{id} [put]
+// @Success 200  {object} domain.Category
+// @Failure 400  {object} error
+// @Failure 404  {object} error
+// @Failure 409  {object} error
+// @Failure 500  {object} error
+func (h *CategoryHandler) Update(echo echo.Context) error {
+	categoryDto := dto.CategoryDto{}
+
+	err := echo.Bind(&categoryDto)
+	if err != nil {
+		return echo.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	id, err := strconv.Atoi(echo.Param("id"))
+	if err != nil {
+		return echo.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	category, err := h.categoryController.Update(id, categoryDto)
+	if err != nil {
+		return echo.JSON(httpStatusFromError(err), errorResponse(err))
+	}
+
+	return echo.JSON(http.StatusOK, category)
+}
+
+// Delete godoc
+// @Summary      Delete Category
+// @Description  Delete a category without items
+// @Tags         Categories
+// @Produce      json
+// @Param        id path int true "ID da categoria"
+// @Router       /v1/category/{id} [delete]
+// @Success 200  {string} string "category deleted successfully"
+// @Failure 404  {object} error
+// @Failure 409  {object} error
+// @Failure 500  {object} error
+func (h *CategoryHandler) Delete(echo echo.Context) error {
+	id, err := strconv.Atoi(echo.Param("id"))
+	if err != nil {
+		return echo.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	err = h.categoryController.Delete(id)
+	if err != nil {
+		return echo.JSON(httpStatusFromError(err), err.Error())
+	}
+
+	return echo.JSON(http.StatusOK, "category deleted successfully")
+}
@@ -0,0 +1,111 @@
+package handlers
+
+import (
+	"github.com/8soat-grupo35/fastfood-order/internal/adapters/dto"
+	custom_errors "github.com/8soat-grupo35/fastfood-order/internal/api/errors"
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+	mockControllers "github.com/8soat-grupo35/fastfood-order/internal/interfaces/controllers/mock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type CategoryHandlerSuite struct {
+	suite.Suite
+	ctrl       *gomock.Controller
+	controller *mockControllers.MockCategoryController
+	handler    *CategoryHandler
+	e          *echo.Echo
+}
+
+func (suite *CategoryHandlerSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.controller = mockControllers.NewMockCategoryController(suite.ctrl)
+	suite.handler = &CategoryHandler{categoryController: suite.controller}
+	suite.e = echo.New()
+}
+
+func (suite *CategoryHandlerSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func (suite *CategoryHandlerSuite) TestGetAllOnlyActive() {
+	expectedCategories := []entities.Category{{ID: 1, Name: "LANCHE", DisplayOrder: 1, Active: true}}
+
+	suite.controller.EXPECT().GetAll(true).Return(expectedCategories, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/category?active=true", nil)
+	rec := httptest.NewRecorder()
+	c := suite.e.NewContext(req, rec)
+
+	err := suite.handler.GetAll(c)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusOK, rec.Code)
+	assert.Contains(suite.T(), rec.Body.String(), `"name":"LANCHE","display_order":1,"active":true`)
+}
+
+func (suite *CategoryHandlerSuite) TestGetAllReturnsBadRequestOnInvalidActive() {
+	req := httptest.NewRequest(http.MethodGet, "/v1/category?active=sim", nil)
+	rec := httptest.NewRecorder()
+	c := suite.e.NewContext(req, rec)
+
+	err := suite.handler.GetAll(c)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusBadRequest, rec.Code)
+}
+
+func (suite *CategoryHandlerSuite) TestCreate() {
+	suite.controller.EXPECT().Create(gomock.Any()).DoAndReturn(func(categoryDto dto.CategoryDto) (*entities.Category, error) {
+		assert.Equal(suite.T(), 5, categoryDto.DisplayOrder)
+		assert.False(suite.T(), *categoryDto.Active)
+		return &entities.Category{ID: 5, Name: "SALADA"}, nil
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/category", strings.NewReader(`{"name":"Salada","display_order":5,"active":false}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := suite.e.NewContext(req, rec)
+
+	err := suite.handler.Create(c)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusOK, rec.Code)
+}
+
+func (suite *CategoryHandlerSuite) TestUpdateReturnsConflict() {
+	suite.controller.EXPECT().Update(5, gomock.Any()).Return(nil, &custom_errors.ConflictError{Message: "category name already in use"})
+
+	req := httptest.NewRequest(http.MethodPut, "/v1/category/5", strings.NewReader(`{"name":"Bebida"}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := suite.e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("5")
+
+	err := suite.handler.Update(c)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusConflict, rec.Code)
+}
+
+func (suite *CategoryHandlerSuite) TestDeleteReturnsConflictWhileItHasItems() {
+	suite.controller.EXPECT().Delete(1).Return(&custom_errors.ConflictError{Message: "category still has items"})
+
+	req := httptest.NewRequest(http.MethodDelete, "/v1/category/1", nil)
+	rec := httptest.NewRecorder()
+	c := suite.e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	err := suite.handler.Delete(c)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusConflict, rec.Code)
+	assert.Equal(suite.T(), `"category still has items"`+"\n", rec.Body.String())
+}
+
+func TestCategoryHandlerSuite(t *testing.T) {
+	suite.Run(t, new(CategoryHandlerSuite))
+}
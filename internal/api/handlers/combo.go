@@ -0,0 +1,126 @@
+package handlers
+
+import (
+	"github.com/8soat-grupo35/fastfood-order/internal/adapters/dto"
+	"github.com/8soat-grupo35/fastfood-order/internal/controllers"
+	controllersInterface "github.com/8soat-grupo35/fastfood-order/internal/interfaces/controllers"
+	"gorm.io/gorm"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ComboHandler struct {
+	comboController controllersInterface.ComboController
+}
+
+func NewComboHandler(db *gorm.DB) ComboHandler {
+	return ComboHandler{
+		comboController: controllers.NewComboController(db),
+	}
+}
+
+// GetAll godoc
+// @Summary      List Combos
+// @Description  List every combo with its slots and the items allowed in each one
+// @Tags         Combos
+// @Produce      json
+// @Router       /v1/combo [get]
+// @Success 200  {array} domain.Combo
+// @Failure 500  {object} error
+func (h *ComboHandler) GetAll(echo echo.Context) error {
+	combos, err := h.comboController.GetAll()
+
+	if err != nil {
+		return echo.JSON(httpStatusFromError(err), err.Error())
+	}
+
+	return echo.JSON(http.StatusOK, combos)
+}
+
+// Create godoc
+// @Summary      Insert Combo
+// @Description  Insert a combo. Each slot lists the items of its category the customer may choose
+// @Tags         Combos
+// @Accept       json
+// @Produce      json
+// @Param        Combo	body dto.ComboDto true "Combo to insert"
+// @Param        Idempotency-Key header string false "Key that makes retries return the original combo instead of creating a new one"
+// @Router       /v1/combo [post]
+// @Success 200  {object} domain.Combo
+// @Failure 400  {object} error
+// @Failure 500  {object} error
+func (h *ComboHandler) Create(echo echo.Context) error {
+	comboDto := dto.ComboDto{}
+
+	err := echo.Bind(&comboDto)
+	if err != nil {
+		return echo.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	combo, err := h.comboController.Create(comboDto)
+	if err != nil {
+		return echo.JSON(httpStatusFromError(err), errorResponse(err))
+	}
+
+	return echo.JSON(http.StatusOK, combo)
+}
+
+// Update godoc
+// @Summary      Update Combo
+// @Description  Update a combo, replacing its slots
+// @Tags         Combos
+// @Accept       json
+// @Produce      json
+// @Param        id     path int          true "ID do combo"
+// @Param        Combo	body dto.ComboDto true "Combo to update"
+// @Router       /v1/combo/{id} [put]
+// @Success 200  {object} domain.Combo
+// @Failure 400  {object} error
+// @Failure 404  {object} error
+// @Failure 500  {object} error
+func (h *ComboHandler) Update(echo echo.Context) error {
+	comboDto := dto.ComboDto{}
+
+	err := echo.Bind(&comboDto)
+	if err != nil {
+		return echo.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	id, err := strconv.Atoi(echo.Param("id"))
+	if err != nil {
+		return echo.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	combo, err := h.comboController.Update(id, comboDto)
+	if err != nil {
+		return echo.JSON(httpStatusFromError(err), errorResponse(err))
+	}
+
+	return echo.JSON(http.StatusOK, combo)
+}
+
+// Delete godoc
+// @Summary      Delete Combo
+// @Description  Delete Combo
+// @Tags         Combos
+// @Produce      json
+// @Param        id path int true "ID do combo"
+// @Router       /v1/combo/{id} [delete]
+// @Success 200  {string} string "combo deleted successfully"
+// @Failure 404  {object} error
+// @Failure 500  {object} error
+func (h *ComboHandler) Delete(echo echo.Context) error {
+	id, err := strconv.Atoi(echo.Param("id"))
+	if err != nil {
+		return echo.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	err = h.comboController.Delete(id)
+	if err != nil {
+		return echo.JSON(httpStatusFromError(err), err.Error())
+	}
+
+	return echo.JSON(http.StatusOK, "combo deleted successfully")
+}
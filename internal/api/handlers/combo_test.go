@@ -0,0 +1,118 @@
+package handlers
+
+import (
+	"errors"
+	"github.com/8soat-grupo35/fastfood-order/internal/adapters/dto"
+	custom_errors "github.com/8soat-grupo35/fastfood-order/internal/api/errors"
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+	mockControllers "github.com/8soat-grupo35/fastfood-order/internal/interfaces/controllers/mock"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type ComboHandlerSuite struct {
+	suite.Suite
+	ctrl       *gomock.Controller
+	controller *mockControllers.MockComboController
+	handler    *ComboHandler
+	e          *echo.Echo
+}
+
+func (suite *ComboHandlerSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.controller = mockControllers.NewMockComboController(suite.ctrl)
+	suite.handler = &ComboHandler{comboController: suite.controller}
+	suite.e = echo.New()
+}
+
+func (suite *ComboHandlerSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func (suite *ComboHandlerSuite) TestGetAll() {
+	expectedCombos := []entities.Combo{{ID: 7, Name: "Combo Classico", PricingRule: entities.COMBO_FIXED_PRICE, Price: 3500, Slots: []entities.ComboSlot{
+		{ID: 1, Category: "LANCHE", Items: []entities.ComboSlotItem{{ItemID: 1}}},
+	}}}
+
+	suite.controller.EXPECT().GetAll().Return(expectedCombos, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/combo", nil)
+	rec := httptest.NewRecorder()
+	c := suite.e.NewContext(req, rec)
+
+	err := suite.handler.GetAll(c)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusOK, rec.Code)
+	assert.Contains(suite.T(), rec.Body.String(), `"slots":[{"id":1,"category":"LANCHE","items":[{"item_id":1}]}]`)
+}
+
+func (suite *ComboHandlerSuite) TestCreate() {
+	suite.controller.EXPECT().Create(gomock.Any()).DoAndReturn(func(comboDto dto.ComboDto) (*entities.Combo, error) {
+		assert.Equal(suite.T(), []uint32{2, 3}, comboDto.Slots[0].ItemIDs)
+		return &entities.Combo{ID: 7, Name: comboDto.Name}, nil
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/combo", strings.NewReader(`{"name":"Combo Classico","pricing_rule":"PRECO_FIXO","price":35,"slots":[{"category":"BEBIDA","item_ids":[2,3]}]}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := suite.e.NewContext(req, rec)
+
+	err := suite.handler.Create(c)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusOK, rec.Code)
+}
+
+func (suite *ComboHandlerSuite) TestCreateReturnsValidationDetails() {
+	suite.controller.EXPECT().Create(gomock.Any()).Return(nil, custom_errors.NewValidationError(validation.Errors{"slots": errors.New("cannot be blank")}))
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/combo", strings.NewReader(`{"name":"Combo Classico"}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := suite.e.NewContext(req, rec)
+
+	err := suite.handler.Create(c)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusBadRequest, rec.Code)
+	assert.Contains(suite.T(), rec.Body.String(), `"field":"slots"`)
+}
+
+func (suite *ComboHandlerSuite) TestUpdateReturnsNotFound() {
+	suite.controller.EXPECT().Update(9, gomock.Any()).Return(nil, &custom_errors.NotFoundError{Message: "combo not found to update"})
+
+	req := httptest.NewRequest(http.MethodPut, "/v1/combo/9", strings.NewReader(`{"name":"Combo Classico"}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := suite.e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("9")
+
+	err := suite.handler.Update(c)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusNotFound, rec.Code)
+}
+
+func (suite *ComboHandlerSuite) TestDelete() {
+	suite.controller.EXPECT().Delete(7).Return(nil)
+
+	req := httptest.NewRequest(http.MethodDelete, "/v1/combo/7", nil)
+	rec := httptest.NewRecorder()
+	c := suite.e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("7")
+
+	err := suite.handler.Delete(c)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusOK, rec.Code)
+	assert.Equal(suite.T(), `"combo deleted successfully"`+"\n", rec.Body.String())
+}
+
+func TestComboHandlerSuite(t *testing.T) {
+	suite.Run(t, new(ComboHandlerSuite))
+}
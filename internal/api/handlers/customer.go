@@ -37,6 +37,7 @@ func (h *CustomerHandler) GetAll(echo echo.Context) error {
 // @Accept       json
 // @Produce      json
 // @Param        CustomerToInsert	body dto.CustomerDto true "teste"
+// @Param        Idempotency-Key header string false "Key that makes retries return the original customer instead of creating a new one"
 // @Router       /v1/customer [post]
 // @success 200 {array} domain.Customer
 // @Failure 500 {object} error
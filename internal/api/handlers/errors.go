@@ -0,0 +1,42 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	custom_errors "github.com/8soat-grupo35/fastfood-order/internal/api/errors"
+)
+
+// errorResponse keeps the plain message body used by every handler, unless the error lists the fields that
+// failed or tells when the store opens again.
+func errorResponse(err error) interface{} {
+	var badRequestError *custom_errors.BadRequestError
+	if errors.As(err, &badRequestError) && len(badRequestError.Details) > 0 {
+		return badRequestError
+	}
+
+	var storeClosedError *custom_errors.StoreClosedError
+	if errors.As(err, &storeClosedError) {
+		return storeClosedError
+	}
+
+	return err.Error()
+}
+
+func httpStatusFromError(err error) int {
+	var badRequestError *custom_errors.BadRequestError
+	var notFoundError *custom_errors.NotFoundError
+	var conflictError *custom_errors.ConflictError
+	var storeClosedError *custom_errors.StoreClosedError
+
+	switch {
+	case errors.As(err, &badRequestError):
+		return http.StatusBadRequest
+	case errors.As(err, &notFoundError):
+		return http.StatusNotFound
+	case errors.As(err, &conflictError), errors.As(err, &storeClosedError):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,93 @@
+package handlers
+
+import (
+	"bytes"
+	"github.com/8soat-grupo35/fastfood-order/internal/controllers"
+	controllersInterface "github.com/8soat-grupo35/fastfood-order/internal/interfaces/controllers"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+)
+
+const (
+	IdempotencyKeyHeader     = "Idempotency-Key"
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+)
+
+type IdempotencyKeyHandler struct {
+	idempotencyKeyController controllersInterface.IdempotencyKeyController
+}
+
+func NewIdempotencyKeyHandler(db *gorm.DB) IdempotencyKeyHandler {
+	return IdempotencyKeyHandler{
+		idempotencyKeyController: controllers.NewIdempotencyKeyController(db),
+	}
+}
+
+// Middleware makes a create route safe to retry: requests carrying an Idempotency-Key header run once and
+// every retry with the same key and body gets the original response back.
+func (h *IdempotencyKeyHandler) Middleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(echo echo.Context) error {
+		key := echo.Request().Header.Get(IdempotencyKeyHeader)
+		if key == "" {
+			return next(echo)
+		}
+
+		requestBody, err := io.ReadAll(echo.Request().Body)
+		if err != nil {
+			return echo.JSON(http.StatusBadRequest, err.Error())
+		}
+		echo.Request().Body = io.NopCloser(bytes.NewReader(requestBody))
+
+		// The request path, unlike the route, tells apart the same key sent for different orders, and the
+		// store tells apart the same key sent to different stores.
+		scope := echo.Request().Method + " " + echo.Request().URL.Path
+		if store, err := currentStore(echo); err == nil {
+			scope += " store " + strconv.FormatUint(uint64(store.ID), 10)
+		}
+		idempotencyKey, err := h.idempotencyKeyController.Begin(key, scope, requestBody)
+		if err != nil {
+			return echo.JSON(httpStatusFromError(err), err.Error())
+		}
+
+		if idempotencyKey.IsCompleted() {
+			echo.Response().Header().Set(IdempotentReplayedHeader, "true")
+			return echo.JSONBlob(idempotencyKey.ResponseStatus, []byte(idempotencyKey.ResponseBody))
+		}
+
+		responseBody := &bytes.Buffer{}
+		echo.Response().Writer = &responseRecorder{ResponseWriter: echo.Response().Writer, body: responseBody}
+
+		err = next(echo)
+
+		status := echo.Response().Status
+		if err != nil || status < http.StatusOK || status >= http.StatusMultipleChoices {
+			releaseErr := h.idempotencyKeyController.Release(*idempotencyKey)
+			if releaseErr != nil {
+				log.Println(releaseErr.Error())
+			}
+			return err
+		}
+
+		completeErr := h.idempotencyKeyController.Complete(*idempotencyKey, status, responseBody.Bytes())
+		if completeErr != nil {
+			log.Println(completeErr.Error())
+		}
+
+		return nil
+	}
+}
+
+type responseRecorder struct {
+	http.ResponseWriter
+	body *bytes.Buffer
+}
+
+func (r *responseRecorder) Write(data []byte) (int, error) {
+	r.body.Write(data)
+	return r.ResponseWriter.Write(data)
+}
@@ -0,0 +1,138 @@
+package handlers
+
+import (
+	custom_errors "github.com/8soat-grupo35/fastfood-order/internal/api/errors"
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+	mockControllers "github.com/8soat-grupo35/fastfood-order/internal/interfaces/controllers/mock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type IdempotencyKeyHandlerSuite struct {
+	suite.Suite
+	ctrl       *gomock.Controller
+	controller *mockControllers.MockIdempotencyKeyController
+	handler    *IdempotencyKeyHandler
+	e          *echo.Echo
+	calls      int
+	next       echo.HandlerFunc
+}
+
+func (suite *IdempotencyKeyHandlerSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.controller = mockControllers.NewMockIdempotencyKeyController(suite.ctrl)
+	suite.handler = &IdempotencyKeyHandler{idempotencyKeyController: suite.controller}
+	suite.e = echo.New()
+	suite.calls = 0
+	suite.next = func(c echo.Context) error {
+		suite.calls++
+		return c.JSON(http.StatusOK, map[string]int{"id": 1})
+	}
+}
+
+func (suite *IdempotencyKeyHandlerSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func (suite *IdempotencyKeyHandlerSuite) newContext(key string, body string) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(http.MethodPost, "/v1/orders/checkout", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	if key != "" {
+		req.Header.Set(IdempotencyKeyHeader, key)
+	}
+	rec := httptest.NewRecorder()
+	c := suite.e.NewContext(req, rec)
+	c.SetPath("/v1/orders/checkout")
+	return c, rec
+}
+
+func (suite *IdempotencyKeyHandlerSuite) TestMiddlewareWithoutKeyCallsHandler() {
+	c, rec := suite.newContext("", `{}`)
+
+	err := suite.handler.Middleware(suite.next)(c)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusOK, rec.Code)
+	assert.Equal(suite.T(), 1, suite.calls)
+}
+
+func (suite *IdempotencyKeyHandlerSuite) TestMiddlewareStoresFirstResponse() {
+	reservedKey := &entities.IdempotencyKey{ID: 1, Key: "abc"}
+
+	suite.controller.EXPECT().Begin("abc", "POST /v1/orders/checkout", []byte(`{}`)).Return(reservedKey, nil)
+	suite.controller.EXPECT().Complete(*reservedKey, http.StatusOK, []byte(`{"id":1}`+"\n")).Return(nil)
+
+	c, rec := suite.newContext("abc", `{}`)
+
+	err := suite.handler.Middleware(suite.next)(c)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusOK, rec.Code)
+	assert.Equal(suite.T(), 1, suite.calls)
+}
+
+func (suite *IdempotencyKeyHandlerSuite) TestMiddlewareScopesKeyToStore() {
+	reservedKey := &entities.IdempotencyKey{ID: 1, Key: "abc"}
+
+	suite.controller.EXPECT().Begin("abc", "POST /v1/orders/checkout store 2", []byte(`{}`)).Return(reservedKey, nil)
+	suite.controller.EXPECT().Complete(*reservedKey, http.StatusOK, []byte(`{"id":1}`+"\n")).Return(nil)
+
+	c, rec := suite.newContext("abc", `{}`)
+	c.Set(storeContextKey, entities.Store{ID: 2})
+
+	err := suite.handler.Middleware(suite.next)(c)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusOK, rec.Code)
+}
+
+func (suite *IdempotencyKeyHandlerSuite) TestMiddlewareReplaysCompletedResponse() {
+	storedKey := &entities.IdempotencyKey{ID: 1, Key: "abc", ResponseStatus: http.StatusOK, ResponseBody: `{"id":1}`}
+
+	suite.controller.EXPECT().Begin("abc", "POST /v1/orders/checkout", []byte(`{}`)).Return(storedKey, nil)
+
+	c, rec := suite.newContext("abc", `{}`)
+
+	err := suite.handler.Middleware(suite.next)(c)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusOK, rec.Code)
+	assert.Equal(suite.T(), `{"id":1}`, rec.Body.String())
+	assert.Equal(suite.T(), "true", rec.Header().Get(IdempotentReplayedHeader))
+	assert.Equal(suite.T(), 0, suite.calls)
+}
+
+func (suite *IdempotencyKeyHandlerSuite) TestMiddlewareReturnsConflictOnDifferentBody() {
+	suite.controller.EXPECT().Begin("abc", "POST /v1/orders/checkout", []byte(`{"a":2}`)).Return(nil, &custom_errors.ConflictError{
+		Message: "idempotency key was already used with a different request",
+	})
+
+	c, rec := suite.newContext("abc", `{"a":2}`)
+
+	err := suite.handler.Middleware(suite.next)(c)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusConflict, rec.Code)
+	assert.Equal(suite.T(), 0, suite.calls)
+}
+
+func (suite *IdempotencyKeyHandlerSuite) TestMiddlewareReleasesKeyOnFailedResponse() {
+	reservedKey := &entities.IdempotencyKey{ID: 1, Key: "abc"}
+
+	suite.controller.EXPECT().Begin("abc", "POST /v1/orders/checkout", []byte(`{}`)).Return(reservedKey, nil)
+	suite.controller.EXPECT().Release(*reservedKey).Return(nil)
+
+	c, rec := suite.newContext("abc", `{}`)
+	failingNext := func(c echo.Context) error {
+		return c.JSON(http.StatusInternalServerError, "create order on repository has failed")
+	}
+
+	err := suite.handler.Middleware(failingNext)(c)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusInternalServerError, rec.Code)
+}
+
+func TestIdempotencyKeyHandlerSuite(t *testing.T) {
+	suite.Run(t, new(IdempotencyKeyHandlerSuite))
+}
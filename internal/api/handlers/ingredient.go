@@ -0,0 +1,169 @@
+package handlers
+
+import (
+	"github.com/8soat-grupo35/fastfood-order/internal/adapters/dto"
+	"github.com/8soat-grupo35/fastfood-order/internal/controllers"
+	controllersInterface "github.com/8soat-grupo35/fastfood-order/internal/interfaces/controllers"
+	"gorm.io/gorm"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+type IngredientHandler struct {
+	ingredientController controllersInterface.IngredientController
+}
+
+func NewIngredientHandler(db *gorm.DB) IngredientHandler {
+	return IngredientHandler{
+		ingredientController: controllers.NewIngredientController(db),
+	}
+}
+
+// GetAll godoc
+// @Summary      List Ingredients
+// @Description  List every ingredient with its stock at the store
+// @Tags         Ingredients
+// @Produce      json
+// @Param        X-Store-ID header int false "Store of the request, the default store when missing"
+// @Router       /v1/ingredient [get]
+// @Success 200  {array} domain.Ingredient
+// @Failure 500  {object} error
+func (h *IngredientHandler) GetAll(echo echo.Context) error {
+	store, err := currentStore(echo)
+	if err != nil {
+		return echo.JSON(httpStatusFromError(err), err.Error())
+	}
+
+	ingredients, err := h.ingredientController.GetAll(store)
+
+	if err != nil {
+		return echo.JSON(httpStatusFromError(err), err.Error())
+	}
+
+	return echo.JSON(http.StatusOK, ingredients)
+}
+
+// GetLowStock godoc
+// @Summary      Low Stock Report
+// @Description  List the ingredients below their low stock threshold at the store, the closest to running out first
+// @Tags         Ingredients
+// @Produce      json
+// @Param        X-Store-ID header int false "Store of the request, the default store when missing"
+// @Router       /v1/ingredient/low-stock [get]
+// @Success 200  {array} domain.Ingredient
+// @Failure 500  {object} error
+func (h *IngredientHandler) GetLowStock(echo echo.Context) error {
+	store, err := currentStore(echo)
+	if err != nil {
+		return echo.JSON(httpStatusFromError(err), err.Error())
+	}
+
+	ingredients, err := h.ingredientController.GetLowStock(store)
+
+	if err != nil {
+		return echo.JSON(httpStatusFromError(err), err.Error())
+	}
+
+	return echo.JSON(http.StatusOK, ingredients)
+}
+
+// Create godoc
+// @Summary      Insert Ingredient
+// @Description  Insert an ingredient with its unit of measure and its stock at the store
+// @Tags         Ingredients
+// @Accept       json
+// @Produce      json
+// @Param        Ingredient	body dto.IngredientDto true "Ingredient to insert"
+// @Param        Idempotency-Key header string false "Key that makes retries return the original ingredient instead of creating a new one"
+// @Param        X-Store-ID header int false "Store of the request, the default store when missing"
+// @Router       /v1/ingredient [post]
+// @Success 200  {object} domain.Ingredient
+// @Failure 400  {object} error
+// @Failure 500  {object} error
+func (h *IngredientHandler) Create(echo echo.Context) error {
+	ingredientDto := dto.IngredientDto{}
+
+	err := echo.Bind(&ingredientDto)
+	if err != nil {
+		return echo.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	store, err := currentStore(echo)
+	if err != nil {
+		return echo.JSON(httpStatusFromError(err), err.Error())
+	}
+
+	ingredient, err := h.ingredientController.Create(store, ingredientDto)
+	if err != nil {
+		return echo.JSON(httpStatusFromError(err), errorResponse(err))
+	}
+
+	return echo.JSON(http.StatusOK, ingredient)
+}
+
+// Update godoc
+// @Summary      Update Ingredient
+// @Description  Update an ingredient. The stock given replaces the one stored at the store, as after counting it
+// @Tags         Ingredients
+// @Accept       json
+// @Produce      json
+// @Param        id     path int          true "ID do ingrediente"
+// @Param        Ingredient	body dto.IngredientDto true "Ingredient to update"
+// @Param        X-Store-ID header int false "Store of the request, the default store when missing"
+// @Router       /v1/ingredient/{id} [put]
+// @Success 200  {object} domain.Ingredient
+// @Failure 400  {object} error
+// @Failure 404  {object} error
+// @Failure 500  {object} error
+func (h *IngredientHandler) Update(echo echo.Context) error {
+	ingredientDto := dto.IngredientDto{}
+
+	err := echo.Bind(&ingredientDto)
+	if err != nil {
+		return echo.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	id, err := strconv.Atoi(echo.Param("id"))
+	if err != nil {
+		return echo.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	store, err := currentStore(echo)
+	if err != nil {
+		return echo.JSON(httpStatusFromError(err), err.Error())
+	}
+
+	ingredient, err := h.ingredientController.Update(store, id, ingredientDto)
+	if err != nil {
+		return echo.JSON(httpStatusFromError(err), errorResponse(err))
+	}
+
+	return echo.JSON(http.StatusOK, ingredient)
+}
+
+// Delete godoc
+// @Summary      Delete Ingredient
+// @Description  Delete an ingredient, taking it out of the recipes that use it
+// @Tags         Ingredients
+// @Produce      json
+// @Param        id path int true "ID do ingrediente"
+// @Param        X-Store-ID header int false "Store of the request, the default store when missing"
+// @Router       /v1/ingredient/{id} [delete]
+// @Success 200  {string} string "ingredient deleted successfully"
+// @Failure 404  {object} error
+// @Failure 500  {object} error
+func (h *IngredientHandler) Delete(echo echo.Context) error {
+	id, err := strconv.Atoi(echo.Param("id"))
+	if err != nil {
+		return echo.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	err = h.ingredientController.Delete(id)
+	if err != nil {
+		return echo.JSON(httpStatusFromError(err), err.Error())
+	}
+
+	return echo.JSON(http.StatusOK, "ingredient deleted successfully")
+}
@@ -0,0 +1,116 @@
+package handlers
+
+import (
+	"github.com/8soat-grupo35/fastfood-order/internal/adapters/dto"
+	custom_errors "github.com/8soat-grupo35/fastfood-order/internal/api/errors"
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+	mockControllers "github.com/8soat-grupo35/fastfood-order/internal/interfaces/controllers/mock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type IngredientHandlerSuite struct {
+	suite.Suite
+	ctrl       *gomock.Controller
+	controller *mockControllers.MockIngredientController
+	handler    *IngredientHandler
+	e          *echo.Echo
+}
+
+func (suite *IngredientHandlerSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.controller = mockControllers.NewMockIngredientController(suite.ctrl)
+	suite.handler = &IngredientHandler{ingredientController: suite.controller}
+	suite.e = echo.New()
+}
+
+func (suite *IngredientHandlerSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func (suite *IngredientHandlerSuite) TestGetLowStock() {
+	expectedIngredients := []entities.Ingredient{{ID: 5, Name: "Queijo", Unit: entities.INGREDIENT_GRAM, Stock: 400000, LowStockThreshold: 500000}}
+
+	suite.controller.EXPECT().GetLowStock(matriz).Return(expectedIngredients, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/ingredient/low-stock", nil)
+	rec := httptest.NewRecorder()
+	c := suite.e.NewContext(req, rec)
+	c.Set(storeContextKey, matriz)
+
+	err := suite.handler.GetLowStock(c)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusOK, rec.Code)
+	assert.Contains(suite.T(), rec.Body.String(), `"unit":"G","stock":400,"low_stock_threshold":500`)
+}
+
+func (suite *IngredientHandlerSuite) TestGetLowStockReturnsErrorOnFailure() {
+	suite.controller.EXPECT().GetLowStock(matriz).Return(nil, &custom_errors.DatabaseError{Message: "get low stock ingredients from repository has failed"})
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/ingredient/low-stock", nil)
+	rec := httptest.NewRecorder()
+	c := suite.e.NewContext(req, rec)
+	c.Set(storeContextKey, matriz)
+
+	err := suite.handler.GetLowStock(c)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusInternalServerError, rec.Code)
+}
+
+func (suite *IngredientHandlerSuite) TestCreate() {
+	suite.controller.EXPECT().Create(matriz, gomock.Any()).DoAndReturn(func(store entities.Store, ingredientDto dto.IngredientDto) (*entities.Ingredient, error) {
+		assert.Equal(suite.T(), 0.5, ingredientDto.LowStockThreshold)
+		return &entities.Ingredient{ID: 5, Name: ingredientDto.Name}, nil
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/ingredient", strings.NewReader(`{"name":"Leite","unit":"L","stock":12,"low_stock_threshold":0.5}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := suite.e.NewContext(req, rec)
+	c.Set(storeContextKey, matriz)
+
+	err := suite.handler.Create(c)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusOK, rec.Code)
+}
+
+func (suite *IngredientHandlerSuite) TestUpdateReturnsNotFound() {
+	suite.controller.EXPECT().Update(matriz, 9, gomock.Any()).Return(nil, &custom_errors.NotFoundError{Message: "ingredient not found to update"})
+
+	req := httptest.NewRequest(http.MethodPut, "/v1/ingredient/9", strings.NewReader(`{"name":"Leite","unit":"L"}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := suite.e.NewContext(req, rec)
+	c.Set(storeContextKey, matriz)
+	c.SetParamNames("id")
+	c.SetParamValues("9")
+
+	err := suite.handler.Update(c)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusNotFound, rec.Code)
+}
+
+func (suite *IngredientHandlerSuite) TestDelete() {
+	suite.controller.EXPECT().Delete(5).Return(nil)
+
+	req := httptest.NewRequest(http.MethodDelete, "/v1/ingredient/5", nil)
+	rec := httptest.NewRecorder()
+	c := suite.e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("5")
+
+	err := suite.handler.Delete(c)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusOK, rec.Code)
+	assert.Equal(suite.T(), `"ingredient deleted successfully"`+"\n", rec.Body.String())
+}
+
+func TestIngredientHandlerSuite(t *testing.T) {
+	suite.Run(t, new(IngredientHandlerSuite))
+}
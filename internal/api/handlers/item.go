@@ -23,17 +23,35 @@ func NewItemHandler(db *gorm.DB) ItemHandler {
 
 // GetAll godoc
 // @Summary      List Items
-// @Description  List All Items
+// @Description  List the items on sale now at the store, priced by the menus of the store open now
 // @Tags         Items
 // @Accept       json
 // @Produce      json
+// @Param        category query string false "Category of the items"
+// @Param        include_off_sale query bool false "Also list the items paused or out of their menus, for the admin"
+// @Param        X-Store-ID header int false "Store of the request, the default store when missing"
 // @Router       /v1/item [get]
 // @success 200  {object} domain.Item
+// @Failure 400 {object} error
 // @Failure 500 {object} error
 func (h *ItemHandler) GetAll(echo echo.Context) error {
 	category := echo.QueryParam("category")
 
-	items, err := h.itemController.GetAllByCategory(category)
+	includeOffSale := false
+	if include := echo.QueryParam("include_off_sale"); include != "" {
+		var err error
+		includeOffSale, err = strconv.ParseBool(include)
+		if err != nil {
+			return echo.JSON(http.StatusBadRequest, err.Error())
+		}
+	}
+
+	store, err := currentStore(echo)
+	if err != nil {
+		return echo.JSON(httpStatusFromError(err), err.Error())
+	}
+
+	items, err := h.itemController.GetAllByCategory(store, category, includeOffSale)
 
 	if err != nil {
 		return echo.JSON(http.StatusInternalServerError, err.Error())
@@ -49,6 +67,8 @@ func (h *ItemHandler) GetAll(echo echo.Context) error {
 // @Accept       json
 // @Produce      json
 // @Param        ItemToInsert	body dto.ItemDto true "teste"
+// @Param        Idempotency-Key header string false "Key that makes retries return the original item instead of creating a new one"
+// @Param        X-Store-ID header int false "Store of the request, the default store when missing"
 // @Router       /v1/item [post]
 // @success 200 {array} domain.Item
 // @Failure 500 {object} error
@@ -61,7 +81,12 @@ func (h *ItemHandler) Create(echo echo.Context) error {
 		return echo.JSON(http.StatusBadRequest, err.Error())
 	}
 
-	item, err := h.itemController.Create(itemDto)
+	store, err := currentStore(echo)
+	if err != nil {
+		return echo.JSON(httpStatusFromError(err), err.Error())
+	}
+
+	item, err := h.itemController.Create(store, itemDto)
 
 	if err != nil {
 		return echo.JSON(http.StatusInternalServerError, err.Error())
@@ -78,6 +103,7 @@ func (h *ItemHandler) Create(echo echo.Context) error {
 // @Produce      json
 // @Param		 id             path int         true "ID do item"
 // @Param        ItemToInsert	body dto.ItemDto true "teste"
+// @Param        X-Store-ID header int false "Store of the request, the default store when missing"
 // @Router       /v1/item/{id} [put]
 // @success 200 {array} domain.Item
 // @Failure 500 {object} error
@@ -95,7 +121,12 @@ func (h *ItemHandler) Update(echo echo.Context) error {
 		return echo.JSON(http.StatusBadRequest, err.Error())
 	}
 
-	item, err := h.itemController.Update(id, itemDto)
+	store, err := currentStore(echo)
+	if err != nil {
+		return echo.JSON(httpStatusFromError(err), err.Error())
+	}
+
+	item, err := h.itemController.Update(store, id, itemDto)
 
 	if err != nil {
 		return echo.JSON(http.StatusInternalServerError, err.Error())
@@ -104,6 +135,46 @@ func (h *ItemHandler) Update(echo echo.Context) error {
 	return echo.JSON(http.StatusOK, item)
 }
 
+// SetAvailability godoc
+// @Summary      Set Item Availability
+// @Description  Pause an item, taking it off sale without deleting it, or make it available again
+// @Tags         Items
+// @Accept       json
+// @Produce      json
+// @Param		 id             path int         true "ID do item"
+// @Param        Availability	body dto.ItemAvailabilityDto true "Availability of the item"
+// @Param        X-Store-ID header int false "Store of the request, the default store when missing"
+// @Router       /v1/item/{id}/availability [patch]
+// @success 200 {object} domain.Item
+// @Failure 400 {object} error
+// @Failure 404 {object} error
+// @Failure 500 {object} error
+func (h *ItemHandler) SetAvailability(echo echo.Context) error {
+	availabilityDto := dto.ItemAvailabilityDto{}
+
+	err := echo.Bind(&availabilityDto)
+	if err != nil {
+		return echo.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	id, err := strconv.Atoi(echo.Param("id"))
+	if err != nil {
+		return echo.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	store, err := currentStore(echo)
+	if err != nil {
+		return echo.JSON(httpStatusFromError(err), err.Error())
+	}
+
+	item, err := h.itemController.SetAvailability(store, id, availabilityDto)
+	if err != nil {
+		return echo.JSON(httpStatusFromError(err), errorResponse(err))
+	}
+
+	return echo.JSON(http.StatusOK, item)
+}
+
 // Delete godoc
 // @Summary      Delete Item
 // @Description  Delete Item
@@ -111,6 +182,7 @@ func (h *ItemHandler) Update(echo echo.Context) error {
 // @Accept       json
 // @Produce      json
 // @Param		 id             path int         true "ID do item"
+// @Param        X-Store-ID header int false "Store of the request, the default store when missing"
 // @Router       /v1/item/{id} [delete]
 // @success 200 {string}  string    "item deleted successfully"
 // @Failure 500 {object} error
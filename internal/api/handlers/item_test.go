@@ -1,6 +1,8 @@
 package handlers
 
 import (
+	"github.com/8soat-grupo35/fastfood-order/internal/adapters/dto"
+	custom_errors "github.com/8soat-grupo35/fastfood-order/internal/api/errors"
 	"github.com/8soat-grupo35/fastfood-order/internal/entities"
 	mockControllers "github.com/8soat-grupo35/fastfood-order/internal/interfaces/controllers/mock"
 	"github.com/labstack/echo/v4"
@@ -34,53 +36,137 @@ func (suite *ItemHandlerSuite) TearDownTest() {
 
 func (suite *ItemHandlerSuite) TestGetAll() {
 	expectedItems := []entities.Item{
-		{ID: 1, Name: "Burger", Category: "LANCHE"},
+		{ID: 1, Name: "Burger", Category: "LANCHE", CategoryID: 1, Available: true},
 	}
 
-	suite.controller.EXPECT().GetAllByCategory(gomock.Any()).Return(expectedItems, nil)
+	suite.controller.EXPECT().GetAllByCategory(matriz, gomock.Any(), false).Return(expectedItems, nil)
 
 	req := httptest.NewRequest(http.MethodGet, "/v1/item", nil)
 	rec := httptest.NewRecorder()
 	c := suite.e.NewContext(req, rec)
+	c.Set(storeContextKey, matriz)
 
 	err := suite.handler.GetAll(c)
 	assert.NoError(suite.T(), err)
 	assert.Equal(suite.T(), http.StatusOK, rec.Code)
-	assert.Equal(suite.T(), `[{"ID":1,"Name":"Burger","Category":"LANCHE","Price":0,"ImageUrl":"","CreatedAt":"0001-01-01T00:00:00Z","UpdatedAt":"0001-01-01T00:00:00Z","DeletedAt":null}]`+"\n", rec.Body.String())
+	assert.Equal(suite.T(), `[{"ID":1,"Name":"Burger","Category":"LANCHE","CategoryID":1,"Price":0.00,"ImageUrl":"","Paused":false,"Available":true,"CreatedAt":"0001-01-01T00:00:00Z","UpdatedAt":"0001-01-01T00:00:00Z","DeletedAt":null}]`+"\n", rec.Body.String())
+}
+
+func (suite *ItemHandlerSuite) TestGetAllNestsVariants() {
+	expectedItems := []entities.Item{
+		{ID: 1, Name: "Refrigerante", Category: "BEBIDA", Price: 790, Variants: []entities.ItemVariant{
+			{ID: 1, Label: "P", PriceDelta: -200, Available: true},
+			{ID: 2, Label: "G", PriceDelta: 250, Available: false},
+		}},
+	}
+
+	suite.controller.EXPECT().GetAllByCategory(matriz, "BEBIDA", false).Return(expectedItems, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/item?category=BEBIDA", nil)
+	rec := httptest.NewRecorder()
+	c := suite.e.NewContext(req, rec)
+	c.Set(storeContextKey, matriz)
+
+	err := suite.handler.GetAll(c)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusOK, rec.Code)
+	assert.Contains(suite.T(), rec.Body.String(), `"Variants":[{"ID":1,"Label":"P","PriceDelta":-2.00,"Available":true},{"ID":2,"Label":"G","PriceDelta":2.50,"Available":false}]`)
+}
+
+func (suite *ItemHandlerSuite) TestGetAllIncludesItemsOffSaleForTheAdmin() {
+	suite.controller.EXPECT().GetAllByCategory(matriz, "", true).Return([]entities.Item{}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/item?include_off_sale=true", nil)
+	rec := httptest.NewRecorder()
+	c := suite.e.NewContext(req, rec)
+	c.Set(storeContextKey, matriz)
+
+	err := suite.handler.GetAll(c)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusOK, rec.Code)
+}
+
+func (suite *ItemHandlerSuite) TestGetAllReturnsBadRequestOnInvalidIncludeOffSale() {
+	req := httptest.NewRequest(http.MethodGet, "/v1/item?include_off_sale=sim", nil)
+	rec := httptest.NewRecorder()
+	c := suite.e.NewContext(req, rec)
+	c.Set(storeContextKey, matriz)
+
+	err := suite.handler.GetAll(c)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusBadRequest, rec.Code)
 }
 
 func (suite *ItemHandlerSuite) TestCreate() {
-	newItem := &entities.Item{ID: 1, Name: "Burger", Category: "LANCHE", Price: 10, ImageUrl: "http://image.com"}
+	newItem := &entities.Item{ID: 1, Name: "Burger", Category: "LANCHE", CategoryID: 1, Price: 1000, ImageUrl: "http://image.com", Available: true}
 
-	suite.controller.EXPECT().Create(gomock.Any()).Return(newItem, nil)
+	suite.controller.EXPECT().Create(matriz, gomock.Any()).Return(newItem, nil)
 
 	req := httptest.NewRequest(http.MethodPost, "/v1/item", strings.NewReader(`{"name":"Burger","category":"LANCHE","price":10,"imageUrl":"http://image.com"}`))
 	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 	rec := httptest.NewRecorder()
 	c := suite.e.NewContext(req, rec)
+	c.Set(storeContextKey, matriz)
 
 	err := suite.handler.Create(c)
 	assert.NoError(suite.T(), err)
 	assert.Equal(suite.T(), http.StatusOK, rec.Code)
-	assert.Equal(suite.T(), `{"ID":1,"Name":"Burger","Category":"LANCHE","Price":10,"ImageUrl":"http://image.com","CreatedAt":"0001-01-01T00:00:00Z","UpdatedAt":"0001-01-01T00:00:00Z","DeletedAt":null}`+"\n", rec.Body.String())
+	assert.Equal(suite.T(), `{"ID":1,"Name":"Burger","Category":"LANCHE","CategoryID":1,"Price":10.00,"ImageUrl":"http://image.com","Paused":false,"Available":true,"CreatedAt":"0001-01-01T00:00:00Z","UpdatedAt":"0001-01-01T00:00:00Z","DeletedAt":null}`+"\n", rec.Body.String())
 }
 
 func (suite *ItemHandlerSuite) TestUpdate() {
-	itemAfterUpdate := &entities.Item{ID: 1, Name: "Burger", Category: "LANCHE", Price: 10, ImageUrl: "http://image.com"}
+	itemAfterUpdate := &entities.Item{ID: 1, Name: "Burger", Category: "LANCHE", CategoryID: 1, Price: 1000, ImageUrl: "http://image.com", Available: true}
 
-	suite.controller.EXPECT().Update(1, gomock.Any()).Return(itemAfterUpdate, nil)
+	suite.controller.EXPECT().Update(matriz, 1, gomock.Any()).Return(itemAfterUpdate, nil)
 
 	req := httptest.NewRequest(http.MethodPut, "/v1/item/1", strings.NewReader(`{"name":"Burger","category":"LANCHE","price":10,"imageUrl":"http://image.com"}`))
 	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 	rec := httptest.NewRecorder()
 	c := suite.e.NewContext(req, rec)
+	c.Set(storeContextKey, matriz)
 	c.SetParamNames("id")
 	c.SetParamValues("1")
 
 	err := suite.handler.Update(c)
 	assert.NoError(suite.T(), err)
 	assert.Equal(suite.T(), http.StatusOK, rec.Code)
-	assert.Equal(suite.T(), `{"ID":1,"Name":"Burger","Category":"LANCHE","Price":10,"ImageUrl":"http://image.com","CreatedAt":"0001-01-01T00:00:00Z","UpdatedAt":"0001-01-01T00:00:00Z","DeletedAt":null}`+"\n", rec.Body.String())
+	assert.Equal(suite.T(), `{"ID":1,"Name":"Burger","Category":"LANCHE","CategoryID":1,"Price":10.00,"ImageUrl":"http://image.com","Paused":false,"Available":true,"CreatedAt":"0001-01-01T00:00:00Z","UpdatedAt":"0001-01-01T00:00:00Z","DeletedAt":null}`+"\n", rec.Body.String())
+}
+
+func (suite *ItemHandlerSuite) TestSetAvailability() {
+	suite.controller.EXPECT().SetAvailability(matriz, 1, gomock.Any()).DoAndReturn(func(store entities.Store, itemId int, availabilityDto dto.ItemAvailabilityDto) (*entities.Item, error) {
+		assert.False(suite.T(), *availabilityDto.Available)
+		return &entities.Item{ID: 1, Name: "Burger", Paused: true}, nil
+	})
+
+	req := httptest.NewRequest(http.MethodPatch, "/v1/item/1/availability", strings.NewReader(`{"available":false}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := suite.e.NewContext(req, rec)
+	c.Set(storeContextKey, matriz)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	err := suite.handler.SetAvailability(c)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusOK, rec.Code)
+	assert.Contains(suite.T(), rec.Body.String(), `"Paused":true,"Available":false`)
+}
+
+func (suite *ItemHandlerSuite) TestSetAvailabilityReturnsNotFound() {
+	suite.controller.EXPECT().SetAvailability(matriz, 9, gomock.Any()).Return(nil, &custom_errors.NotFoundError{Message: "item not found to set availability"})
+
+	req := httptest.NewRequest(http.MethodPatch, "/v1/item/9/availability", strings.NewReader(`{"available":true}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := suite.e.NewContext(req, rec)
+	c.Set(storeContextKey, matriz)
+	c.SetParamNames("id")
+	c.SetParamValues("9")
+
+	err := suite.handler.SetAvailability(c)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusNotFound, rec.Code)
 }
 
 func (suite *ItemHandlerSuite) TestDelete() {
@@ -89,6 +175,7 @@ func (suite *ItemHandlerSuite) TestDelete() {
 	req := httptest.NewRequest(http.MethodDelete, "/v1/item/1", nil)
 	rec := httptest.NewRecorder()
 	c := suite.e.NewContext(req, rec)
+	c.Set(storeContextKey, matriz)
 	c.SetParamNames("id")
 	c.SetParamValues("1")
 
@@ -0,0 +1,150 @@
+package handlers
+
+import (
+	"github.com/8soat-grupo35/fastfood-order/internal/adapters/dto"
+	"github.com/8soat-grupo35/fastfood-order/internal/controllers"
+	controllersInterface "github.com/8soat-grupo35/fastfood-order/internal/interfaces/controllers"
+	"gorm.io/gorm"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+type MenuHandler struct {
+	menuController controllersInterface.MenuController
+}
+
+func NewMenuHandler(db *gorm.DB) MenuHandler {
+	return MenuHandler{
+		menuController: controllers.NewMenuController(db),
+	}
+}
+
+// GetAll godoc
+// @Summary      List Menus
+// @Description  List every menu of the store with its time window and items
+// @Tags         Menus
+// @Produce      json
+// @Param        X-Store-ID header int false "Store of the request, the default store when missing"
+// @Router       /v1/menu [get]
+// @Success 200  {array} domain.Menu
+// @Failure 500  {object} error
+func (h *MenuHandler) GetAll(echo echo.Context) error {
+	store, err := currentStore(echo)
+	if err != nil {
+		return echo.JSON(httpStatusFromError(err), err.Error())
+	}
+
+	menus, err := h.menuController.GetAll(store)
+
+	if err != nil {
+		return echo.JSON(httpStatusFromError(err), err.Error())
+	}
+
+	return echo.JSON(http.StatusOK, menus)
+}
+
+// Create godoc
+// @Summary      Insert Menu
+// @Description  Insert a menu of the store open in a window of the day. Items of exclusive menus are only sold while one of them is open, and menu prices replace the item prices while the menu is open
+// @Tags         Menus
+// @Accept       json
+// @Produce      json
+// @Param        Menu	body dto.MenuDto true "Menu to insert"
+// @Param        Idempotency-Key header string false "Key that makes retries return the original menu instead of creating a new one"
+// @Param        X-Store-ID header int false "Store of the request, the default store when missing"
+// @Router       /v1/menu [post]
+// @Success 200  {object} domain.Menu
+// @Failure 400  {object} error
+// @Failure 500  {object} error
+func (h *MenuHandler) Create(echo echo.Context) error {
+	menuDto := dto.MenuDto{}
+
+	err := echo.Bind(&menuDto)
+	if err != nil {
+		return echo.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	store, err := currentStore(echo)
+	if err != nil {
+		return echo.JSON(httpStatusFromError(err), err.Error())
+	}
+
+	menu, err := h.menuController.Create(store, menuDto)
+	if err != nil {
+		return echo.JSON(httpStatusFromError(err), errorResponse(err))
+	}
+
+	return echo.JSON(http.StatusOK, menu)
+}
+
+// Update godoc
+// @Summary      Update Menu
+// @Description  Update a menu, replacing its items
+// @Tags         Menus
+// @Accept       json
+// @Produce      json
+// @Param        id     path int          true "ID do menu"
+// @Param        Menu	body dto.MenuDto true "Menu to update"
+// @Param        X-Store-ID header int false "Store of the request, the default store when missing"
+// @Router       /v1/menu/{id} [put]
+// @Success 200  {object} domain.Menu
+// @Failure 400  {object} error
+// @Failure 404  {object} error
+// @Failure 500  {object} error
+func (h *MenuHandler) Update(echo echo.Context) error {
+	menuDto := dto.MenuDto{}
+
+	err := echo.Bind(&menuDto)
+	if err != nil {
+		return echo.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	id, err := strconv.Atoi(echo.Param("id"))
+	if err != nil {
+		return echo.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	store, err := currentStore(echo)
+	if err != nil {
+		return echo.JSON(httpStatusFromError(err), err.Error())
+	}
+
+	menu, err := h.menuController.Update(store, id, menuDto)
+	if err != nil {
+		return echo.JSON(httpStatusFromError(err), errorResponse(err))
+	}
+
+	return echo.JSON(http.StatusOK, menu)
+}
+
+// Delete godoc
+// @Summary      Delete Menu
+// @Description  Delete Menu
+// @Tags         Menus
+// @Produce      json
+// @Param        id path int true "ID do menu"
+// @Param        X-Store-ID header int false "Store of the request, the default store when missing"
+// @Router       /v1/menu/{id} [delete]
+// @Success 200  {string} string "menu deleted successfully"
+// @Failure 404  {object} error
+// @Failure 500  {object} error
+func (h *MenuHandler) Delete(echo echo.Context) error {
+	id, err := strconv.Atoi(echo.Param("id"))
+	if err != nil {
+		return echo.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	store, err := currentStore(echo)
+	if err != nil {
+		return echo.JSON(httpStatusFromError(err), err.Error())
+	}
+
+	err = h.menuController.Delete(store, id)
+	if err != nil {
+		return echo.JSON(httpStatusFromError(err), err.Error())
+	}
+
+	return echo.JSON(http.StatusOK, "menu deleted successfully")
+}
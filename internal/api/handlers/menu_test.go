@@ -0,0 +1,109 @@
+package handlers
+
+import (
+	"github.com/8soat-grupo35/fastfood-order/internal/adapters/dto"
+	custom_errors "github.com/8soat-grupo35/fastfood-order/internal/api/errors"
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+	mockControllers "github.com/8soat-grupo35/fastfood-order/internal/interfaces/controllers/mock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type MenuHandlerSuite struct {
+	suite.Suite
+	ctrl       *gomock.Controller
+	controller *mockControllers.MockMenuController
+	handler    *MenuHandler
+	e          *echo.Echo
+}
+
+func (suite *MenuHandlerSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.controller = mockControllers.NewMockMenuController(suite.ctrl)
+	suite.handler = &MenuHandler{menuController: suite.controller}
+	suite.e = echo.New()
+}
+
+func (suite *MenuHandlerSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func (suite *MenuHandlerSuite) TestGetAll() {
+	happyHourPrice := entities.Money(1990)
+	expectedMenus := []entities.Menu{{ID: 3, Name: "Happy hour", StartTime: "17:00", EndTime: "19:00", Items: []entities.MenuItem{
+		{ItemID: 1, Price: &happyHourPrice},
+		{ItemID: 2},
+	}}}
+
+	suite.controller.EXPECT().GetAll(matriz).Return(expectedMenus, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/menu", nil)
+	rec := httptest.NewRecorder()
+	c := suite.e.NewContext(req, rec)
+	c.Set(storeContextKey, matriz)
+
+	err := suite.handler.GetAll(c)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusOK, rec.Code)
+	assert.Contains(suite.T(), rec.Body.String(), `"start_time":"17:00","end_time":"19:00","exclusive":false,"items":[{"item_id":1,"price":19.90},{"item_id":2}]`)
+}
+
+func (suite *MenuHandlerSuite) TestCreate() {
+	suite.controller.EXPECT().Create(matriz, gomock.Any()).DoAndReturn(func(store entities.Store, menuDto dto.MenuDto) (*entities.Menu, error) {
+		assert.True(suite.T(), menuDto.Exclusive)
+		assert.Nil(suite.T(), menuDto.Items[0].Price)
+		return &entities.Menu{ID: 1, Name: menuDto.Name}, nil
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/menu", strings.NewReader(`{"name":"Café da manhã","start_time":"06:00","end_time":"11:00","exclusive":true,"items":[{"item_id":4}]}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := suite.e.NewContext(req, rec)
+	c.Set(storeContextKey, matriz)
+
+	err := suite.handler.Create(c)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusOK, rec.Code)
+}
+
+func (suite *MenuHandlerSuite) TestUpdateReturnsNotFound() {
+	suite.controller.EXPECT().Update(matriz, 9, gomock.Any()).Return(nil, &custom_errors.NotFoundError{Message: "menu not found to update"})
+
+	req := httptest.NewRequest(http.MethodPut, "/v1/menu/9", strings.NewReader(`{"name":"Happy hour"}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := suite.e.NewContext(req, rec)
+	c.Set(storeContextKey, matriz)
+	c.SetParamNames("id")
+	c.SetParamValues("9")
+
+	err := suite.handler.Update(c)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusNotFound, rec.Code)
+}
+
+func (suite *MenuHandlerSuite) TestDelete() {
+	suite.controller.EXPECT().Delete(matriz, 3).Return(nil)
+
+	req := httptest.NewRequest(http.MethodDelete, "/v1/menu/3", nil)
+	rec := httptest.NewRecorder()
+	c := suite.e.NewContext(req, rec)
+	c.Set(storeContextKey, matriz)
+	c.SetParamNames("id")
+	c.SetParamValues("3")
+
+	err := suite.handler.Delete(c)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusOK, rec.Code)
+	assert.Equal(suite.T(), `"menu deleted successfully"`+"\n", rec.Body.String())
+}
+
+func TestMenuHandlerSuite(t *testing.T) {
+	suite.Run(t, new(MenuHandlerSuite))
+}
@@ -1,56 +1,226 @@
 package handlers
 
 import (
+	"encoding/json"
+	"fmt"
 	"github.com/8soat-grupo35/fastfood-order/internal/adapters/dto"
+	"github.com/8soat-grupo35/fastfood-order/internal/api/events"
 	"github.com/8soat-grupo35/fastfood-order/internal/controllers"
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
 	controllersInterface "github.com/8soat-grupo35/fastfood-order/internal/interfaces/controllers"
 	"net/http"
-
-	httpClient "github.com/8soat-grupo35/fastfood-order/internal/adapters/http"
 	"strconv"
+	"time"
 
 	"github.com/labstack/echo/v4"
 	"gorm.io/gorm"
 )
 
+const NextCursorHeader = "X-Next-Cursor"
+
+// streamHeartbeatInterval keeps idle stream connections from being closed by proxies and load balancers.
+const streamHeartbeatInterval = 15 * time.Second
+
 type OrderHandler struct {
 	orderController controllersInterface.OrderController
+	hub             *events.Hub
 }
 
-func NewOrderHandler(db *gorm.DB, httpClient *httpClient.Client) OrderHandler {
+func NewOrderHandler(db *gorm.DB, hub *events.Hub) OrderHandler {
 	return OrderHandler{
-		orderController: controllers.NewOrderController(db, httpClient),
+		orderController: controllers.NewOrderController(db),
+		hub:             hub,
 	}
 }
 
 // GetAll godoc
 // @Summary      List Orders
-// @Description  List All Orders
+// @Description  List the orders of the store in the kitchen board ordering. Without status only the paid orders still on the kitchen board are listed. When there are more orders, the X-Next-Cursor header holds the cursor of the next page
 // @Tags         Orders
 // @Accept       json
 // @Produce      json
+// @Param        status       query []string false "Statuses to list, repeated or comma separated" collectionFormat(multi)
+// @Param        customer_id  query int      false "Customer ID"
+// @Param        created_from query string   false "Created at or after, as a date (YYYY-MM-DD) or a RFC 3339 timestamp"
+// @Param        created_to   query string   false "Created before, as a RFC 3339 timestamp, or up to the end of the date (YYYY-MM-DD)"
+// @Param        pickup_code  query string   false "Pickup code"
+// @Param        cursor       query string   false "X-Next-Cursor of the previous page"
+// @Param        limit        query int      false "Page size, at most 100. 20 by default, except for the kitchen board without other filters, which is listed whole"
+// @Param        X-Store-ID header int false "Store of the request, the default store when missing"
 // @Router       /v1/orders [get]
-// @Success 200  {object} domain.Order
+// @Success 200  {array} domain.Order
+// @Header  200  {string} X-Next-Cursor "Cursor of the next page, missing on the last page"
+// @Failure 400  {object} error
 // @Failure 500  {object} error
 func (h *OrderHandler) GetAll(echo echo.Context) error {
-	orders, err := h.orderController.GetAll()
+	filterDto := dto.OrderFilterDto{}
 
+	err := echo.Bind(&filterDto)
 	if err != nil {
-		return echo.JSON(http.StatusInternalServerError, err.Error())
+		return echo.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	store, err := currentStore(echo)
+	if err != nil {
+		return echo.JSON(httpStatusFromError(err), err.Error())
+	}
+
+	page, err := h.orderController.Search(store, filterDto)
+	if err != nil {
+		return echo.JSON(httpStatusFromError(err), errorResponse(err))
+	}
+
+	if page.NextCursor != "" {
+		echo.Response().Header().Set(NextCursorHeader, page.NextCursor)
+	}
+
+	return echo.JSON(http.StatusOK, page.Orders)
+}
+
+// GetByCustomer godoc
+// @Summary      List Customer Orders
+// @Description  Every order the customer placed at the store with its lines, newest first
+// @Tags         Customers
+// @Produce      json
+// @Param        id path int true "ID do cliente"
+// @Param        X-Store-ID header int false "Store of the request, the default store when missing"
+// @Router       /v1/customer/{id}/orders [get]
+// @Success 200  {array} domain.Order
+// @Failure 400  {object} error
+// @Failure 404  {object} error
+// @Failure 500  {object} error
+func (h *OrderHandler) GetByCustomer(echo echo.Context) error {
+	id, err := strconv.Atoi(echo.Param("id"))
+	if err != nil {
+		return echo.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	store, err := currentStore(echo)
+	if err != nil {
+		return echo.JSON(httpStatusFromError(err), err.Error())
+	}
+
+	orders, err := h.orderController.GetByCustomer(store, uint32(id))
+	if err != nil {
+		return echo.JSON(httpStatusFromError(err), err.Error())
 	}
 
 	return echo.JSON(http.StatusOK, orders)
 }
 
+// Tracking godoc
+// @Summary      Track Order
+// @Description  Current status of an order, how many orders the kitchen still has to prepare before it and when it should be ready
+// @Tags         Orders
+// @Produce      json
+// @Param        code path string true "Tracking code printed on the receipt"
+// @Param        X-Store-ID header int false "Store of the request, the default store when missing"
+// @Router       /v1/orders/{code}/tracking [get]
+// @Success 200  {object} presenters.OrderTrackingPresenter
+// @Failure 404  {object} error
+// @Failure 500  {object} error
+func (h *OrderHandler) Tracking(echo echo.Context) error {
+	store, err := currentStore(echo)
+	if err != nil {
+		return echo.JSON(httpStatusFromError(err), err.Error())
+	}
+
+	tracking, err := h.orderController.Track(store, echo.Param("code"))
+	if err != nil {
+		return echo.JSON(httpStatusFromError(err), err.Error())
+	}
+
+	return echo.JSON(http.StatusOK, tracking)
+}
+
+// Stream godoc
+// @Summary      Stream Orders
+// @Description  Server-Sent Events for the kitchen board: an orders.snapshot event with the current orders, then an order.created event when the payment of an order of the store is approved and an event for every later change, on any replica
+// @Tags         Orders
+// @Produce      text/event-stream
+// @Param        X-Store-ID header int false "Store of the request, the default store when missing"
+// @Router       /v1/orders/stream [get]
+// @Success 200  {object} domain.OrderEvent
+// @Failure 500  {object} error
+func (h *OrderHandler) Stream(echo echo.Context) error {
+	store, err := currentStore(echo)
+	if err != nil {
+		return echo.JSON(httpStatusFromError(err), err.Error())
+	}
+
+	orderEvents, unsubscribe := h.hub.Subscribe(store.ID)
+	defer unsubscribe()
+
+	orders, err := h.orderController.GetAll(store)
+	if err != nil {
+		return echo.JSON(http.StatusInternalServerError, err.Error())
+	}
+
+	response := echo.Response()
+	response.Header().Set("Content-Type", "text/event-stream")
+	response.Header().Set("Cache-Control", "no-cache")
+	response.Header().Set("Connection", "keep-alive")
+	response.Header().Set("X-Accel-Buffering", "no")
+	response.WriteHeader(http.StatusOK)
+
+	err = writeStreamEvent(response, entities.ORDER_SNAPSHOT_EVENT, orders)
+	if err != nil {
+		return nil
+	}
+
+	heartbeat := time.NewTicker(streamHeartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-echo.Request().Context().Done():
+			return nil
+		case event, open := <-orderEvents:
+			if !open {
+				return nil
+			}
+
+			err = writeStreamEvent(response, event.Type, event)
+		case <-heartbeat.C:
+			_, err = fmt.Fprint(response, ": keep-alive\n\n")
+			response.Flush()
+		}
+
+		if err != nil {
+			return nil
+		}
+	}
+}
+
+func writeStreamEvent(response *echo.Response, eventType string, data interface{}) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(response, "event: %s\ndata: %s\n\n", eventType, payload)
+	if err != nil {
+		return err
+	}
+
+	response.Flush()
+
+	return nil
+}
+
 // Create godoc
 // @Summary      Insert Order
-// @Description  Insert Order
+// @Description  Insert Order. While the store is closed the order is refused with 409 and the next opening of the store
 // @Tags         Orders
 // @Accept       json
 // @Produce      json
 // @Param        Order	body dto.OrderDto true "Order to create"
+// @Param        Idempotency-Key header string false "Key that makes retries return the original order instead of creating a new one"
+// @Param        X-Store-ID header int false "Store of the request, the default store when missing"
 // @Router       /v1/orders/checkout [post]
 // @success 200 {array} presenters.OrderPresenter
+// @Failure 400 {object} error
+// @Failure 409 {object} error
 // @Failure 500 {object} error
 func (h *OrderHandler) Checkout(echo echo.Context) error {
 	orderDto := dto.OrderDto{}
@@ -60,9 +230,154 @@ func (h *OrderHandler) Checkout(echo echo.Context) error {
 		return echo.JSON(http.StatusBadRequest, err.Error())
 	}
 
-	order, err := h.orderController.Checkout(orderDto)
+	store, err := currentStore(echo)
 	if err != nil {
-		return echo.JSON(http.StatusInternalServerError, err.Error())
+		return echo.JSON(httpStatusFromError(err), err.Error())
+	}
+
+	order, err := h.orderController.Checkout(store, orderDto)
+	if err != nil {
+		return echo.JSON(httpStatusFromError(err), errorResponse(err))
+	}
+
+	return echo.JSON(http.StatusOK, order)
+}
+
+// Reorder godoc
+// @Summary      Reorder
+// @Description  Check out a new order with the items of an earlier one. Items that are no longer available or out of stock are left out and listed in dropped_items
+// @Tags         Orders
+// @Produce      json
+// @Param        id path int true "ID do pedido"
+// @Param        Idempotency-Key header string false "Key that makes retries return the original order instead of creating a new one"
+// @Param        X-Store-ID header int false "Store of the request, the default store when missing"
+// @Router       /v1/orders/{id}/reorder [post]
+// @Success 200  {object} presenters.ReorderPresenter
+// @Failure 400  {object} error
+// @Failure 404  {object} error
+// @Failure 409  {object} error
+// @Failure 500  {object} error
+func (h *OrderHandler) Reorder(echo echo.Context) error {
+	id, err := strconv.Atoi(echo.Param("id"))
+	if err != nil {
+		return echo.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	store, err := currentStore(echo)
+	if err != nil {
+		return echo.JSON(httpStatusFromError(err), err.Error())
+	}
+
+	reorder, err := h.orderController.Reorder(store, uint32(id))
+	if err != nil {
+		return echo.JSON(httpStatusFromError(err), errorResponse(err))
+	}
+
+	return echo.JSON(http.StatusOK, reorder)
+}
+
+// Timeline godoc
+// @Summary      Order Timeline
+// @Description  Every status an order went through, oldest first, with who changed it and when
+// @Tags         Orders
+// @Produce      json
+// @Param        id path int true "ID do pedido"
+// @Param        X-Store-ID header int false "Store of the request, the default store when missing"
+// @Router       /v1/orders/{id}/timeline [get]
+// @Success 200  {array} domain.OrderStatusHistory
+// @Failure 400  {object} error
+// @Failure 404  {object} error
+// @Failure 500  {object} error
+func (h *OrderHandler) Timeline(echo echo.Context) error {
+	id, err := strconv.Atoi(echo.Param("id"))
+	if err != nil {
+		return echo.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	store, err := currentStore(echo)
+	if err != nil {
+		return echo.JSON(httpStatusFromError(err), err.Error())
+	}
+
+	timeline, err := h.orderController.Timeline(store, uint32(id))
+	if err != nil {
+		return echo.JSON(httpStatusFromError(err), err.Error())
+	}
+
+	return echo.JSON(http.StatusOK, timeline)
+}
+
+// Cancel godoc
+// @Summary      Cancel Order
+// @Description  Cancel an order that is not ready yet and request the payment reversal
+// @Tags         Orders
+// @Accept       json
+// @Produce      json
+// @Param        id     path int                true "ID do pedido"
+// @Param        Order  body dto.OrderCancelDto true "Who canceled the order and why"
+// @Param        X-Store-ID header int false "Store of the request, the default store when missing"
+// @Router       /v1/orders/{id}/cancel [post]
+// @success 200 {object} domain.Order
+// @Failure 400 {object} error
+// @Failure 404 {object} error
+// @Failure 409 {object} error
+// @Failure 500 {object} error
+func (h *OrderHandler) Cancel(echo echo.Context) error {
+	cancelDto := dto.OrderCancelDto{}
+
+	id, err := strconv.Atoi(echo.Param("id"))
+	if err != nil {
+		return echo.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	err = echo.Bind(&cancelDto)
+	if err != nil {
+		return echo.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	store, err := currentStore(echo)
+	if err != nil {
+		return echo.JSON(httpStatusFromError(err), err.Error())
+	}
+
+	order, err := h.orderController.Cancel(store, uint32(id), cancelDto)
+	if err != nil {
+		return echo.JSON(httpStatusFromError(err), err.Error())
+	}
+
+	return echo.JSON(http.StatusOK, order)
+}
+
+// UpdatePaymentStatus godoc
+// @Summary      Update Order Payment Status
+// @Description  Callback used by the payment service, for the orders of every store, to report an approved (APROVADO), rejected (RECUSADO) or expired (EXPIRADO) payment. Orders with a rejected or expired payment are canceled
+// @Tags         Orders
+// @Accept       json
+// @Produce      json
+// @Param        id     path int                       true "ID do pedido"
+// @Param        Order  body dto.OrderPaymentStatusDto true "Payment result"
+// @Router       /v1/orders/{id}/payment-status [post]
+// @success 200 {object} domain.Order
+// @Failure 400 {object} error
+// @Failure 404 {object} error
+// @Failure 409 {object} error
+// @Failure 500 {object} error
+func (h *OrderHandler) UpdatePaymentStatus(echo echo.Context) error {
+	paymentStatusDto := dto.OrderPaymentStatusDto{}
+
+	id, err := strconv.Atoi(echo.Param("id"))
+	if err != nil {
+		return echo.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	err = echo.Bind(&paymentStatusDto)
+	if err != nil {
+		return echo.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	order, err := h.orderController.UpdatePaymentStatus(uint32(id), paymentStatusDto.Status)
+	if err != nil {
+		return echo.JSON(httpStatusFromError(err), err.Error())
 	}
 
 	return echo.JSON(http.StatusOK, order)
@@ -76,25 +391,33 @@ func (h *OrderHandler) Checkout(echo echo.Context) error {
 // @Produce      json
 // @Param		 		 id     path int         true "ID do item"
 // @Param        Order	body dto.OrderStatusDto true "Status to update Order"
+// @Param        X-Store-ID header int false "Store of the request, the default store when missing"
 // @Router       /v1/orders/{id} [patch]
 // @success 200 {object} domain.Order
+// @Failure 400 {object} error
+// @Failure 409 {object} error
 // @Failure 500 {object} error
 func (h *OrderHandler) UpdateStatus(echo echo.Context) error {
-	orderDto := dto.OrderDto{}
+	statusDto := dto.OrderStatusDto{}
 
 	id, err := strconv.Atoi(echo.Param("id"))
 	if err != nil {
 		return echo.JSON(http.StatusBadRequest, err.Error())
 	}
 
-	bindError := echo.Bind(&orderDto)
+	bindError := echo.Bind(&statusDto)
 	if bindError != nil {
-		return echo.JSON(http.StatusBadRequest, err.Error())
+		return echo.JSON(http.StatusBadRequest, bindError.Error())
 	}
 
-	order, err := h.orderController.UpdateStatus(uint32(id), orderDto.Status)
+	store, err := currentStore(echo)
 	if err != nil {
-		return echo.JSON(http.StatusInternalServerError, err.Error())
+		return echo.JSON(httpStatusFromError(err), err.Error())
+	}
+
+	order, err := h.orderController.UpdateStatus(store, uint32(id), statusDto)
+	if err != nil {
+		return echo.JSON(httpStatusFromError(err), err.Error())
 	}
 
 	if order == nil {
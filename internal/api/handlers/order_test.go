@@ -1,6 +1,10 @@
 package handlers
 
 import (
+	"bufio"
+	"github.com/8soat-grupo35/fastfood-order/internal/adapters/dto"
+	custom_errors "github.com/8soat-grupo35/fastfood-order/internal/api/errors"
+	"github.com/8soat-grupo35/fastfood-order/internal/api/events"
 	"github.com/8soat-grupo35/fastfood-order/internal/entities"
 	mockControllers "github.com/8soat-grupo35/fastfood-order/internal/interfaces/controllers/mock"
 	"github.com/8soat-grupo35/fastfood-order/internal/presenters"
@@ -12,8 +16,11 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 )
 
+var registeredCustomerID = uint32(1)
+
 type OrderHandlerSuite struct {
 	suite.Suite
 	ctrl       *gomock.Controller
@@ -25,7 +32,7 @@ type OrderHandlerSuite struct {
 func (suite *OrderHandlerSuite) SetupTest() {
 	suite.ctrl = gomock.NewController(suite.T())
 	suite.controller = mockControllers.NewMockOrderController(suite.ctrl)
-	suite.handler = &OrderHandler{orderController: suite.controller}
+	suite.handler = &OrderHandler{orderController: suite.controller, hub: events.NewHub()}
 	suite.e = echo.New()
 }
 
@@ -38,45 +45,218 @@ func (suite *OrderHandlerSuite) TestGetAll() {
 		{ID: 1, Status: "Pending"},
 	}
 
-	suite.controller.EXPECT().GetAll().Return(expectedOrders, nil)
+	suite.controller.EXPECT().Search(matriz, dto.OrderFilterDto{}).Return(&entities.OrderPage{Orders: expectedOrders}, nil)
 
 	req := httptest.NewRequest(http.MethodGet, "/v1/orders", nil)
 	rec := httptest.NewRecorder()
 	c := suite.e.NewContext(req, rec)
+	c.Set(storeContextKey, matriz)
+
+	err := suite.handler.GetAll(c)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusOK, rec.Code)
+	assert.Empty(suite.T(), rec.Header().Get(NextCursorHeader))
+}
+
+func (suite *OrderHandlerSuite) TestGetAllWithFilters() {
+	filterDto := dto.OrderFilterDto{
+		Status:      []string{entities.FINISHED_STATUS, entities.CANCELED_STATUS},
+		CustomerID:  1,
+		CreatedFrom: "2024-05-10",
+		CreatedTo:   "2024-05-10",
+		Limit:       10,
+	}
+
+	suite.controller.EXPECT().Search(matriz, filterDto).Return(&entities.OrderPage{
+		Orders:     []entities.Order{{ID: 3, Status: entities.FINISHED_STATUS}},
+		NextCursor: "next",
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/orders?status=FINALIZADO&status=CANCELADO&customer_id=1&created_from=2024-05-10&created_to=2024-05-10&limit=10", nil)
+	rec := httptest.NewRecorder()
+	c := suite.e.NewContext(req, rec)
+	c.Set(storeContextKey, matriz)
 
 	err := suite.handler.GetAll(c)
 	assert.NoError(suite.T(), err)
 	assert.Equal(suite.T(), http.StatusOK, rec.Code)
+	assert.Equal(suite.T(), "next", rec.Header().Get(NextCursorHeader))
+}
+
+func (suite *OrderHandlerSuite) TestGetAllReturnsBadRequestOnInvalidFilter() {
+	suite.controller.EXPECT().Search(matriz, gomock.Any()).Return(nil, &custom_errors.BadRequestError{
+		Message: "limit: must be no greater than 100.",
+		Details: []custom_errors.ErrorDetail{{Field: "limit", Message: "must be no greater than 100"}},
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/orders?limit=500", nil)
+	rec := httptest.NewRecorder()
+	c := suite.e.NewContext(req, rec)
+	c.Set(storeContextKey, matriz)
+
+	err := suite.handler.GetAll(c)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusBadRequest, rec.Code)
+	assert.Contains(suite.T(), rec.Body.String(), `"field":"limit"`)
+}
+
+func (suite *OrderHandlerSuite) TestTracking() {
+	ordersAhead := 1
+	suite.controller.EXPECT().Track(matriz, "ABCD2345").Return(&presenters.OrderTrackingPresenter{
+		TrackingCode: "ABCD2345",
+		Status:       entities.RECEIVED_STATUS,
+		OrdersAhead:  &ordersAhead,
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/orders/ABCD2345/tracking", nil)
+	rec := httptest.NewRecorder()
+	c := suite.e.NewContext(req, rec)
+	c.Set(storeContextKey, matriz)
+	c.SetParamNames("code")
+	c.SetParamValues("ABCD2345")
+
+	err := suite.handler.Tracking(c)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusOK, rec.Code)
+	assert.Contains(suite.T(), rec.Body.String(), `"orders_ahead":1`)
+}
+
+func (suite *OrderHandlerSuite) TestTrackingReturnsNotFoundOnUnknownCode() {
+	suite.controller.EXPECT().Track(matriz, "ZZZZ9999").Return(nil, &custom_errors.NotFoundError{Message: "order not found"})
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/orders/ZZZZ9999/tracking", nil)
+	rec := httptest.NewRecorder()
+	c := suite.e.NewContext(req, rec)
+	c.Set(storeContextKey, matriz)
+	c.SetParamNames("code")
+	c.SetParamValues("ZZZZ9999")
+
+	err := suite.handler.Tracking(c)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusNotFound, rec.Code)
+}
+
+func (suite *OrderHandlerSuite) TestStreamSendsSnapshotAndOrderEvents() {
+	suite.controller.EXPECT().GetAll(matriz).Return([]entities.Order{{ID: 1, Status: entities.RECEIVED_STATUS}}, nil)
+
+	suite.e.GET("/v1/orders/stream", suite.handler.Stream, withStore(matriz))
+	server := httptest.NewServer(suite.e)
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "/v1/orders/stream")
+	assert.NoError(suite.T(), err)
+	defer resp.Body.Close()
+	assert.Equal(suite.T(), "text/event-stream", resp.Header.Get("Content-Type"))
+
+	reader := bufio.NewReader(resp.Body)
+	readEvent := func() string {
+		var event strings.Builder
+		for {
+			line, err := reader.ReadString('\n')
+			assert.NoError(suite.T(), err)
+			if line == "\n" {
+				return event.String()
+			}
+			event.WriteString(line)
+		}
+	}
+
+	assert.Contains(suite.T(), readEvent(), "event: orders.snapshot\ndata: [{\"id\":1,")
+
+	suite.handler.hub.Broadcast(entities.OrderEvent{Type: entities.ORDER_STATUS_CHANGED_EVENT, StoreID: 1, OrderID: 1, Status: entities.IN_PREPARATION_STATUS})
+
+	statusChangedEvent := readEvent()
+	assert.Contains(suite.T(), statusChangedEvent, "event: order.status_changed\ndata: {\"type\":\"order.status_changed\",\"store_id\":1,\"order_id\":1,")
+	assert.Contains(suite.T(), statusChangedEvent, "\"status\":\"EM_PREPARACAO\"")
 }
 
 func (suite *OrderHandlerSuite) TestCheckout() {
 	orderPresenter := &presenters.OrderPresenter{Id: 1}
 
-	suite.controller.EXPECT().Checkout(gomock.Any()).Return(orderPresenter, nil)
+	suite.controller.EXPECT().Checkout(matriz, gomock.Any()).Return(orderPresenter, nil)
 
 	req := httptest.NewRequest(http.MethodPost, "/v1/orders/checkout", strings.NewReader(`{"status":"Pending","customerID":1,"items":[{"id":1,"quantity":2}]}`))
 	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 	rec := httptest.NewRecorder()
 	c := suite.e.NewContext(req, rec)
+	c.Set(storeContextKey, matriz)
 
 	err := suite.handler.Checkout(c)
 	assert.NoError(suite.T(), err)
 	assert.Equal(suite.T(), http.StatusOK, rec.Code)
-	assert.Equal(suite.T(), `{"id":1}`+"\n", rec.Body.String())
+	assert.Equal(suite.T(), `{"id":1,"total":0.00}`+"\n", rec.Body.String())
+}
+
+func (suite *OrderHandlerSuite) TestCheckoutReturnsTheTotalOfAFullyDiscountedOrder() {
+	orderPresenter := &presenters.OrderPresenter{
+		Id:        1,
+		Discounts: []presenters.OrderDiscountPresenter{{PromotionName: "Cortesia", Amount: 2800}},
+	}
+
+	suite.controller.EXPECT().Checkout(matriz, gomock.Any()).Return(orderPresenter, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/orders/checkout", strings.NewReader(`{"customerID":1,"items":[{"id":1,"quantity":1}]}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := suite.e.NewContext(req, rec)
+	c.Set(storeContextKey, matriz)
+
+	err := suite.handler.Checkout(c)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusOK, rec.Code)
+	assert.Contains(suite.T(), rec.Body.String(), `"total":0.00,`)
+}
+
+func (suite *OrderHandlerSuite) TestCheckoutReturnsValidationDetails() {
+	suite.controller.EXPECT().Checkout(matriz, gomock.Any()).Return(nil, &custom_errors.BadRequestError{
+		Message: "items: (0: (id: item 9 not found.).).",
+		Details: []custom_errors.ErrorDetail{{Field: "items.0.id", Message: "item 9 not found"}},
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/orders/checkout", strings.NewReader(`{"customer_id":1,"items":[{"id":9,"quantity":1}]}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := suite.e.NewContext(req, rec)
+	c.Set(storeContextKey, matriz)
+
+	err := suite.handler.Checkout(c)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusBadRequest, rec.Code)
+	assert.JSONEq(suite.T(), `{"message":"items: (0: (id: item 9 not found.).).","details":[{"field":"items.0.id","message":"item 9 not found"}]}`, rec.Body.String())
+}
+
+func (suite *OrderHandlerSuite) TestCheckoutReturnsTheNextOpeningWhileStoreIsClosed() {
+	nextOpening := time.Date(2026, time.October, 19, 10, 0, 0, 0, time.UTC)
+	suite.controller.EXPECT().Checkout(matriz, gomock.Any()).Return(nil, &custom_errors.StoreClosedError{
+		Message:     "store is closed, it opens again at 2026-10-19T10:00:00Z",
+		NextOpening: &nextOpening,
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/orders/checkout", strings.NewReader(`{"customer_id":1,"items":[{"id":1,"quantity":1}]}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := suite.e.NewContext(req, rec)
+	c.Set(storeContextKey, matriz)
+
+	err := suite.handler.Checkout(c)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusConflict, rec.Code)
+	assert.JSONEq(suite.T(), `{"message":"store is closed, it opens again at 2026-10-19T10:00:00Z","next_opening":"2026-10-19T10:00:00Z"}`, rec.Body.String())
 }
 
 func (suite *OrderHandlerSuite) TestUpdateStatus() {
 	items := []entities.OrderItem{
 		{ID: 1, Quantity: 2},
 	}
-	orderAfterUpdate := &entities.Order{ID: 1, Status: entities.DONE_STATUS, CustomerID: 1, Items: items}
+	orderAfterUpdate := &entities.Order{ID: 1, Status: entities.DONE_STATUS, CustomerID: &registeredCustomerID, Items: items}
 
-	suite.controller.EXPECT().UpdateStatus(uint32(1), gomock.Any()).Return(orderAfterUpdate, nil)
+	suite.controller.EXPECT().UpdateStatus(matriz, uint32(1), dto.OrderStatusDto{Status: entities.DONE_STATUS, ChangedBy: "cozinha"}).Return(orderAfterUpdate, nil)
 
-	req := httptest.NewRequest(http.MethodPatch, "/v1/orders/1", strings.NewReader(`{"status":"DONE"}`))
+	req := httptest.NewRequest(http.MethodPatch, "/v1/orders/1", strings.NewReader(`{"status":"PRONTO","changed_by":"cozinha"}`))
 	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 	rec := httptest.NewRecorder()
 	c := suite.e.NewContext(req, rec)
+	c.Set(storeContextKey, matriz)
 	c.SetParamNames("id")
 	c.SetParamValues("1")
 
@@ -85,6 +265,196 @@ func (suite *OrderHandlerSuite) TestUpdateStatus() {
 	assert.Equal(suite.T(), http.StatusOK, rec.Code)
 }
 
+func (suite *OrderHandlerSuite) TestUpdateStatusReturnsConflictOnInvalidTransition() {
+	suite.controller.EXPECT().UpdateStatus(matriz, uint32(1), dto.OrderStatusDto{Status: entities.FINISHED_STATUS}).Return(nil, &custom_errors.ConflictError{
+		Message: "order status cannot change from RECEBIDO to FINALIZADO",
+	})
+
+	req := httptest.NewRequest(http.MethodPatch, "/v1/orders/1", strings.NewReader(`{"status":"FINALIZADO"}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := suite.e.NewContext(req, rec)
+	c.Set(storeContextKey, matriz)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	err := suite.handler.UpdateStatus(c)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusConflict, rec.Code)
+}
+
+func (suite *OrderHandlerSuite) TestGetByCustomer() {
+	suite.controller.EXPECT().GetByCustomer(matriz, uint32(1)).Return([]entities.Order{{ID: 1, CustomerID: &registeredCustomerID}}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/customer/1/orders", nil)
+	rec := httptest.NewRecorder()
+	c := suite.e.NewContext(req, rec)
+	c.Set(storeContextKey, matriz)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	err := suite.handler.GetByCustomer(c)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusOK, rec.Code)
+}
+
+func (suite *OrderHandlerSuite) TestGetByCustomerReturnsNotFoundOnUnknownCustomer() {
+	suite.controller.EXPECT().GetByCustomer(matriz, uint32(9)).Return(nil, &custom_errors.NotFoundError{Message: "customer not found"})
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/customer/9/orders", nil)
+	rec := httptest.NewRecorder()
+	c := suite.e.NewContext(req, rec)
+	c.Set(storeContextKey, matriz)
+	c.SetParamNames("id")
+	c.SetParamValues("9")
+
+	err := suite.handler.GetByCustomer(c)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusNotFound, rec.Code)
+}
+
+func (suite *OrderHandlerSuite) TestReorder() {
+	suite.controller.EXPECT().Reorder(matriz, uint32(1)).Return(&presenters.ReorderPresenter{
+		OrderPresenter: presenters.OrderPresenter{Id: 5, PickupCode: "A-042"},
+		DroppedItems: []presenters.DroppedOrderItemPresenter{
+			{Id: 2, ItemName: "Milkshake", Quantity: 1, Reason: entities.ITEM_UNAVAILABLE_REASON},
+		},
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/orders/1/reorder", nil)
+	rec := httptest.NewRecorder()
+	c := suite.e.NewContext(req, rec)
+	c.Set(storeContextKey, matriz)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	err := suite.handler.Reorder(c)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusOK, rec.Code)
+	assert.JSONEq(suite.T(), `{"id":5,"pickup_code":"A-042","total":0,"dropped_items":[{"id":2,"item_name":"Milkshake","quantity":1,"reason":"item is no longer available"}]}`, rec.Body.String())
+}
+
+func (suite *OrderHandlerSuite) TestReorderReturnsConflictWhenNoItemIsAvailable() {
+	suite.controller.EXPECT().Reorder(matriz, uint32(1)).Return(nil, &custom_errors.ConflictError{
+		Message: "none of the items of the order is available anymore",
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/orders/1/reorder", nil)
+	rec := httptest.NewRecorder()
+	c := suite.e.NewContext(req, rec)
+	c.Set(storeContextKey, matriz)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	err := suite.handler.Reorder(c)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusConflict, rec.Code)
+}
+
+func (suite *OrderHandlerSuite) TestTimeline() {
+	suite.controller.EXPECT().Timeline(matriz, uint32(1)).Return([]entities.OrderStatusHistory{
+		{ID: 1, OrderID: 1, Status: entities.RECEIVED_STATUS, ChangedBy: entities.SYSTEM_ACTOR},
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/orders/1/timeline", nil)
+	rec := httptest.NewRecorder()
+	c := suite.e.NewContext(req, rec)
+	c.Set(storeContextKey, matriz)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	err := suite.handler.Timeline(c)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusOK, rec.Code)
+	assert.Contains(suite.T(), rec.Body.String(), `"changed_by":"system"`)
+}
+
+func (suite *OrderHandlerSuite) TestTimelineReturnsNotFoundOnUnknownOrder() {
+	suite.controller.EXPECT().Timeline(matriz, uint32(9)).Return(nil, &custom_errors.NotFoundError{Message: "order not found"})
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/orders/9/timeline", nil)
+	rec := httptest.NewRecorder()
+	c := suite.e.NewContext(req, rec)
+	c.Set(storeContextKey, matriz)
+	c.SetParamNames("id")
+	c.SetParamValues("9")
+
+	err := suite.handler.Timeline(c)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusNotFound, rec.Code)
+}
+
+func (suite *OrderHandlerSuite) TestCancel() {
+	cancelDto := dto.OrderCancelDto{CanceledBy: "atendente", Reason: "cliente desistiu"}
+	canceledOrder := &entities.Order{ID: 1, Status: entities.CANCELED_STATUS, CustomerID: &registeredCustomerID}
+
+	suite.controller.EXPECT().Cancel(matriz, uint32(1), cancelDto).Return(canceledOrder, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/orders/1/cancel", strings.NewReader(`{"canceled_by":"atendente","reason":"cliente desistiu"}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := suite.e.NewContext(req, rec)
+	c.Set(storeContextKey, matriz)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	err := suite.handler.Cancel(c)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusOK, rec.Code)
+}
+
+func (suite *OrderHandlerSuite) TestCancelReturnsConflictWhenOrderIsDone() {
+	suite.controller.EXPECT().Cancel(matriz, uint32(1), gomock.Any()).Return(nil, &custom_errors.ConflictError{
+		Message: "order with status PRONTO cannot be canceled",
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/orders/1/cancel", strings.NewReader(`{"canceled_by":"atendente","reason":"cliente desistiu"}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := suite.e.NewContext(req, rec)
+	c.Set(storeContextKey, matriz)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	err := suite.handler.Cancel(c)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusConflict, rec.Code)
+}
+
+func (suite *OrderHandlerSuite) TestUpdatePaymentStatus() {
+	paidOrder := &entities.Order{ID: 1, Status: entities.RECEIVED_STATUS, PaymentStatus: entities.PAYMENT_APPROVED_STATUS}
+
+	suite.controller.EXPECT().UpdatePaymentStatus(uint32(1), entities.PAYMENT_APPROVED_STATUS).Return(paidOrder, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/orders/1/payment-status", strings.NewReader(`{"status":"APROVADO"}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := suite.e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	err := suite.handler.UpdatePaymentStatus(c)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusOK, rec.Code)
+}
+
+func (suite *OrderHandlerSuite) TestUpdatePaymentStatusReturnsBadRequestOnUnknownStatus() {
+	suite.controller.EXPECT().UpdatePaymentStatus(uint32(1), "PAGO").Return(nil, &custom_errors.BadRequestError{
+		Message: "payment_status: must be a valid value.",
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/orders/1/payment-status", strings.NewReader(`{"status":"PAGO"}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := suite.e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	err := suite.handler.UpdatePaymentStatus(c)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusBadRequest, rec.Code)
+}
+
 func TestOrderHandlerSuite(t *testing.T) {
 	suite.Run(t, new(OrderHandlerSuite))
 }
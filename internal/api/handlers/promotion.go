@@ -0,0 +1,152 @@
+package handlers
+
+import (
+	"github.com/8soat-grupo35/fastfood-order/internal/adapters/dto"
+	"github.com/8soat-grupo35/fastfood-order/internal/controllers"
+	controllersInterface "github.com/8soat-grupo35/fastfood-order/internal/interfaces/controllers"
+	"gorm.io/gorm"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+type PromotionHandler struct {
+	promotionController controllersInterface.PromotionController
+}
+
+func NewPromotionHandler(db *gorm.DB) PromotionHandler {
+	return PromotionHandler{
+		promotionController: controllers.NewPromotionController(db),
+	}
+}
+
+// GetAll godoc
+// @Summary      List Promotions
+// @Description  List every promotion and coupon of the store with how many times it was used
+// @Tags         Promotions
+// @Produce      json
+// @Param        X-Store-ID header int false "Store of the request, the default store when missing"
+// @Router       /v1/promotion [get]
+// @Success 200  {array} domain.Promotion
+// @Failure 500  {object} error
+func (h *PromotionHandler) GetAll(echo echo.Context) error {
+	store, err := currentStore(echo)
+	if err != nil {
+		return echo.JSON(httpStatusFromError(err), err.Error())
+	}
+
+	promotions, err := h.promotionController.GetAll(store)
+
+	if err != nil {
+		return echo.JSON(httpStatusFromError(err), err.Error())
+	}
+
+	return echo.JSON(http.StatusOK, promotions)
+}
+
+// Create godoc
+// @Summary      Insert Promotion
+// @Description  Insert a promotion. Promotions with a code are coupons, the others apply to every order they fit
+// @Tags         Promotions
+// @Accept       json
+// @Produce      json
+// @Param        Promotion	body dto.PromotionDto true "Promotion to insert"
+// @Param        Idempotency-Key header string false "Key that makes retries return the original promotion instead of creating a new one"
+// @Param        X-Store-ID header int false "Store of the request, the default store when missing"
+// @Router       /v1/promotion [post]
+// @Success 200  {object} domain.Promotion
+// @Failure 400  {object} error
+// @Failure 409  {object} error
+// @Failure 500  {object} error
+func (h *PromotionHandler) Create(echo echo.Context) error {
+	promotionDto := dto.PromotionDto{}
+
+	err := echo.Bind(&promotionDto)
+	if err != nil {
+		return echo.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	store, err := currentStore(echo)
+	if err != nil {
+		return echo.JSON(httpStatusFromError(err), err.Error())
+	}
+
+	promotion, err := h.promotionController.Create(store, promotionDto)
+	if err != nil {
+		return echo.JSON(httpStatusFromError(err), errorResponse(err))
+	}
+
+	return echo.JSON(http.StatusOK, promotion)
+}
+
+// Update godoc
+// @Summary      Update Promotion
+// @Description  Update a promotion. The uses it already had are kept
+// @Tags         Promotions
+// @Accept       json
+// @Produce      json
+// @Param        id     path int          true "ID da promoção"
+// @Param        Promotion	body dto.PromotionDto true "Promotion to update"
+// @Param        X-Store-ID header int false "Store of the request, the default store when missing"
+// @Router       /v1/promotion/{id} [put]
+// @Success 200  {object} domain.Promotion
+// @Failure 400  {object} error
+// @Failure 404  {object} error
+// @Failure 409  {object} error
+// @Failure 500  {object} error
+func (h *PromotionHandler) Update(echo echo.Context) error {
+	promotionDto := dto.PromotionDto{}
+
+	err := echo.Bind(&promotionDto)
+	if err != nil {
+		return echo.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	id, err := strconv.Atoi(echo.Param("id"))
+	if err != nil {
+		return echo.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	store, err := currentStore(echo)
+	if err != nil {
+		return echo.JSON(httpStatusFromError(err), err.Error())
+	}
+
+	promotion, err := h.promotionController.Update(store, id, promotionDto)
+	if err != nil {
+		return echo.JSON(httpStatusFromError(err), errorResponse(err))
+	}
+
+	return echo.JSON(http.StatusOK, promotion)
+}
+
+// Delete godoc
+// @Summary      Delete Promotion
+// @Description  Delete Promotion
+// @Tags         Promotions
+// @Produce      json
+// @Param        id path int true "ID da promoção"
+// @Param        X-Store-ID header int false "Store of the request, the default store when missing"
+// @Router       /v1/promotion/{id} [delete]
+// @Success 200  {string} string "promotion deleted successfully"
+// @Failure 404  {object} error
+// @Failure 500  {object} error
+func (h *PromotionHandler) Delete(echo echo.Context) error {
+	id, err := strconv.Atoi(echo.Param("id"))
+	if err != nil {
+		return echo.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	store, err := currentStore(echo)
+	if err != nil {
+		return echo.JSON(httpStatusFromError(err), err.Error())
+	}
+
+	err = h.promotionController.Delete(store, id)
+	if err != nil {
+		return echo.JSON(httpStatusFromError(err), err.Error())
+	}
+
+	return echo.JSON(http.StatusOK, "promotion deleted successfully")
+}
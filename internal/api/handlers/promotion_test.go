@@ -0,0 +1,120 @@
+package handlers
+
+import (
+	"github.com/8soat-grupo35/fastfood-order/internal/adapters/dto"
+	custom_errors "github.com/8soat-grupo35/fastfood-order/internal/api/errors"
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+	mockControllers "github.com/8soat-grupo35/fastfood-order/internal/interfaces/controllers/mock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+type PromotionHandlerSuite struct {
+	suite.Suite
+	ctrl       *gomock.Controller
+	controller *mockControllers.MockPromotionController
+	handler    *PromotionHandler
+	e          *echo.Echo
+}
+
+func (suite *PromotionHandlerSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.controller = mockControllers.NewMockPromotionController(suite.ctrl)
+	suite.handler = &PromotionHandler{promotionController: suite.controller}
+	suite.e = echo.New()
+}
+
+func (suite *PromotionHandlerSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func (suite *PromotionHandlerSuite) TestGetAll() {
+	expectedPromotions := []entities.Promotion{{ID: 4, Name: "Bem-vindo", Code: "BEMVINDO", Kind: entities.PROMOTION_FIXED_AMOUNT, Amount: 1000}}
+
+	suite.controller.EXPECT().GetAll(matriz).Return(expectedPromotions, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/promotion", nil)
+	rec := httptest.NewRecorder()
+	c := suite.e.NewContext(req, rec)
+	c.Set(storeContextKey, matriz)
+
+	err := suite.handler.GetAll(c)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusOK, rec.Code)
+	assert.Contains(suite.T(), rec.Body.String(), `"code":"BEMVINDO","kind":"VALOR_FIXO"`)
+}
+
+func (suite *PromotionHandlerSuite) TestCreate() {
+	suite.controller.EXPECT().Create(matriz, gomock.Any()).DoAndReturn(func(store entities.Store, promotionDto dto.PromotionDto) (*entities.Promotion, error) {
+		assert.Equal(suite.T(), time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC), *promotionDto.EndsAt)
+		assert.Equal(suite.T(), uint32(1), promotionDto.PerCustomerLimit)
+		return &entities.Promotion{ID: 4, Name: promotionDto.Name}, nil
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/promotion", strings.NewReader(`{"name":"Bem-vindo","code":"BEMVINDO","kind":"VALOR_FIXO","amount":10,"ends_at":"2024-06-01T00:00:00Z","per_customer_limit":1}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := suite.e.NewContext(req, rec)
+	c.Set(storeContextKey, matriz)
+
+	err := suite.handler.Create(c)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusOK, rec.Code)
+}
+
+func (suite *PromotionHandlerSuite) TestCreateReturnsConflictOnCodeInUse() {
+	suite.controller.EXPECT().Create(matriz, gomock.Any()).Return(nil, &custom_errors.ConflictError{Message: "coupon code already in use"})
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/promotion", strings.NewReader(`{"name":"Bem-vindo","code":"BEMVINDO"}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := suite.e.NewContext(req, rec)
+	c.Set(storeContextKey, matriz)
+
+	err := suite.handler.Create(c)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusConflict, rec.Code)
+}
+
+func (suite *PromotionHandlerSuite) TestUpdateReturnsNotFound() {
+	suite.controller.EXPECT().Update(matriz, 9, gomock.Any()).Return(nil, &custom_errors.NotFoundError{Message: "promotion not found to update"})
+
+	req := httptest.NewRequest(http.MethodPut, "/v1/promotion/9", strings.NewReader(`{"name":"Bem-vindo"}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := suite.e.NewContext(req, rec)
+	c.Set(storeContextKey, matriz)
+	c.SetParamNames("id")
+	c.SetParamValues("9")
+
+	err := suite.handler.Update(c)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusNotFound, rec.Code)
+}
+
+func (suite *PromotionHandlerSuite) TestDelete() {
+	suite.controller.EXPECT().Delete(matriz, 4).Return(nil)
+
+	req := httptest.NewRequest(http.MethodDelete, "/v1/promotion/4", nil)
+	rec := httptest.NewRecorder()
+	c := suite.e.NewContext(req, rec)
+	c.Set(storeContextKey, matriz)
+	c.SetParamNames("id")
+	c.SetParamValues("4")
+
+	err := suite.handler.Delete(c)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusOK, rec.Code)
+	assert.Equal(suite.T(), `"promotion deleted successfully"`+"\n", rec.Body.String())
+}
+
+func TestPromotionHandlerSuite(t *testing.T) {
+	suite.Run(t, new(PromotionHandlerSuite))
+}
@@ -0,0 +1,358 @@
+package handlers
+
+import (
+	"errors"
+	"github.com/8soat-grupo35/fastfood-order/internal/adapters/dto"
+	"github.com/8soat-grupo35/fastfood-order/internal/controllers"
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+	controllersInterface "github.com/8soat-grupo35/fastfood-order/internal/interfaces/controllers"
+	"gorm.io/gorm"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+const StoreIDHeader = "X-Store-ID"
+
+// storeContextKey keeps the store the request is made to in the echo context.
+const storeContextKey = "store"
+
+type StoreHandler struct {
+	storeController controllersInterface.StoreController
+	defaultStoreId  uint32
+}
+
+func NewStoreHandler(db *gorm.DB, defaultStoreId uint32) StoreHandler {
+	return StoreHandler{
+		storeController: controllers.NewStoreController(db),
+		defaultStoreId:  defaultStoreId,
+	}
+}
+
+// Middleware finds the store of the request from the X-Store-ID header, the default store taking requests
+// without it. Unknown and inactive stores are not found.
+func (h *StoreHandler) Middleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(echo echo.Context) error {
+		storeId := uint64(h.defaultStoreId)
+
+		if header := echo.Request().Header.Get(StoreIDHeader); header != "" {
+			id, err := strconv.ParseUint(header, 10, 32)
+			if err != nil {
+				return echo.JSON(http.StatusBadRequest, err.Error())
+			}
+			storeId = id
+		}
+
+		store, err := h.storeController.Resolve(int(storeId))
+		if err != nil {
+			return echo.JSON(httpStatusFromError(err), err.Error())
+		}
+
+		echo.Set(storeContextKey, *store)
+
+		return next(echo)
+	}
+}
+
+// currentStore is the store the middleware found for the request. Routes left without the middleware fail
+// instead of working on a store that does not exist.
+func currentStore(echo echo.Context) (entities.Store, error) {
+	store, ok := echo.Get(storeContextKey).(entities.Store)
+	if !ok || store.ID == 0 {
+		return entities.Store{}, errors.New("the store of the request was not resolved")
+	}
+
+	return store, nil
+}
+
+// GetAll godoc
+// @Summary      List Stores
+// @Description  List the stores of the franchise
+// @Tags         Store
+// @Produce      json
+// @Router       /v1/stores [get]
+// @Success 200  {array} domain.Store
+// @Failure 500  {object} error
+func (h *StoreHandler) GetAll(echo echo.Context) error {
+	stores, err := h.storeController.GetAll()
+
+	if err != nil {
+		return echo.JSON(httpStatusFromError(err), err.Error())
+	}
+
+	return echo.JSON(http.StatusOK, stores)
+}
+
+// Create godoc
+// @Summary      Insert Store
+// @Description  Open a new store of the franchise. Its orders take the store time zone and pickup code prefix
+// @Tags         Store
+// @Accept       json
+// @Produce      json
+// @Param        Store body dto.StoreDto true "Store to insert"
+// @Param        Idempotency-Key header string false "Key that makes retries return the original store instead of creating a new one"
+// @Router       /v1/stores [post]
+// @Success 200  {object} domain.Store
+// @Failure 400  {object} error
+// @Failure 500  {object} error
+func (h *StoreHandler) Create(echo echo.Context) error {
+	storeDto := dto.StoreDto{}
+
+	err := echo.Bind(&storeDto)
+	if err != nil {
+		return echo.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	store, err := h.storeController.Create(storeDto)
+	if err != nil {
+		return echo.JSON(httpStatusFromError(err), errorResponse(err))
+	}
+
+	return echo.JSON(http.StatusOK, store)
+}
+
+// Update godoc
+// @Summary      Update Store
+// @Description  Update a store. Inactive stores take no requests
+// @Tags         Store
+// @Accept       json
+// @Produce      json
+// @Param        id    path int          true "ID da loja"
+// @Param        Store body dto.StoreDto true "Store to update"
+// @Router       /v1/stores/{id} [put]
+// @Success 200  {object} domain.Store
+// @Failure 400  {object} error
+// @Failure 404  {object} error
+// @Failure 500  {object} error
+func (h *StoreHandler) Update(echo echo.Context) error {
+	storeDto := dto.StoreDto{}
+
+	err := echo.Bind(&storeDto)
+	if err != nil {
+		return echo.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	id, err := strconv.Atoi(echo.Param("id"))
+	if err != nil {
+		return echo.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	store, err := h.storeController.Update(id, storeDto)
+	if err != nil {
+		return echo.JSON(httpStatusFromError(err), errorResponse(err))
+	}
+
+	return echo.JSON(http.StatusOK, store)
+}
+
+// GetStatus godoc
+// @Summary      Store Status
+// @Description  Tell if the store takes orders now and, while closed, when it opens again
+// @Tags         Store
+// @Produce      json
+// @Param        X-Store-ID header int false "Store of the request, the default store when missing"
+// @Router       /v1/store/status [get]
+// @Success 200  {object} domain.StoreStatus
+// @Failure 500  {object} error
+func (h *StoreHandler) GetStatus(echo echo.Context) error {
+	store, err := currentStore(echo)
+	if err != nil {
+		return echo.JSON(httpStatusFromError(err), err.Error())
+	}
+
+	status, err := h.storeController.GetStatus(store)
+
+	if err != nil {
+		return echo.JSON(httpStatusFromError(err), err.Error())
+	}
+
+	return echo.JSON(http.StatusOK, status)
+}
+
+// GetHours godoc
+// @Summary      List Store Hours
+// @Description  List the opening hours of the store by day of the week, from Sunday (0) to Saturday (6). Without hours the store is open all day, every day
+// @Tags         Store
+// @Produce      json
+// @Param        X-Store-ID header int false "Store of the request, the default store when missing"
+// @Router       /v1/store/hours [get]
+// @Success 200  {array} domain.StoreHours
+// @Failure 500  {object} error
+func (h *StoreHandler) GetHours(echo echo.Context) error {
+	store, err := currentStore(echo)
+	if err != nil {
+		return echo.JSON(httpStatusFromError(err), err.Error())
+	}
+
+	hours, err := h.storeController.GetHours(store)
+
+	if err != nil {
+		return echo.JSON(httpStatusFromError(err), err.Error())
+	}
+
+	return echo.JSON(http.StatusOK, hours)
+}
+
+// UpdateHours godoc
+// @Summary      Update Store Hours
+// @Description  Replace the opening hours of the whole week. Days of the week left out are closed
+// @Tags         Store
+// @Accept       json
+// @Produce      json
+// @Param        Hours body dto.StoreHoursDto true "Hours of the week"
+// @Param        X-Store-ID header int false "Store of the request, the default store when missing"
+// @Router       /v1/store/hours [put]
+// @Success 200  {array} domain.StoreHours
+// @Failure 400  {object} error
+// @Failure 500  {object} error
+func (h *StoreHandler) UpdateHours(echo echo.Context) error {
+	hoursDto := dto.StoreHoursDto{}
+
+	err := echo.Bind(&hoursDto)
+	if err != nil {
+		return echo.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	store, err := currentStore(echo)
+	if err != nil {
+		return echo.JSON(httpStatusFromError(err), err.Error())
+	}
+
+	hours, err := h.storeController.UpdateHours(store, hoursDto)
+	if err != nil {
+		return echo.JSON(httpStatusFromError(err), errorResponse(err))
+	}
+
+	return echo.JSON(http.StatusOK, hours)
+}
+
+// GetCalendar godoc
+// @Summary      List Store Calendar
+// @Description  List the calendar days from today on, such as holidays, which close the store or change its hours on the date
+// @Tags         Store
+// @Produce      json
+// @Param        X-Store-ID header int false "Store of the request, the default store when missing"
+// @Router       /v1/store/calendar [get]
+// @Success 200  {array} domain.StoreCalendarDay
+// @Failure 500  {object} error
+func (h *StoreHandler) GetCalendar(echo echo.Context) error {
+	store, err := currentStore(echo)
+	if err != nil {
+		return echo.JSON(httpStatusFromError(err), err.Error())
+	}
+
+	days, err := h.storeController.GetCalendar(store)
+
+	if err != nil {
+		return echo.JSON(httpStatusFromError(err), err.Error())
+	}
+
+	return echo.JSON(http.StatusOK, days)
+}
+
+// CreateCalendarDay godoc
+// @Summary      Insert Store Calendar Day
+// @Description  Close the store on a date, or open it on other hours than the weekly ones
+// @Tags         Store
+// @Accept       json
+// @Produce      json
+// @Param        Day body dto.StoreCalendarDayDto true "Calendar day to insert"
+// @Param        Idempotency-Key header string false "Key that makes retries return the original calendar day instead of creating a new one"
+// @Param        X-Store-ID header int false "Store of the request, the default store when missing"
+// @Router       /v1/store/calendar [post]
+// @Success 200  {object} domain.StoreCalendarDay
+// @Failure 400  {object} error
+// @Failure 409  {object} error
+// @Failure 500  {object} error
+func (h *StoreHandler) CreateCalendarDay(echo echo.Context) error {
+	dayDto := dto.StoreCalendarDayDto{}
+
+	err := echo.Bind(&dayDto)
+	if err != nil {
+		return echo.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	store, err := currentStore(echo)
+	if err != nil {
+		return echo.JSON(httpStatusFromError(err), err.Error())
+	}
+
+	day, err := h.storeController.CreateCalendarDay(store, dayDto)
+	if err != nil {
+		return echo.JSON(httpStatusFromError(err), errorResponse(err))
+	}
+
+	return echo.JSON(http.StatusOK, day)
+}
+
+// UpdateCalendarDay godoc
+// @Summary      Update Store Calendar Day
+// @Description  Update a calendar day of the store
+// @Tags         Store
+// @Accept       json
+// @Produce      json
+// @Param        id  path int                     true "ID do dia"
+// @Param        Day body dto.StoreCalendarDayDto true "Calendar day to update"
+// @Param        X-Store-ID header int false "Store of the request, the default store when missing"
+// @Router       /v1/store/calendar/{id} [put]
+// @Success 200  {object} domain.StoreCalendarDay
+// @Failure 400  {object} error
+// @Failure 404  {object} error
+// @Failure 409  {object} error
+// @Failure 500  {object} error
+func (h *StoreHandler) UpdateCalendarDay(echo echo.Context) error {
+	dayDto := dto.StoreCalendarDayDto{}
+
+	err := echo.Bind(&dayDto)
+	if err != nil {
+		return echo.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	id, err := strconv.Atoi(echo.Param("id"))
+	if err != nil {
+		return echo.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	store, err := currentStore(echo)
+	if err != nil {
+		return echo.JSON(httpStatusFromError(err), err.Error())
+	}
+
+	day, err := h.storeController.UpdateCalendarDay(store, id, dayDto)
+	if err != nil {
+		return echo.JSON(httpStatusFromError(err), errorResponse(err))
+	}
+
+	return echo.JSON(http.StatusOK, day)
+}
+
+// DeleteCalendarDay godoc
+// @Summary      Delete Store Calendar Day
+// @Description  Delete a calendar day, so the weekly hours apply on the date again
+// @Tags         Store
+// @Produce      json
+// @Param        id path int true "ID do dia"
+// @Param        X-Store-ID header int false "Store of the request, the default store when missing"
+// @Router       /v1/store/calendar/{id} [delete]
+// @Success 200  {string} string "store calendar day deleted successfully"
+// @Failure 404  {object} error
+// @Failure 500  {object} error
+func (h *StoreHandler) DeleteCalendarDay(echo echo.Context) error {
+	id, err := strconv.Atoi(echo.Param("id"))
+	if err != nil {
+		return echo.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	store, err := currentStore(echo)
+	if err != nil {
+		return echo.JSON(httpStatusFromError(err), err.Error())
+	}
+
+	err = h.storeController.DeleteCalendarDay(store, id)
+	if err != nil {
+		return echo.JSON(httpStatusFromError(err), err.Error())
+	}
+
+	return echo.JSON(http.StatusOK, "store calendar day deleted successfully")
+}
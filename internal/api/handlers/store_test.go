@@ -0,0 +1,291 @@
+package handlers
+
+import (
+	"github.com/8soat-grupo35/fastfood-order/internal/adapters/dto"
+	custom_errors "github.com/8soat-grupo35/fastfood-order/internal/api/errors"
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+	mockControllers "github.com/8soat-grupo35/fastfood-order/internal/interfaces/controllers/mock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+var matriz = entities.Store{ID: 1, Name: "Matriz", TimeZone: "UTC", PickupCodePrefix: "A", Active: true}
+
+// withStore stands for the store middleware on routes tested through a server.
+func withStore(store entities.Store) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			c.Set(storeContextKey, store)
+			return next(c)
+		}
+	}
+}
+
+type StoreHandlerSuite struct {
+	suite.Suite
+	ctrl       *gomock.Controller
+	controller *mockControllers.MockStoreController
+	handler    *StoreHandler
+	e          *echo.Echo
+}
+
+func (suite *StoreHandlerSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.controller = mockControllers.NewMockStoreController(suite.ctrl)
+	suite.handler = &StoreHandler{storeController: suite.controller, defaultStoreId: 1}
+	suite.e = echo.New()
+}
+
+func (suite *StoreHandlerSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func (suite *StoreHandlerSuite) TestMiddlewareTakesTheDefaultStoreWithoutHeader() {
+	suite.controller.EXPECT().Resolve(1).Return(&matriz, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/store/status", nil)
+	rec := httptest.NewRecorder()
+	c := suite.e.NewContext(req, rec)
+
+	err := suite.handler.Middleware(func(c echo.Context) error {
+		store, err := currentStore(c)
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), matriz, store)
+		return c.NoContent(http.StatusOK)
+	})(c)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusOK, rec.Code)
+}
+
+func (suite *StoreHandlerSuite) TestMiddlewareTakesTheStoreOfTheHeader() {
+	centro := entities.Store{ID: 2, Name: "Centro", TimeZone: "America/Sao_Paulo", PickupCodePrefix: "C", Active: true}
+	suite.controller.EXPECT().Resolve(2).Return(&centro, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/store/status", nil)
+	req.Header.Set(StoreIDHeader, "2")
+	rec := httptest.NewRecorder()
+	c := suite.e.NewContext(req, rec)
+
+	err := suite.handler.Middleware(func(c echo.Context) error {
+		store, err := currentStore(c)
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), centro, store)
+		return c.NoContent(http.StatusOK)
+	})(c)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusOK, rec.Code)
+}
+
+func (suite *StoreHandlerSuite) TestMiddlewareReturnsBadRequestOnInvalidHeader() {
+	req := httptest.NewRequest(http.MethodGet, "/v1/store/status", nil)
+	req.Header.Set(StoreIDHeader, "matriz")
+	rec := httptest.NewRecorder()
+	c := suite.e.NewContext(req, rec)
+
+	err := suite.handler.Middleware(func(c echo.Context) error {
+		suite.T().Fatal("handler must not be called")
+		return nil
+	})(c)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusBadRequest, rec.Code)
+}
+
+func (suite *StoreHandlerSuite) TestMiddlewareReturnsNotFoundOnUnknownStore() {
+	suite.controller.EXPECT().Resolve(9).Return(nil, &custom_errors.NotFoundError{Message: "store not found"})
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/store/status", nil)
+	req.Header.Set(StoreIDHeader, "9")
+	rec := httptest.NewRecorder()
+	c := suite.e.NewContext(req, rec)
+
+	err := suite.handler.Middleware(func(c echo.Context) error {
+		suite.T().Fatal("handler must not be called")
+		return nil
+	})(c)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusNotFound, rec.Code)
+	assert.Equal(suite.T(), `"store not found"`+"\n", rec.Body.String())
+}
+
+func (suite *StoreHandlerSuite) TestGetAll() {
+	suite.controller.EXPECT().GetAll().Return([]entities.Store{matriz}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/stores", nil)
+	rec := httptest.NewRecorder()
+	c := suite.e.NewContext(req, rec)
+
+	err := suite.handler.GetAll(c)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusOK, rec.Code)
+	assert.Contains(suite.T(), rec.Body.String(), `"id":1,"name":"Matriz","time_zone":"UTC","pickup_code_prefix":"A","active":true`)
+}
+
+func (suite *StoreHandlerSuite) TestCreate() {
+	suite.controller.EXPECT().Create(gomock.Any()).DoAndReturn(func(storeDto dto.StoreDto) (*entities.Store, error) {
+		assert.Equal(suite.T(), "Centro", storeDto.Name)
+		assert.Equal(suite.T(), "America/Sao_Paulo", storeDto.TimeZone)
+		assert.Nil(suite.T(), storeDto.Active)
+		return &entities.Store{ID: 2, Name: "Centro", TimeZone: "America/Sao_Paulo", PickupCodePrefix: "C", Active: true}, nil
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/stores", strings.NewReader(`{"name":"Centro","time_zone":"America/Sao_Paulo","pickup_code_prefix":"c"}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := suite.e.NewContext(req, rec)
+
+	err := suite.handler.Create(c)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusOK, rec.Code)
+	assert.Contains(suite.T(), rec.Body.String(), `"id":2,"name":"Centro"`)
+}
+
+func (suite *StoreHandlerSuite) TestCreateReturnsValidationDetails() {
+	suite.controller.EXPECT().Create(gomock.Any()).Return(nil, &custom_errors.BadRequestError{
+		Message: "time_zone: must be a IANA time zone, such as America/Sao_Paulo.",
+		Details: []custom_errors.ErrorDetail{{Field: "time_zone", Message: "must be a IANA time zone, such as America/Sao_Paulo"}},
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/stores", strings.NewReader(`{"name":"Centro","time_zone":"Centro/Cidade","pickup_code_prefix":"C"}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := suite.e.NewContext(req, rec)
+
+	err := suite.handler.Create(c)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusBadRequest, rec.Code)
+	assert.Contains(suite.T(), rec.Body.String(), `"field":"time_zone"`)
+}
+
+func (suite *StoreHandlerSuite) TestUpdateReturnsNotFound() {
+	suite.controller.EXPECT().Update(9, gomock.Any()).Return(nil, &custom_errors.NotFoundError{Message: "store not found to update"})
+
+	req := httptest.NewRequest(http.MethodPut, "/v1/stores/9", strings.NewReader(`{"name":"Centro","time_zone":"America/Sao_Paulo","pickup_code_prefix":"C","active":false}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := suite.e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("9")
+
+	err := suite.handler.Update(c)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusNotFound, rec.Code)
+}
+
+func (suite *StoreHandlerSuite) TestGetStatus() {
+	nextOpening := time.Date(2026, time.October, 19, 10, 0, 0, 0, time.UTC)
+	suite.controller.EXPECT().GetStatus(matriz).Return(&entities.StoreStatus{NextOpening: &nextOpening}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/store/status", nil)
+	rec := httptest.NewRecorder()
+	c := suite.e.NewContext(req, rec)
+	c.Set(storeContextKey, matriz)
+
+	err := suite.handler.GetStatus(c)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusOK, rec.Code)
+	assert.JSONEq(suite.T(), `{"open":false,"next_opening":"2026-10-19T10:00:00Z"}`, rec.Body.String())
+}
+
+func (suite *StoreHandlerSuite) TestGetStatusReturnsErrorWithoutStore() {
+	req := httptest.NewRequest(http.MethodGet, "/v1/store/status", nil)
+	rec := httptest.NewRecorder()
+	c := suite.e.NewContext(req, rec)
+
+	err := suite.handler.GetStatus(c)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusInternalServerError, rec.Code)
+}
+
+func (suite *StoreHandlerSuite) TestGetHours() {
+	suite.controller.EXPECT().GetHours(matriz).Return([]entities.StoreHours{{Weekday: time.Saturday, OpenTime: "18:00", CloseTime: "02:00"}}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/store/hours", nil)
+	rec := httptest.NewRecorder()
+	c := suite.e.NewContext(req, rec)
+	c.Set(storeContextKey, matriz)
+
+	err := suite.handler.GetHours(c)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusOK, rec.Code)
+	assert.JSONEq(suite.T(), `[{"weekday":6,"open_time":"18:00","close_time":"02:00"}]`, rec.Body.String())
+}
+
+func (suite *StoreHandlerSuite) TestUpdateHoursReturnsValidationDetails() {
+	suite.controller.EXPECT().UpdateHours(matriz, gomock.Any()).DoAndReturn(func(store entities.Store, hoursDto dto.StoreHoursDto) ([]entities.StoreHours, error) {
+		assert.Equal(suite.T(), 7, hoursDto.Days[0].Weekday)
+		return nil, &custom_errors.BadRequestError{
+			Message: "days: (0: (weekday: must be no greater than 6.).).",
+			Details: []custom_errors.ErrorDetail{{Field: "days.0.weekday", Message: "must be no greater than 6"}},
+		}
+	})
+
+	req := httptest.NewRequest(http.MethodPut, "/v1/store/hours", strings.NewReader(`{"days":[{"weekday":7,"open_time":"10:00","close_time":"22:00"}]}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := suite.e.NewContext(req, rec)
+	c.Set(storeContextKey, matriz)
+
+	err := suite.handler.UpdateHours(c)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusBadRequest, rec.Code)
+	assert.Contains(suite.T(), rec.Body.String(), `"field":"days.0.weekday"`)
+}
+
+func (suite *StoreHandlerSuite) TestCreateCalendarDay() {
+	suite.controller.EXPECT().CreateCalendarDay(matriz, gomock.Any()).DoAndReturn(func(store entities.Store, dayDto dto.StoreCalendarDayDto) (*entities.StoreCalendarDay, error) {
+		assert.Equal(suite.T(), "2026-12-25", dayDto.Date)
+		assert.True(suite.T(), dayDto.Closed)
+		return &entities.StoreCalendarDay{ID: 2, Date: time.Date(2026, time.December, 25, 0, 0, 0, 0, time.UTC), Closed: true}, nil
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/store/calendar", strings.NewReader(`{"date":"2026-12-25","closed":true,"description":"Natal"}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := suite.e.NewContext(req, rec)
+	c.Set(storeContextKey, matriz)
+
+	err := suite.handler.CreateCalendarDay(c)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusOK, rec.Code)
+	assert.Contains(suite.T(), rec.Body.String(), `"date":"2026-12-25T00:00:00Z","closed":true`)
+}
+
+func (suite *StoreHandlerSuite) TestCreateCalendarDayReturnsConflictOnDateTaken() {
+	suite.controller.EXPECT().CreateCalendarDay(matriz, gomock.Any()).Return(nil, &custom_errors.ConflictError{Message: "store calendar already has the date"})
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/store/calendar", strings.NewReader(`{"date":"2026-12-25","closed":true}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := suite.e.NewContext(req, rec)
+	c.Set(storeContextKey, matriz)
+
+	err := suite.handler.CreateCalendarDay(c)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusConflict, rec.Code)
+}
+
+func (suite *StoreHandlerSuite) TestDeleteCalendarDayReturnsNotFound() {
+	suite.controller.EXPECT().DeleteCalendarDay(matriz, 9).Return(&custom_errors.NotFoundError{Message: "store calendar day not found to delete"})
+
+	req := httptest.NewRequest(http.MethodDelete, "/v1/store/calendar/9", nil)
+	rec := httptest.NewRecorder()
+	c := suite.e.NewContext(req, rec)
+	c.Set(storeContextKey, matriz)
+	c.SetParamNames("id")
+	c.SetParamValues("9")
+
+	err := suite.handler.DeleteCalendarDay(c)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusNotFound, rec.Code)
+}
+
+func TestStoreHandlerSuite(t *testing.T) {
+	suite.Run(t, new(StoreHandlerSuite))
+}
@@ -5,12 +5,15 @@ import (
 	"fmt"
 	"github.com/8soat-grupo35/fastfood-order/external"
 	httpClient "github.com/8soat-grupo35/fastfood-order/internal/adapters/http"
+	"github.com/8soat-grupo35/fastfood-order/internal/api/events"
 	"github.com/8soat-grupo35/fastfood-order/internal/api/handlers"
+	"github.com/8soat-grupo35/fastfood-order/internal/api/workers"
 	"net/http"
 
 	_ "github.com/8soat-grupo35/fastfood-order/docs"
 	"github.com/labstack/echo/v4"
 	echoSwagger "github.com/swaggo/echo-swagger"
+	"gorm.io/gorm"
 )
 
 func Start(cfg external.Config) {
@@ -37,32 +40,107 @@ func newApp(cfg external.Config) *echo.Echo {
 	external.ConectaDB(cfg.DatabaseConfig.Host, cfg.DatabaseConfig.User, cfg.DatabaseConfig.Password, cfg.DatabaseConfig.DbName, cfg.DatabaseConfig.Port)
 	paymentClient := httpClient.NewClient(cfg.HttpConfig.ServiceURL, cfg.HttpConfig.Timeout)
 
+	outboxWorker := workers.NewOutboxWorker(external.DB, paymentClient, cfg.OutboxConfig.DispatchInterval, cfg.OutboxConfig.BatchSize)
+	go outboxWorker.Start(context.Background())
+
+	idempotencyKeyWorker := workers.NewIdempotencyKeyWorker(external.DB, cfg.IdempotencyKeyConfig.PurgeInterval)
+	go idempotencyKeyWorker.Start(context.Background())
+
+	orderEventsHub := events.NewHub()
+	orderEventWorker := workers.NewOrderEventWorker(external.DB, orderEventsHub, cfg.StreamConfig.ListenRetryInterval)
+	go orderEventWorker.Start(context.Background())
+
+	return newRouter(external.DB, cfg.StoreConfig, orderEventsHub)
+}
+
+func newRouter(db *gorm.DB, storeConfig external.StoreConfig, orderEventsHub *events.Hub) *echo.Echo {
 	app := echo.New()
 	app.GET("/swagger/*", echoSwagger.WrapHandler)
 	app.GET("/", func(echo echo.Context) error {
 		return echo.JSON(http.StatusOK, "Alive")
 	})
 
-	customerHandler := handlers.NewCustomerHandler(external.DB)
+	idempotencyKeyHandler := handlers.NewIdempotencyKeyHandler(db)
+
+	storeHandler := handlers.NewStoreHandler(db, storeConfig.DefaultStoreID)
+	storesV1Group := app.Group("/v1/stores")
+	storesV1Group.GET("", storeHandler.GetAll)
+	storesV1Group.POST("", storeHandler.Create, idempotencyKeyHandler.Middleware)
+	storesV1Group.PUT("/:id", storeHandler.Update)
+
+	customerHandler := handlers.NewCustomerHandler(db)
 	customerGroupV1 := app.Group("/v1/customer")
 	customerGroupV1.GET("", customerHandler.GetAll)
 	customerGroupV1.GET("/cpf/:cpf", customerHandler.GetByCpf)
-	customerGroupV1.POST("", customerHandler.Create)
+	customerGroupV1.POST("", customerHandler.Create, idempotencyKeyHandler.Middleware)
 	customerGroupV1.PUT("/:id", customerHandler.Update)
 	customerGroupV1.DELETE("/:id", customerHandler.Delete)
 
-	itemHandler := handlers.NewItemHandler(external.DB)
-	itemV1Group := app.Group("/v1/item")
+	categoryHandler := handlers.NewCategoryHandler(db)
+	categoryV1Group := app.Group("/v1/category")
+	categoryV1Group.GET("", categoryHandler.GetAll)
+	categoryV1Group.POST("", categoryHandler.Create, idempotencyKeyHandler.Middleware)
+	categoryV1Group.PUT("/:id", categoryHandler.Update)
+	categoryV1Group.DELETE("/:id", categoryHandler.Delete)
+
+	itemHandler := handlers.NewItemHandler(db)
+	itemV1Group := app.Group("/v1/item", storeHandler.Middleware)
 	itemV1Group.GET("", itemHandler.GetAll)
-	itemV1Group.POST("", itemHandler.Create)
+	itemV1Group.POST("", itemHandler.Create, idempotencyKeyHandler.Middleware)
 	itemV1Group.PUT("/:id", itemHandler.Update)
+	itemV1Group.PATCH("/:id/availability", itemHandler.SetAvailability)
 	itemV1Group.DELETE("/:id", itemHandler.Delete)
 
-	orderHandler := handlers.NewOrderHandler(external.DB, paymentClient)
-	orderV1Group := app.Group("/v1/orders")
+	ingredientHandler := handlers.NewIngredientHandler(db)
+	ingredientV1Group := app.Group("/v1/ingredient", storeHandler.Middleware)
+	ingredientV1Group.GET("", ingredientHandler.GetAll)
+	ingredientV1Group.GET("/low-stock", ingredientHandler.GetLowStock)
+	ingredientV1Group.POST("", ingredientHandler.Create, idempotencyKeyHandler.Middleware)
+	ingredientV1Group.PUT("/:id", ingredientHandler.Update)
+	ingredientV1Group.DELETE("/:id", ingredientHandler.Delete)
+
+	comboHandler := handlers.NewComboHandler(db)
+	comboV1Group := app.Group("/v1/combo")
+	comboV1Group.GET("", comboHandler.GetAll)
+	comboV1Group.POST("", comboHandler.Create, idempotencyKeyHandler.Middleware)
+	comboV1Group.PUT("/:id", comboHandler.Update)
+	comboV1Group.DELETE("/:id", comboHandler.Delete)
+
+	menuHandler := handlers.NewMenuHandler(db)
+	menuV1Group := app.Group("/v1/menu", storeHandler.Middleware)
+	menuV1Group.GET("", menuHandler.GetAll)
+	menuV1Group.POST("", menuHandler.Create, idempotencyKeyHandler.Middleware)
+	menuV1Group.PUT("/:id", menuHandler.Update)
+	menuV1Group.DELETE("/:id", menuHandler.Delete)
+
+	storeV1Group := app.Group("/v1/store", storeHandler.Middleware)
+	storeV1Group.GET("/status", storeHandler.GetStatus)
+	storeV1Group.GET("/hours", storeHandler.GetHours)
+	storeV1Group.PUT("/hours", storeHandler.UpdateHours)
+	storeV1Group.GET("/calendar", storeHandler.GetCalendar)
+	storeV1Group.POST("/calendar", storeHandler.CreateCalendarDay, idempotencyKeyHandler.Middleware)
+	storeV1Group.PUT("/calendar/:id", storeHandler.UpdateCalendarDay)
+	storeV1Group.DELETE("/calendar/:id", storeHandler.DeleteCalendarDay)
+
+	promotionHandler := handlers.NewPromotionHandler(db)
+	promotionV1Group := app.Group("/v1/promotion", storeHandler.Middleware)
+	promotionV1Group.GET("", promotionHandler.GetAll)
+	promotionV1Group.POST("", promotionHandler.Create, idempotencyKeyHandler.Middleware)
+	promotionV1Group.PUT("/:id", promotionHandler.Update)
+	promotionV1Group.DELETE("/:id", promotionHandler.Delete)
+
+	orderHandler := handlers.NewOrderHandler(db, orderEventsHub)
+	app.POST("/v1/orders/:id/payment-status", orderHandler.UpdatePaymentStatus)
+	orderV1Group := app.Group("/v1/orders", storeHandler.Middleware)
 	orderV1Group.GET("", orderHandler.GetAll)
-	orderV1Group.POST("/checkout", orderHandler.Checkout)
+	orderV1Group.GET("/stream", orderHandler.Stream)
+	orderV1Group.GET("/:code/tracking", orderHandler.Tracking)
+	orderV1Group.GET("/:id/timeline", orderHandler.Timeline)
+	orderV1Group.POST("/checkout", orderHandler.Checkout, idempotencyKeyHandler.Middleware)
 	orderV1Group.PATCH("/:id", orderHandler.UpdateStatus)
+	orderV1Group.POST("/:id/reorder", orderHandler.Reorder, idempotencyKeyHandler.Middleware)
+	orderV1Group.POST("/:id/cancel", orderHandler.Cancel)
+	customerGroupV1.GET("/:id/orders", orderHandler.GetByCustomer, storeHandler.Middleware)
 
 	return app
 }
@@ -0,0 +1,82 @@
+package server
+
+import (
+	"database/sql"
+	"github.com/8soat-grupo35/fastfood-order/external"
+	"github.com/8soat-grupo35/fastfood-order/internal/api/events"
+	"github.com/8soat-grupo35/fastfood-order/internal/api/handlers"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type RouterSuite struct {
+	suite.Suite
+	conn *sql.DB
+	mock sqlmock.Sqlmock
+	app  *echo.Echo
+}
+
+func (suite *RouterSuite) SetupTest() {
+	var err error
+	suite.conn, suite.mock, err = sqlmock.New()
+	assert.NoError(suite.T(), err)
+
+	db, err := gorm.Open(postgres.New(postgres.Config{
+		DriverName: "postgres",
+		Conn:       suite.conn,
+	}), &gorm.Config{})
+	assert.NoError(suite.T(), err)
+
+	suite.app = newRouter(db, external.StoreConfig{DefaultStoreID: 1}, events.NewHub())
+}
+
+func (suite *RouterSuite) TearDownTest() {
+	suite.conn.Close()
+}
+
+func (suite *RouterSuite) TestStoreRoutesResolveTheStoreOfTheRequest() {
+	routes := []struct {
+		method string
+		path   string
+		body   string
+	}{
+		{http.MethodGet, "/v1/item", ""},
+		{http.MethodPost, "/v1/item", `{"name":"X-Burguer","category":"LANCHE","price":10,"stock":5}`},
+		{http.MethodPut, "/v1/item/1", `{"name":"X-Burguer","category":"LANCHE","price":10,"stock":5}`},
+		{http.MethodPatch, "/v1/item/1/availability", `{"available":false}`},
+		{http.MethodGet, "/v1/ingredient", ""},
+		{http.MethodGet, "/v1/ingredient/low-stock", ""},
+		{http.MethodPost, "/v1/ingredient", `{"name":"Pão","unit":"un","stock":10}`},
+		{http.MethodPut, "/v1/ingredient/1", `{"name":"Pão","unit":"un","stock":10}`},
+		{http.MethodGet, "/v1/promotion", ""},
+		{http.MethodPost, "/v1/promotion", `{"name":"Dez reais","kind":"VALOR_FIXO","amount":10}`},
+		{http.MethodPut, "/v1/promotion/1", `{"name":"Dez reais","kind":"VALOR_FIXO","amount":10}`},
+		{http.MethodDelete, "/v1/promotion/1", ""},
+	}
+
+	for _, route := range routes {
+		suite.mock.ExpectQuery("SELECT (.+) FROM \"stores\" WHERE (.+)").WithArgs(9, 1).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+		req := httptest.NewRequest(route.method, route.path, strings.NewReader(route.body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set(handlers.StoreIDHeader, "9")
+		rec := httptest.NewRecorder()
+
+		suite.app.ServeHTTP(rec, req)
+
+		assert.Equal(suite.T(), http.StatusNotFound, rec.Code, route.method+" "+route.path)
+		assert.Nil(suite.T(), suite.mock.ExpectationsWereMet(), route.method+" "+route.path)
+	}
+}
+
+func TestRouterSuite(t *testing.T) {
+	suite.Run(t, new(RouterSuite))
+}
@@ -0,0 +1,41 @@
+package workers
+
+import (
+	"context"
+	"github.com/8soat-grupo35/fastfood-order/internal/controllers"
+	controllersInterface "github.com/8soat-grupo35/fastfood-order/internal/interfaces/controllers"
+	"log"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type IdempotencyKeyWorker struct {
+	idempotencyKeyController controllersInterface.IdempotencyKeyController
+	interval                 time.Duration
+}
+
+func NewIdempotencyKeyWorker(db *gorm.DB, interval time.Duration) IdempotencyKeyWorker {
+	return IdempotencyKeyWorker{
+		idempotencyKeyController: controllers.NewIdempotencyKeyController(db),
+		interval:                 interval,
+	}
+}
+
+// Start deletes the expired idempotency keys on every tick until the context is done.
+func (w IdempotencyKeyWorker) Start(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := w.idempotencyKeyController.Purge()
+			if err != nil {
+				log.Println(err.Error())
+			}
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package workers
+
+import (
+	"context"
+	"github.com/8soat-grupo35/fastfood-order/internal/api/events"
+	"github.com/8soat-grupo35/fastfood-order/internal/controllers"
+	controllersInterface "github.com/8soat-grupo35/fastfood-order/internal/interfaces/controllers"
+	"log"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type OrderEventWorker struct {
+	orderEventController controllersInterface.OrderEventController
+	hub                  *events.Hub
+	retryInterval        time.Duration
+}
+
+func NewOrderEventWorker(db *gorm.DB, hub *events.Hub, retryInterval time.Duration) OrderEventWorker {
+	return OrderEventWorker{
+		orderEventController: controllers.NewOrderEventController(db),
+		hub:                  hub,
+		retryInterval:        retryInterval,
+	}
+}
+
+// Start relays the order events published by any replica to the stream clients of this one, listening
+// again after a pause whenever the connection is lost, until the context is done.
+func (w OrderEventWorker) Start(ctx context.Context) {
+	for {
+		err := w.orderEventController.Listen(ctx, w.hub.Broadcast)
+		if err != nil {
+			log.Println(err.Error())
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(w.retryInterval):
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package workers
+
+import (
+	"context"
+	"errors"
+	"github.com/8soat-grupo35/fastfood-order/internal/api/events"
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+	mockControllers "github.com/8soat-grupo35/fastfood-order/internal/interfaces/controllers/mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"testing"
+	"time"
+)
+
+func TestOrderEventWorkerRelaysEventsAndListensAgainAfterFailure(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	controller := mockControllers.NewMockOrderEventController(ctrl)
+	hub := events.NewHub()
+	worker := OrderEventWorker{orderEventController: controller, hub: hub, retryInterval: 10 * time.Millisecond}
+
+	subscriber, unsubscribe := hub.Subscribe(1)
+	defer unsubscribe()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	gomock.InOrder(
+		controller.EXPECT().Listen(ctx, gomock.Any()).Return(errors.New("connection lost")),
+		controller.EXPECT().Listen(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, handle func(entities.OrderEvent)) error {
+			handle(entities.OrderEvent{Type: entities.ORDER_CREATED_EVENT, OrderID: 1, StoreID: 1})
+			cancel()
+			return nil
+		}),
+	)
+
+	done := make(chan struct{})
+	go func() {
+		worker.Start(ctx)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		assert.Fail(t, "order event worker did not stop")
+	}
+	assert.Equal(t, uint32(1), (<-subscriber).OrderID)
+}
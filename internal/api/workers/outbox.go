@@ -0,0 +1,42 @@
+package workers
+
+import (
+	"context"
+	"github.com/8soat-grupo35/fastfood-order/internal/controllers"
+	controllersInterface "github.com/8soat-grupo35/fastfood-order/internal/interfaces/controllers"
+	"log"
+	"time"
+
+	httpClient "github.com/8soat-grupo35/fastfood-order/internal/adapters/http"
+	"gorm.io/gorm"
+)
+
+type OutboxWorker struct {
+	outboxController controllersInterface.OutboxController
+	interval         time.Duration
+}
+
+func NewOutboxWorker(db *gorm.DB, httpClient *httpClient.Client, interval time.Duration, batchSize int) OutboxWorker {
+	return OutboxWorker{
+		outboxController: controllers.NewOutboxController(db, httpClient, batchSize),
+		interval:         interval,
+	}
+}
+
+// Start dispatches the pending outbox messages on every tick until the context is done.
+func (w OutboxWorker) Start(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := w.outboxController.Dispatch()
+			if err != nil {
+				log.Println(err.Error())
+			}
+		}
+	}
+}
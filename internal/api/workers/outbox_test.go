@@ -0,0 +1,34 @@
+package workers
+
+import (
+	"context"
+	mockControllers "github.com/8soat-grupo35/fastfood-order/internal/interfaces/controllers/mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"testing"
+	"time"
+)
+
+func TestOutboxWorkerDispatchesUntilContextIsDone(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	controller := mockControllers.NewMockOutboxController(ctrl)
+	worker := OutboxWorker{outboxController: controller, interval: 10 * time.Millisecond}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	controller.EXPECT().Dispatch().DoAndReturn(func() error {
+		cancel()
+		return nil
+	}).MinTimes(1)
+
+	done := make(chan struct{})
+	go func() {
+		worker.Start(ctx)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		assert.Fail(t, "outbox worker did not stop")
+	}
+}
@@ -0,0 +1,37 @@
+package controllers
+
+import (
+	"github.com/8soat-grupo35/fastfood-order/internal/adapters/dto"
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+	"github.com/8soat-grupo35/fastfood-order/internal/gateways"
+	controllersInterface "github.com/8soat-grupo35/fastfood-order/internal/interfaces/controllers"
+	"github.com/8soat-grupo35/fastfood-order/internal/interfaces/usecase"
+	"github.com/8soat-grupo35/fastfood-order/internal/usecases"
+	"gorm.io/gorm"
+)
+
+type CategoryController struct {
+	UseCase usecase.CategoryUseCase
+}
+
+func NewCategoryController(db *gorm.DB) controllersInterface.CategoryController {
+	return &CategoryController{
+		UseCase: usecases.NewCategoryUseCase(gateways.NewCategoryGateway(db)),
+	}
+}
+
+func (p *CategoryController) GetAll(onlyActive bool) ([]entities.Category, error) {
+	return p.UseCase.GetAll(onlyActive)
+}
+
+func (p *CategoryController) Create(categoryDto dto.CategoryDto) (*entities.Category, error) {
+	return p.UseCase.Create(categoryDto)
+}
+
+func (p *CategoryController) Update(categoryId int, categoryDto dto.CategoryDto) (*entities.Category, error) {
+	return p.UseCase.Update(uint32(categoryId), categoryDto)
+}
+
+func (p *CategoryController) Delete(categoryId int) error {
+	return p.UseCase.Delete(uint32(categoryId))
+}
@@ -0,0 +1,71 @@
+package controllers
+
+import (
+	"github.com/8soat-grupo35/fastfood-order/internal/adapters/dto"
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+	mockUsecase "github.com/8soat-grupo35/fastfood-order/internal/interfaces/usecase/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+	"testing"
+)
+
+type CategoryControllerSuite struct {
+	suite.Suite
+	ctrl       *gomock.Controller
+	useCase    *mockUsecase.MockCategoryUseCase
+	controller *CategoryController
+}
+
+func (suite *CategoryControllerSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.useCase = mockUsecase.NewMockCategoryUseCase(suite.ctrl)
+	suite.controller = &CategoryController{UseCase: suite.useCase}
+}
+
+func (suite *CategoryControllerSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func (suite *CategoryControllerSuite) TestGetAll() {
+	expectedCategories := []entities.Category{{ID: 1, Name: "LANCHE", DisplayOrder: 1, Active: true}}
+
+	suite.useCase.EXPECT().GetAll(true).Return(expectedCategories, nil)
+
+	categories, err := suite.controller.GetAll(true)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedCategories, categories)
+}
+
+func (suite *CategoryControllerSuite) TestCreate() {
+	categoryDto := dto.CategoryDto{Name: "Salada", DisplayOrder: 5}
+	expectedCategory := &entities.Category{ID: 5, Name: "SALADA", DisplayOrder: 5, Active: true}
+
+	suite.useCase.EXPECT().Create(categoryDto).Return(expectedCategory, nil)
+
+	category, err := suite.controller.Create(categoryDto)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedCategory, category)
+}
+
+func (suite *CategoryControllerSuite) TestUpdate() {
+	categoryDto := dto.CategoryDto{Name: "Salada", DisplayOrder: 2}
+	expectedCategory := &entities.Category{ID: 5, Name: "SALADA", DisplayOrder: 2, Active: true}
+
+	suite.useCase.EXPECT().Update(uint32(5), categoryDto).Return(expectedCategory, nil)
+
+	category, err := suite.controller.Update(5, categoryDto)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedCategory, category)
+}
+
+func (suite *CategoryControllerSuite) TestDelete() {
+	suite.useCase.EXPECT().Delete(uint32(5)).Return(nil)
+
+	err := suite.controller.Delete(5)
+	assert.NoError(suite.T(), err)
+}
+
+func TestCategoryControllerSuite(t *testing.T) {
+	suite.Run(t, new(CategoryControllerSuite))
+}
@@ -0,0 +1,37 @@
+package controllers
+
+import (
+	"github.com/8soat-grupo35/fastfood-order/internal/adapters/dto"
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+	"github.com/8soat-grupo35/fastfood-order/internal/gateways"
+	controllersInterface "github.com/8soat-grupo35/fastfood-order/internal/interfaces/controllers"
+	"github.com/8soat-grupo35/fastfood-order/internal/interfaces/usecase"
+	"github.com/8soat-grupo35/fastfood-order/internal/usecases"
+	"gorm.io/gorm"
+)
+
+type ComboController struct {
+	UseCase usecase.ComboUseCase
+}
+
+func NewComboController(db *gorm.DB) controllersInterface.ComboController {
+	return &ComboController{
+		UseCase: usecases.NewComboUseCase(gateways.NewComboGateway(db), gateways.NewItemGateway(db)),
+	}
+}
+
+func (c *ComboController) GetAll() ([]entities.Combo, error) {
+	return c.UseCase.GetAll()
+}
+
+func (c *ComboController) Create(comboDto dto.ComboDto) (*entities.Combo, error) {
+	return c.UseCase.Create(comboDto)
+}
+
+func (c *ComboController) Update(comboId int, comboDto dto.ComboDto) (*entities.Combo, error) {
+	return c.UseCase.Update(uint32(comboId), comboDto)
+}
+
+func (c *ComboController) Delete(comboId int) error {
+	return c.UseCase.Delete(uint32(comboId))
+}
@@ -0,0 +1,71 @@
+package controllers
+
+import (
+	"github.com/8soat-grupo35/fastfood-order/internal/adapters/dto"
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+	mockUsecase "github.com/8soat-grupo35/fastfood-order/internal/interfaces/usecase/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+	"testing"
+)
+
+type ComboControllerSuite struct {
+	suite.Suite
+	ctrl       *gomock.Controller
+	useCase    *mockUsecase.MockComboUseCase
+	controller *ComboController
+}
+
+func (suite *ComboControllerSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.useCase = mockUsecase.NewMockComboUseCase(suite.ctrl)
+	suite.controller = &ComboController{UseCase: suite.useCase}
+}
+
+func (suite *ComboControllerSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func (suite *ComboControllerSuite) TestGetAll() {
+	expectedCombos := []entities.Combo{{ID: 7, Name: "Combo Classico"}}
+
+	suite.useCase.EXPECT().GetAll().Return(expectedCombos, nil)
+
+	combos, err := suite.controller.GetAll()
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedCombos, combos)
+}
+
+func (suite *ComboControllerSuite) TestCreate() {
+	comboDto := dto.ComboDto{Name: "Combo Classico"}
+	expectedCombo := &entities.Combo{ID: 7, Name: "Combo Classico"}
+
+	suite.useCase.EXPECT().Create(comboDto).Return(expectedCombo, nil)
+
+	combo, err := suite.controller.Create(comboDto)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedCombo, combo)
+}
+
+func (suite *ComboControllerSuite) TestUpdate() {
+	comboDto := dto.ComboDto{Name: "Combo Classico"}
+	expectedCombo := &entities.Combo{ID: 7, Name: "Combo Classico"}
+
+	suite.useCase.EXPECT().Update(uint32(7), comboDto).Return(expectedCombo, nil)
+
+	combo, err := suite.controller.Update(7, comboDto)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedCombo, combo)
+}
+
+func (suite *ComboControllerSuite) TestDelete() {
+	suite.useCase.EXPECT().Delete(uint32(7)).Return(nil)
+
+	err := suite.controller.Delete(7)
+	assert.NoError(suite.T(), err)
+}
+
+func TestComboControllerSuite(t *testing.T) {
+	suite.Run(t, new(ComboControllerSuite))
+}
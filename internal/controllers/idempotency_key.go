@@ -0,0 +1,37 @@
+package controllers
+
+import (
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+	"github.com/8soat-grupo35/fastfood-order/internal/gateways"
+	controllersInterface "github.com/8soat-grupo35/fastfood-order/internal/interfaces/controllers"
+	"github.com/8soat-grupo35/fastfood-order/internal/interfaces/usecase"
+	"github.com/8soat-grupo35/fastfood-order/internal/usecases"
+	"gorm.io/gorm"
+)
+
+type IdempotencyKeyController struct {
+	UseCase usecase.IdempotencyKeyUseCase
+}
+
+func NewIdempotencyKeyController(db *gorm.DB) controllersInterface.IdempotencyKeyController {
+	gateway := gateways.NewIdempotencyKeyGateway(db)
+	return &IdempotencyKeyController{
+		UseCase: usecases.NewIdempotencyKeyUseCase(gateway),
+	}
+}
+
+func (i *IdempotencyKeyController) Begin(key string, scope string, requestBody []byte) (*entities.IdempotencyKey, error) {
+	return i.UseCase.Begin(key, scope, requestBody)
+}
+
+func (i *IdempotencyKeyController) Complete(idempotencyKey entities.IdempotencyKey, responseStatus int, responseBody []byte) error {
+	return i.UseCase.Complete(idempotencyKey, responseStatus, responseBody)
+}
+
+func (i *IdempotencyKeyController) Release(idempotencyKey entities.IdempotencyKey) error {
+	return i.UseCase.Release(idempotencyKey)
+}
+
+func (i *IdempotencyKeyController) Purge() error {
+	return i.UseCase.Purge()
+}
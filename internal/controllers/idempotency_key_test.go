@@ -0,0 +1,62 @@
+package controllers
+
+import (
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+	mockUsecase "github.com/8soat-grupo35/fastfood-order/internal/interfaces/usecase/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+	"testing"
+)
+
+type IdempotencyKeyControllerSuite struct {
+	suite.Suite
+	ctrl       *gomock.Controller
+	useCase    *mockUsecase.MockIdempotencyKeyUseCase
+	controller *IdempotencyKeyController
+}
+
+func (suite *IdempotencyKeyControllerSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.useCase = mockUsecase.NewMockIdempotencyKeyUseCase(suite.ctrl)
+	suite.controller = &IdempotencyKeyController{UseCase: suite.useCase}
+}
+
+func (suite *IdempotencyKeyControllerSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func (suite *IdempotencyKeyControllerSuite) TestBegin() {
+	reservedKey := &entities.IdempotencyKey{ID: 1, Key: "abc"}
+
+	suite.useCase.EXPECT().Begin("abc", "POST /v1/item", []byte(`{}`)).Return(reservedKey, nil)
+
+	idempotencyKey, err := suite.controller.Begin("abc", "POST /v1/item", []byte(`{}`))
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), reservedKey, idempotencyKey)
+}
+
+func (suite *IdempotencyKeyControllerSuite) TestComplete() {
+	suite.useCase.EXPECT().Complete(entities.IdempotencyKey{ID: 1}, 200, []byte(`{}`)).Return(nil)
+
+	err := suite.controller.Complete(entities.IdempotencyKey{ID: 1}, 200, []byte(`{}`))
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *IdempotencyKeyControllerSuite) TestRelease() {
+	suite.useCase.EXPECT().Release(entities.IdempotencyKey{ID: 1}).Return(nil)
+
+	err := suite.controller.Release(entities.IdempotencyKey{ID: 1})
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *IdempotencyKeyControllerSuite) TestPurge() {
+	suite.useCase.EXPECT().Purge().Return(nil)
+
+	err := suite.controller.Purge()
+	assert.NoError(suite.T(), err)
+}
+
+func TestIdempotencyKeyControllerSuite(t *testing.T) {
+	suite.Run(t, new(IdempotencyKeyControllerSuite))
+}
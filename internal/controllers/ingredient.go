@@ -0,0 +1,41 @@
+package controllers
+
+import (
+	"github.com/8soat-grupo35/fastfood-order/internal/adapters/dto"
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+	"github.com/8soat-grupo35/fastfood-order/internal/gateways"
+	controllersInterface "github.com/8soat-grupo35/fastfood-order/internal/interfaces/controllers"
+	"github.com/8soat-grupo35/fastfood-order/internal/interfaces/usecase"
+	"github.com/8soat-grupo35/fastfood-order/internal/usecases"
+	"gorm.io/gorm"
+)
+
+type IngredientController struct {
+	UseCase usecase.IngredientUseCase
+}
+
+func NewIngredientController(db *gorm.DB) controllersInterface.IngredientController {
+	return &IngredientController{
+		UseCase: usecases.NewIngredientUseCase(gateways.NewIngredientGateway(db)),
+	}
+}
+
+func (p *IngredientController) GetAll(store entities.Store) ([]entities.Ingredient, error) {
+	return p.UseCase.GetAll(store)
+}
+
+func (p *IngredientController) GetLowStock(store entities.Store) ([]entities.Ingredient, error) {
+	return p.UseCase.GetLowStock(store)
+}
+
+func (p *IngredientController) Create(store entities.Store, ingredientDto dto.IngredientDto) (*entities.Ingredient, error) {
+	return p.UseCase.Create(store, ingredientDto)
+}
+
+func (p *IngredientController) Update(store entities.Store, ingredientId int, ingredientDto dto.IngredientDto) (*entities.Ingredient, error) {
+	return p.UseCase.Update(store, uint32(ingredientId), ingredientDto)
+}
+
+func (p *IngredientController) Delete(ingredientId int) error {
+	return p.UseCase.Delete(uint32(ingredientId))
+}
@@ -0,0 +1,81 @@
+package controllers
+
+import (
+	"github.com/8soat-grupo35/fastfood-order/internal/adapters/dto"
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+	mockUsecase "github.com/8soat-grupo35/fastfood-order/internal/interfaces/usecase/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+	"testing"
+)
+
+type IngredientControllerSuite struct {
+	suite.Suite
+	ctrl       *gomock.Controller
+	useCase    *mockUsecase.MockIngredientUseCase
+	controller *IngredientController
+}
+
+func (suite *IngredientControllerSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.useCase = mockUsecase.NewMockIngredientUseCase(suite.ctrl)
+	suite.controller = &IngredientController{UseCase: suite.useCase}
+}
+
+func (suite *IngredientControllerSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func (suite *IngredientControllerSuite) TestGetAll() {
+	expectedIngredients := []entities.Ingredient{{ID: 5, Name: "Queijo"}}
+
+	suite.useCase.EXPECT().GetAll(matriz).Return(expectedIngredients, nil)
+
+	ingredients, err := suite.controller.GetAll(matriz)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedIngredients, ingredients)
+}
+
+func (suite *IngredientControllerSuite) TestGetLowStock() {
+	expectedIngredients := []entities.Ingredient{{ID: 5, Name: "Queijo", Stock: 400000, LowStockThreshold: 500000}}
+
+	suite.useCase.EXPECT().GetLowStock(matriz).Return(expectedIngredients, nil)
+
+	ingredients, err := suite.controller.GetLowStock(matriz)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedIngredients, ingredients)
+}
+
+func (suite *IngredientControllerSuite) TestCreate() {
+	ingredientDto := dto.IngredientDto{Name: "Queijo", Unit: "G"}
+	expectedIngredient := &entities.Ingredient{ID: 5, Name: "Queijo", Unit: "G"}
+
+	suite.useCase.EXPECT().Create(matriz, ingredientDto).Return(expectedIngredient, nil)
+
+	ingredient, err := suite.controller.Create(matriz, ingredientDto)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedIngredient, ingredient)
+}
+
+func (suite *IngredientControllerSuite) TestUpdate() {
+	ingredientDto := dto.IngredientDto{Name: "Queijo", Unit: "G"}
+	expectedIngredient := &entities.Ingredient{ID: 5, Name: "Queijo", Unit: "G"}
+
+	suite.useCase.EXPECT().Update(matriz, uint32(5), ingredientDto).Return(expectedIngredient, nil)
+
+	ingredient, err := suite.controller.Update(matriz, 5, ingredientDto)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedIngredient, ingredient)
+}
+
+func (suite *IngredientControllerSuite) TestDelete() {
+	suite.useCase.EXPECT().Delete(uint32(5)).Return(nil)
+
+	err := suite.controller.Delete(5)
+	assert.NoError(suite.T(), err)
+}
+
+func TestIngredientControllerSuite(t *testing.T) {
+	suite.Run(t, new(IngredientControllerSuite))
+}
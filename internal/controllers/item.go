@@ -16,25 +16,31 @@ type ItemController struct {
 
 func NewItemController(db *gorm.DB) controllersInterface.ItemController {
 	gateway := gateways.NewItemGateway(db)
+	ingredientGateway := gateways.NewIngredientGateway(db)
+	categoryGateway := gateways.NewCategoryGateway(db)
 	return &ItemController{
-		UseCase: usecases.NewItemUseCase(gateway),
+		UseCase: usecases.NewItemUseCase(gateway, ingredientGateway, categoryGateway),
 	}
 }
 
-func (i *ItemController) GetAll() ([]entities.Item, error) {
-	return i.UseCase.GetAll("")
+func (i *ItemController) GetAll(store entities.Store) ([]entities.Item, error) {
+	return i.UseCase.GetAll(store, "", false)
 }
 
-func (i *ItemController) GetAllByCategory(category string) ([]entities.Item, error) {
-	return i.UseCase.GetAll(category)
+func (i *ItemController) GetAllByCategory(store entities.Store, category string, includeOffSale bool) ([]entities.Item, error) {
+	return i.UseCase.GetAll(store, category, includeOffSale)
 }
 
-func (i *ItemController) Create(itemDto dto.ItemDto) (*entities.Item, error) {
-	return i.UseCase.Create(itemDto)
+func (i *ItemController) Create(store entities.Store, itemDto dto.ItemDto) (*entities.Item, error) {
+	return i.UseCase.Create(store, itemDto)
 }
 
-func (i *ItemController) Update(itemId int, itemDto dto.ItemDto) (*entities.Item, error) {
-	return i.UseCase.Update(uint32(itemId), itemDto)
+func (i *ItemController) Update(store entities.Store, itemId int, itemDto dto.ItemDto) (*entities.Item, error) {
+	return i.UseCase.Update(store, uint32(itemId), itemDto)
+}
+
+func (i *ItemController) SetAvailability(store entities.Store, itemId int, availabilityDto dto.ItemAvailabilityDto) (*entities.Item, error) {
+	return i.UseCase.SetAvailability(store, uint32(itemId), availabilityDto)
 }
 
 func (i *ItemController) Delete(itemId int) error {
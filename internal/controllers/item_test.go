@@ -32,9 +32,9 @@ func (suite *ItemControllerSuite) TestGetAll() {
 		{ID: 1, Name: "Burger", Category: "LANCHE"},
 	}
 
-	suite.useCase.EXPECT().GetAll(gomock.Any()).Return(expectedItems, nil)
+	suite.useCase.EXPECT().GetAll(matriz, "", false).Return(expectedItems, nil)
 
-	items, err := suite.controller.GetAll()
+	items, err := suite.controller.GetAll(matriz)
 	assert.NoError(suite.T(), err)
 	assert.Equal(suite.T(), expectedItems, items)
 }
@@ -44,35 +44,47 @@ func (suite *ItemControllerSuite) TestGetAllByCategory() {
 		{ID: 1, Name: "Burger", Category: "LANCHE"},
 	}
 
-	suite.useCase.EXPECT().GetAll(gomock.Any()).Return(expectedItems, nil)
+	suite.useCase.EXPECT().GetAll(matriz, "LANCHE", true).Return(expectedItems, nil)
 
-	items, err := suite.controller.GetAllByCategory("LANCHE")
+	items, err := suite.controller.GetAllByCategory(matriz, "LANCHE", true)
 	assert.NoError(suite.T(), err)
 	assert.Equal(suite.T(), expectedItems, items)
 }
 
 func (suite *ItemControllerSuite) TestCreate() {
 	itemDto := dto.ItemDto{Name: "Burger", Category: "LANCHE", Price: 10.0, ImageUrl: "http://image.com"}
-	newItem := &entities.Item{ID: 1, Name: "Burger", Category: "LANCHE", Price: 10.0, ImageUrl: "http://image.com"}
+	newItem := &entities.Item{ID: 1, Name: "Burger", Category: "LANCHE", Price: 1000, ImageUrl: "http://image.com"}
 
-	suite.useCase.EXPECT().Create(gomock.Any()).Return(newItem, nil)
+	suite.useCase.EXPECT().Create(matriz, gomock.Any()).Return(newItem, nil)
 
-	createdItem, err := suite.controller.Create(itemDto)
+	createdItem, err := suite.controller.Create(matriz, itemDto)
 	assert.NoError(suite.T(), err)
 	assert.Equal(suite.T(), newItem, createdItem)
 }
 
 func (suite *ItemControllerSuite) TestUpdate() {
 	itemDto := dto.ItemDto{Name: "Burger", Category: "LANCHE", Price: 10.0, ImageUrl: "http://image.com"}
-	itemAfterUpdate := &entities.Item{ID: 1, Name: "Burger", Category: "LANCHE", Price: 10.0, ImageUrl: "http://image.com"}
+	itemAfterUpdate := &entities.Item{ID: 1, Name: "Burger", Category: "LANCHE", Price: 1000, ImageUrl: "http://image.com"}
 
-	suite.useCase.EXPECT().Update(uint32(1), gomock.Any()).Return(itemAfterUpdate, nil)
+	suite.useCase.EXPECT().Update(matriz, uint32(1), gomock.Any()).Return(itemAfterUpdate, nil)
 
-	updatedItem, err := suite.controller.Update(1, itemDto)
+	updatedItem, err := suite.controller.Update(matriz, 1, itemDto)
 	assert.NoError(suite.T(), err)
 	assert.Equal(suite.T(), itemAfterUpdate, updatedItem)
 }
 
+func (suite *ItemControllerSuite) TestSetAvailability() {
+	available := false
+	availabilityDto := dto.ItemAvailabilityDto{Available: &available}
+	expectedItem := &entities.Item{ID: 1, Name: "Burger", Paused: true}
+
+	suite.useCase.EXPECT().SetAvailability(matriz, uint32(1), availabilityDto).Return(expectedItem, nil)
+
+	item, err := suite.controller.SetAvailability(matriz, 1, availabilityDto)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedItem, item)
+}
+
 func (suite *ItemControllerSuite) TestDelete() {
 	suite.useCase.EXPECT().Delete(uint32(1)).Return(nil)
 
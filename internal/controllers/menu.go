@@ -0,0 +1,37 @@
+package controllers
+
+import (
+	"github.com/8soat-grupo35/fastfood-order/internal/adapters/dto"
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+	"github.com/8soat-grupo35/fastfood-order/internal/gateways"
+	controllersInterface "github.com/8soat-grupo35/fastfood-order/internal/interfaces/controllers"
+	"github.com/8soat-grupo35/fastfood-order/internal/interfaces/usecase"
+	"github.com/8soat-grupo35/fastfood-order/internal/usecases"
+	"gorm.io/gorm"
+)
+
+type MenuController struct {
+	UseCase usecase.MenuUseCase
+}
+
+func NewMenuController(db *gorm.DB) controllersInterface.MenuController {
+	return &MenuController{
+		UseCase: usecases.NewMenuUseCase(gateways.NewMenuGateway(db), gateways.NewItemGateway(db)),
+	}
+}
+
+func (c *MenuController) GetAll(store entities.Store) ([]entities.Menu, error) {
+	return c.UseCase.GetAll(store)
+}
+
+func (c *MenuController) Create(store entities.Store, menuDto dto.MenuDto) (*entities.Menu, error) {
+	return c.UseCase.Create(store, menuDto)
+}
+
+func (c *MenuController) Update(store entities.Store, menuId int, menuDto dto.MenuDto) (*entities.Menu, error) {
+	return c.UseCase.Update(store, uint32(menuId), menuDto)
+}
+
+func (c *MenuController) Delete(store entities.Store, menuId int) error {
+	return c.UseCase.Delete(store, uint32(menuId))
+}
@@ -0,0 +1,71 @@
+package controllers
+
+import (
+	"github.com/8soat-grupo35/fastfood-order/internal/adapters/dto"
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+	mockUsecase "github.com/8soat-grupo35/fastfood-order/internal/interfaces/usecase/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+	"testing"
+)
+
+type MenuControllerSuite struct {
+	suite.Suite
+	ctrl       *gomock.Controller
+	useCase    *mockUsecase.MockMenuUseCase
+	controller *MenuController
+}
+
+func (suite *MenuControllerSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.useCase = mockUsecase.NewMockMenuUseCase(suite.ctrl)
+	suite.controller = &MenuController{UseCase: suite.useCase}
+}
+
+func (suite *MenuControllerSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func (suite *MenuControllerSuite) TestGetAll() {
+	expectedMenus := []entities.Menu{{ID: 3, Name: "Happy hour"}}
+
+	suite.useCase.EXPECT().GetAll(matriz).Return(expectedMenus, nil)
+
+	menus, err := suite.controller.GetAll(matriz)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedMenus, menus)
+}
+
+func (suite *MenuControllerSuite) TestCreate() {
+	menuDto := dto.MenuDto{Name: "Happy hour"}
+	expectedMenu := &entities.Menu{ID: 3, Name: "Happy hour"}
+
+	suite.useCase.EXPECT().Create(matriz, menuDto).Return(expectedMenu, nil)
+
+	menu, err := suite.controller.Create(matriz, menuDto)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedMenu, menu)
+}
+
+func (suite *MenuControllerSuite) TestUpdate() {
+	menuDto := dto.MenuDto{Name: "Happy hour"}
+	expectedMenu := &entities.Menu{ID: 3, Name: "Happy hour"}
+
+	suite.useCase.EXPECT().Update(matriz, uint32(3), menuDto).Return(expectedMenu, nil)
+
+	menu, err := suite.controller.Update(matriz, 3, menuDto)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedMenu, menu)
+}
+
+func (suite *MenuControllerSuite) TestDelete() {
+	suite.useCase.EXPECT().Delete(matriz, uint32(3)).Return(nil)
+
+	err := suite.controller.Delete(matriz, 3)
+	assert.NoError(suite.T(), err)
+}
+
+func TestMenuControllerSuite(t *testing.T) {
+	suite.Run(t, new(MenuControllerSuite))
+}
@@ -1,12 +1,10 @@
 package controllers
 
 import (
-	"fmt"
 	"github.com/8soat-grupo35/fastfood-order/internal/adapters/dto"
 	"github.com/8soat-grupo35/fastfood-order/internal/entities"
 	"github.com/8soat-grupo35/fastfood-order/internal/gateways"
 	controllersInterface "github.com/8soat-grupo35/fastfood-order/internal/interfaces/controllers"
-	"github.com/8soat-grupo35/fastfood-order/internal/interfaces/http"
 	"github.com/8soat-grupo35/fastfood-order/internal/interfaces/usecase"
 	"github.com/8soat-grupo35/fastfood-order/internal/presenters"
 	"github.com/8soat-grupo35/fastfood-order/internal/usecases"
@@ -15,41 +13,123 @@ import (
 )
 
 type OrderController struct {
-	UseCase             usecase.OrderUseCase
-	OrderPaymentUseCase usecase.OrderPaymentUseCase
+	UseCase usecase.OrderUseCase
 }
 
-func NewOrderController(db *gorm.DB, httpClient http.Client) controllersInterface.OrderController {
+func NewOrderController(db *gorm.DB) controllersInterface.OrderController {
 	orderGateway := gateways.NewOrderGateway(db)
-	orderPaymentGateway := gateways.NewOrderPaymentGateway(httpClient)
+	itemGateway := gateways.NewItemGateway(db)
+	comboGateway := gateways.NewComboGateway(db)
+	promotionGateway := gateways.NewPromotionGateway(db)
+	customerGateway := gateways.NewCustomerGateway(db)
+	orderEventGateway := gateways.NewOrderEventGateway(db)
+	storeGateway := gateways.NewStoreGateway(db)
 	return &OrderController{
-		UseCase:             usecases.NewOrderUseCase(orderGateway),
-		OrderPaymentUseCase: usecases.NewOrderPaymentUseCase(orderPaymentGateway),
+		UseCase: usecases.NewOrderUseCase(orderGateway, itemGateway, comboGateway, promotionGateway, customerGateway, orderEventGateway, storeGateway),
 	}
 }
 
-func (o *OrderController) GetAll() ([]entities.Order, error) {
-	return o.UseCase.GetAll()
+func (o *OrderController) GetAll(store entities.Store) ([]entities.Order, error) {
+	return o.UseCase.GetAll(store)
 }
 
-func (o *OrderController) Checkout(orderDto dto.OrderDto) (*presenters.OrderPresenter, error) {
-	order, err := o.UseCase.Create(orderDto)
+func (o *OrderController) GetByCustomer(store entities.Store, customerId uint32) ([]entities.Order, error) {
+	return o.UseCase.GetByCustomer(store, customerId)
+}
+
+func (o *OrderController) Search(store entities.Store, filterDto dto.OrderFilterDto) (*entities.OrderPage, error) {
+	return o.UseCase.Search(store, filterDto)
+}
+
+func (o *OrderController) Track(store entities.Store, trackingCode string) (*presenters.OrderTrackingPresenter, error) {
+	tracking, err := o.UseCase.Track(store, trackingCode)
 
 	if err != nil {
 		return nil, err
 	}
 
-	err = o.OrderPaymentUseCase.Create(*order)
+	return &presenters.OrderTrackingPresenter{
+		TrackingCode:     tracking.TrackingCode,
+		PickupCode:       tracking.PickupCode,
+		Status:           tracking.Status,
+		PaymentStatus:    tracking.PaymentStatus,
+		CustomerName:     tracking.CustomerName,
+		OrdersAhead:      tracking.OrdersAhead,
+		EstimatedReadyAt: tracking.EstimatedReadyAt,
+	}, nil
+}
+
+func (o *OrderController) Checkout(store entities.Store, orderDto dto.OrderDto) (*presenters.OrderPresenter, error) {
+	order, err := o.UseCase.Create(store, orderDto)
+
+	if err != nil {
+		return nil, err
+	}
+
+	orderPresenter := newOrderPresenter(*order)
+
+	return &orderPresenter, nil
+}
+
+func newOrderPresenter(order entities.Order) presenters.OrderPresenter {
+	orderPresenter := presenters.OrderPresenter{
+		Id:           order.ID,
+		TrackingCode: order.TrackingCode,
+		PickupCode:   order.PickupCode,
+		CustomerName: order.CustomerName,
+		Total:        order.Total,
+	}
+
+	for _, discount := range order.Discounts {
+		orderPresenter.Discounts = append(orderPresenter.Discounts, presenters.OrderDiscountPresenter{
+			PromotionName: discount.PromotionName,
+			Code:          discount.Code,
+			Amount:        discount.Amount,
+		})
+	}
+
+	return orderPresenter
+}
+
+func (o *OrderController) Reorder(store entities.Store, id uint32) (*presenters.ReorderPresenter, error) {
+	reorder, err := o.UseCase.Reorder(store, id)
+
 	if err != nil {
-		fmt.Println("Error creating order payment")
-		//return nil, err
+		return nil, err
 	}
 
-	return &presenters.OrderPresenter{Id: order.ID}, nil
+	droppedItems := []presenters.DroppedOrderItemPresenter{}
+	for _, droppedItem := range reorder.DroppedItems {
+		droppedItems = append(droppedItems, presenters.DroppedOrderItemPresenter{
+			Id:           droppedItem.ItemID,
+			ItemName:     droppedItem.ItemName,
+			VariantLabel: droppedItem.VariantLabel,
+			ComboName:    droppedItem.ComboName,
+			Quantity:     droppedItem.Quantity,
+			Reason:       droppedItem.Reason,
+		})
+	}
+
+	return &presenters.ReorderPresenter{
+		OrderPresenter: newOrderPresenter(reorder.Order),
+		DroppedItems:   droppedItems,
+	}, nil
+}
+
+func (o *OrderController) Timeline(store entities.Store, id uint32) ([]entities.OrderStatusHistory, error) {
+	return o.UseCase.Timeline(store, id)
+}
+
+func (o *OrderController) Cancel(store entities.Store, id uint32, cancelDto dto.OrderCancelDto) (*entities.Order, error) {
+	return o.UseCase.Cancel(store, id, cancelDto)
+}
+
+func (o *OrderController) UpdatePaymentStatus(id uint32, paymentStatus string) (*entities.Order, error) {
+	return o.UseCase.UpdatePaymentStatus(id, paymentStatus)
 }
 
-func (o *OrderController) UpdateStatus(id uint32, status string) (*entities.Order, error) {
-	order, err := o.UseCase.UpdateStatus(id, status)
+func (o *OrderController) UpdateStatus(store entities.Store, id uint32, statusDto dto.OrderStatusDto) (*entities.Order, error) {
+	order, err := o.UseCase.UpdateStatus(store, id, statusDto)
 
 	if err != nil {
 		return nil, err
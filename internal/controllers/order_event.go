@@ -0,0 +1,27 @@
+package controllers
+
+import (
+	"context"
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+	"github.com/8soat-grupo35/fastfood-order/internal/gateways"
+	controllersInterface "github.com/8soat-grupo35/fastfood-order/internal/interfaces/controllers"
+	"github.com/8soat-grupo35/fastfood-order/internal/interfaces/usecase"
+	"github.com/8soat-grupo35/fastfood-order/internal/usecases"
+
+	"gorm.io/gorm"
+)
+
+type OrderEventController struct {
+	UseCase usecase.OrderEventUseCase
+}
+
+func NewOrderEventController(db *gorm.DB) controllersInterface.OrderEventController {
+	orderEventGateway := gateways.NewOrderEventGateway(db)
+	return &OrderEventController{
+		UseCase: usecases.NewOrderEventUseCase(orderEventGateway),
+	}
+}
+
+func (o *OrderEventController) Listen(ctx context.Context, handle func(entities.OrderEvent)) error {
+	return o.UseCase.Listen(ctx, handle)
+}
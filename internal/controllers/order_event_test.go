@@ -0,0 +1,47 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+	mockUsecase "github.com/8soat-grupo35/fastfood-order/internal/interfaces/usecase/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+	"testing"
+)
+
+type OrderEventControllerSuite struct {
+	suite.Suite
+	ctrl       *gomock.Controller
+	useCase    *mockUsecase.MockOrderEventUseCase
+	controller *OrderEventController
+}
+
+func (suite *OrderEventControllerSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.useCase = mockUsecase.NewMockOrderEventUseCase(suite.ctrl)
+	suite.controller = &OrderEventController{UseCase: suite.useCase}
+}
+
+func (suite *OrderEventControllerSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func (suite *OrderEventControllerSuite) TestListen() {
+	suite.useCase.EXPECT().Listen(gomock.Any(), gomock.Any()).Return(nil)
+
+	err := suite.controller.Listen(context.Background(), func(entities.OrderEvent) {})
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *OrderEventControllerSuite) TestListenReturnsUseCaseError() {
+	suite.useCase.EXPECT().Listen(gomock.Any(), gomock.Any()).Return(errors.New("listen error"))
+
+	err := suite.controller.Listen(context.Background(), func(entities.OrderEvent) {})
+	assert.Error(suite.T(), err)
+}
+
+func TestOrderEventControllerSuite(t *testing.T) {
+	suite.Run(t, new(OrderEventControllerSuite))
+}
@@ -1,6 +1,7 @@
 package controllers
 
 import (
+	"errors"
 	"github.com/8soat-grupo35/fastfood-order/internal/adapters/dto"
 	"github.com/8soat-grupo35/fastfood-order/internal/entities"
 	mockUsecase "github.com/8soat-grupo35/fastfood-order/internal/interfaces/usecase/mock"
@@ -11,19 +12,19 @@ import (
 	"testing"
 )
 
+var registeredCustomerID = uint32(1)
+
 type OrderControllerSuite struct {
 	suite.Suite
-	ctrl                *gomock.Controller
-	useCase             *mockUsecase.MockOrderUseCase
-	orderPaymentUseCase *mockUsecase.MockOrderPaymentUseCase
-	controller          *OrderController
+	ctrl       *gomock.Controller
+	useCase    *mockUsecase.MockOrderUseCase
+	controller *OrderController
 }
 
 func (suite *OrderControllerSuite) SetupTest() {
 	suite.ctrl = gomock.NewController(suite.T())
 	suite.useCase = mockUsecase.NewMockOrderUseCase(suite.ctrl)
-	suite.orderPaymentUseCase = mockUsecase.NewMockOrderPaymentUseCase(suite.ctrl)
-	suite.controller = &OrderController{UseCase: suite.useCase, OrderPaymentUseCase: suite.orderPaymentUseCase}
+	suite.controller = &OrderController{UseCase: suite.useCase}
 }
 
 func (suite *OrderControllerSuite) TearDownTest() {
@@ -35,45 +36,167 @@ func (suite *OrderControllerSuite) TestGetAll() {
 		{ID: 1, Status: "Pending"},
 	}
 
-	suite.useCase.EXPECT().GetAll().Return(expectedOrders, nil)
+	suite.useCase.EXPECT().GetAll(matriz).Return(expectedOrders, nil)
 
-	orders, err := suite.controller.GetAll()
+	orders, err := suite.controller.GetAll(matriz)
 	assert.NoError(suite.T(), err)
 	assert.Equal(suite.T(), expectedOrders, orders)
 }
 
+func (suite *OrderControllerSuite) TestSearch() {
+	filterDto := dto.OrderFilterDto{Status: []string{entities.FINISHED_STATUS}}
+	expectedPage := &entities.OrderPage{Orders: []entities.Order{{ID: 1, Status: entities.FINISHED_STATUS}}}
+
+	suite.useCase.EXPECT().Search(matriz, filterDto).Return(expectedPage, nil)
+
+	page, err := suite.controller.Search(matriz, filterDto)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedPage, page)
+}
+
 func (suite *OrderControllerSuite) TestCheckout() {
 	itemsDto := []dto.OrderItemDto{
 		{Id: 1, Quantity: 2},
 	}
-	orderDto := dto.OrderDto{Status: "Pending", CustomerID: 1, Items: itemsDto}
-	newOrder := &entities.Order{ID: 1, Status: "Pending"}
+	orderDto := dto.OrderDto{Status: "Pending", CustomerID: &registeredCustomerID, Items: itemsDto}
+	newOrder := &entities.Order{ID: 1, Status: "Pending", TrackingCode: "ABCD2345", PickupCode: "A-042"}
 	orderCreated := &presenters.OrderPresenter{
-		Id: 1,
+		Id:           1,
+		TrackingCode: "ABCD2345",
+		PickupCode:   "A-042",
 	}
 
-	suite.useCase.EXPECT().Create(gomock.Any()).Return(newOrder, nil)
-	suite.orderPaymentUseCase.EXPECT().Create(*newOrder).Return(nil)
+	suite.useCase.EXPECT().Create(matriz, gomock.Any()).Return(newOrder, nil)
 
-	createdOrder, err := suite.controller.Checkout(orderDto)
+	createdOrder, err := suite.controller.Checkout(matriz, orderDto)
 	assert.NoError(suite.T(), err)
 	assert.Equal(suite.T(), orderCreated, createdOrder)
 }
 
+func (suite *OrderControllerSuite) TestCheckoutShowsDiscounts() {
+	orderDto := dto.OrderDto{CustomerID: &registeredCustomerID, Coupon: "BEMVINDO", Items: []dto.OrderItemDto{{Id: 1, Quantity: 2}}}
+	newOrder := &entities.Order{ID: 1, PickupCode: "A-042", Subtotal: 5600, Discount: 1000, Total: 4600, Discounts: []entities.OrderDiscount{
+		{ID: 1, OrderID: 1, PromotionID: 4, PromotionName: "Bem-vindo", Code: "BEMVINDO", Amount: 1000},
+	}}
+
+	suite.useCase.EXPECT().Create(matriz, orderDto).Return(newOrder, nil)
+
+	createdOrder, err := suite.controller.Checkout(matriz, orderDto)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), entities.Money(4600), createdOrder.Total)
+	assert.Equal(suite.T(), []presenters.OrderDiscountPresenter{
+		{PromotionName: "Bem-vindo", Code: "BEMVINDO", Amount: 1000},
+	}, createdOrder.Discounts)
+}
+
+func (suite *OrderControllerSuite) TestGetByCustomer() {
+	expectedOrders := []entities.Order{{ID: 1, CustomerID: &registeredCustomerID}}
+
+	suite.useCase.EXPECT().GetByCustomer(matriz, uint32(1)).Return(expectedOrders, nil)
+
+	orders, err := suite.controller.GetByCustomer(matriz, 1)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedOrders, orders)
+}
+
+func (suite *OrderControllerSuite) TestReorder() {
+	suite.useCase.EXPECT().Reorder(matriz, uint32(1)).Return(&entities.Reorder{
+		Order: entities.Order{ID: 5, TrackingCode: "ABCD2345", PickupCode: "A-042"},
+		DroppedItems: []entities.DroppedOrderItem{
+			{ItemID: 2, ItemName: "Milkshake", Quantity: 1, Reason: entities.ITEM_UNAVAILABLE_REASON},
+		},
+	}, nil)
+
+	reorder, err := suite.controller.Reorder(matriz, 1)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), &presenters.ReorderPresenter{
+		OrderPresenter: presenters.OrderPresenter{Id: 5, TrackingCode: "ABCD2345", PickupCode: "A-042"},
+		DroppedItems: []presenters.DroppedOrderItemPresenter{
+			{Id: 2, ItemName: "Milkshake", Quantity: 1, Reason: entities.ITEM_UNAVAILABLE_REASON},
+		},
+	}, reorder)
+}
+
+func (suite *OrderControllerSuite) TestReorderReturnsError() {
+	suite.useCase.EXPECT().Reorder(matriz, uint32(1)).Return(nil, errors.New("reorder error"))
+
+	reorder, err := suite.controller.Reorder(matriz, 1)
+	assert.Error(suite.T(), err)
+	assert.Nil(suite.T(), reorder)
+}
+
 func (suite *OrderControllerSuite) TestUpdateStatus() {
 	items := []entities.OrderItem{
 		{ID: 1, Quantity: 2},
 	}
 
-	orderAfterUpdate := &entities.Order{ID: 1, Status: entities.DONE_STATUS, CustomerID: 1, Items: items}
+	orderAfterUpdate := &entities.Order{ID: 1, Status: entities.DONE_STATUS, CustomerID: &registeredCustomerID, Items: items}
+
+	statusDto := dto.OrderStatusDto{Status: entities.DONE_STATUS, ChangedBy: "cozinha"}
 
-	suite.useCase.EXPECT().UpdateStatus(uint32(1), gomock.Any()).Return(orderAfterUpdate, nil)
+	suite.useCase.EXPECT().UpdateStatus(matriz, uint32(1), statusDto).Return(orderAfterUpdate, nil)
 
-	updatedOrder, err := suite.controller.UpdateStatus(1, entities.DONE_STATUS)
+	updatedOrder, err := suite.controller.UpdateStatus(matriz, 1, statusDto)
 	assert.NoError(suite.T(), err)
 	assert.Equal(suite.T(), orderAfterUpdate, updatedOrder)
 }
 
+func (suite *OrderControllerSuite) TestCancel() {
+	cancelDto := dto.OrderCancelDto{CanceledBy: "atendente", Reason: "cliente desistiu"}
+	canceledOrder := &entities.Order{ID: 1, Status: entities.CANCELED_STATUS, CustomerID: &registeredCustomerID}
+
+	suite.useCase.EXPECT().Cancel(matriz, uint32(1), cancelDto).Return(canceledOrder, nil)
+
+	order, err := suite.controller.Cancel(matriz, 1, cancelDto)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), canceledOrder, order)
+}
+
+func (suite *OrderControllerSuite) TestCancelReturnsErrorWhenCancelFails() {
+	cancelDto := dto.OrderCancelDto{CanceledBy: "atendente", Reason: "cliente desistiu"}
+
+	suite.useCase.EXPECT().Cancel(matriz, uint32(1), cancelDto).Return(nil, errors.New("cancel error"))
+
+	order, err := suite.controller.Cancel(matriz, 1, cancelDto)
+	assert.Error(suite.T(), err)
+	assert.Nil(suite.T(), order)
+}
+
+func (suite *OrderControllerSuite) TestUpdatePaymentStatus() {
+	paidOrder := &entities.Order{ID: 1, Status: entities.RECEIVED_STATUS, PaymentStatus: entities.PAYMENT_APPROVED_STATUS}
+
+	suite.useCase.EXPECT().UpdatePaymentStatus(uint32(1), entities.PAYMENT_APPROVED_STATUS).Return(paidOrder, nil)
+
+	order, err := suite.controller.UpdatePaymentStatus(1, entities.PAYMENT_APPROVED_STATUS)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), paidOrder, order)
+}
+
+func (suite *OrderControllerSuite) TestTrack() {
+	ordersAhead := 2
+	tracking := &entities.OrderTracking{TrackingCode: "ABCD2345", Status: entities.RECEIVED_STATUS, OrdersAhead: &ordersAhead}
+
+	suite.useCase.EXPECT().Track(matriz, "ABCD2345").Return(tracking, nil)
+
+	presenter, err := suite.controller.Track(matriz, "ABCD2345")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "ABCD2345", presenter.TrackingCode)
+	assert.Equal(suite.T(), entities.RECEIVED_STATUS, presenter.Status)
+	assert.Equal(suite.T(), 2, *presenter.OrdersAhead)
+}
+
+func (suite *OrderControllerSuite) TestTimeline() {
+	timeline := []entities.OrderStatusHistory{
+		{ID: 1, OrderID: 1, Status: entities.RECEIVED_STATUS, ChangedBy: entities.SYSTEM_ACTOR},
+	}
+
+	suite.useCase.EXPECT().Timeline(matriz, uint32(1)).Return(timeline, nil)
+
+	history, err := suite.controller.Timeline(matriz, 1)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), timeline, history)
+}
+
 func TestOrderControllerSuite(t *testing.T) {
 	suite.Run(t, new(OrderControllerSuite))
 }
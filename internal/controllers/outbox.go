@@ -0,0 +1,27 @@
+package controllers
+
+import (
+	"github.com/8soat-grupo35/fastfood-order/internal/gateways"
+	controllersInterface "github.com/8soat-grupo35/fastfood-order/internal/interfaces/controllers"
+	"github.com/8soat-grupo35/fastfood-order/internal/interfaces/http"
+	"github.com/8soat-grupo35/fastfood-order/internal/interfaces/usecase"
+	"github.com/8soat-grupo35/fastfood-order/internal/usecases"
+
+	"gorm.io/gorm"
+)
+
+type OutboxController struct {
+	UseCase usecase.OutboxUseCase
+}
+
+func NewOutboxController(db *gorm.DB, httpClient http.Client, batchSize int) controllersInterface.OutboxController {
+	outboxGateway := gateways.NewOutboxGateway(db)
+	orderPaymentGateway := gateways.NewOrderPaymentGateway(httpClient)
+	return &OutboxController{
+		UseCase: usecases.NewOutboxUseCase(outboxGateway, orderPaymentGateway, batchSize),
+	}
+}
+
+func (o *OutboxController) Dispatch() error {
+	return o.UseCase.Dispatch()
+}
@@ -0,0 +1,45 @@
+package controllers
+
+import (
+	"errors"
+	mockUsecase "github.com/8soat-grupo35/fastfood-order/internal/interfaces/usecase/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+	"testing"
+)
+
+type OutboxControllerSuite struct {
+	suite.Suite
+	ctrl       *gomock.Controller
+	useCase    *mockUsecase.MockOutboxUseCase
+	controller *OutboxController
+}
+
+func (suite *OutboxControllerSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.useCase = mockUsecase.NewMockOutboxUseCase(suite.ctrl)
+	suite.controller = &OutboxController{UseCase: suite.useCase}
+}
+
+func (suite *OutboxControllerSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func (suite *OutboxControllerSuite) TestDispatch() {
+	suite.useCase.EXPECT().Dispatch().Return(nil)
+
+	err := suite.controller.Dispatch()
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *OutboxControllerSuite) TestDispatchReturnsUseCaseError() {
+	suite.useCase.EXPECT().Dispatch().Return(errors.New("dispatch error"))
+
+	err := suite.controller.Dispatch()
+	assert.Error(suite.T(), err)
+}
+
+func TestOutboxControllerSuite(t *testing.T) {
+	suite.Run(t, new(OutboxControllerSuite))
+}
@@ -0,0 +1,37 @@
+package controllers
+
+import (
+	"github.com/8soat-grupo35/fastfood-order/internal/adapters/dto"
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+	"github.com/8soat-grupo35/fastfood-order/internal/gateways"
+	controllersInterface "github.com/8soat-grupo35/fastfood-order/internal/interfaces/controllers"
+	"github.com/8soat-grupo35/fastfood-order/internal/interfaces/usecase"
+	"github.com/8soat-grupo35/fastfood-order/internal/usecases"
+	"gorm.io/gorm"
+)
+
+type PromotionController struct {
+	UseCase usecase.PromotionUseCase
+}
+
+func NewPromotionController(db *gorm.DB) controllersInterface.PromotionController {
+	return &PromotionController{
+		UseCase: usecases.NewPromotionUseCase(gateways.NewPromotionGateway(db), gateways.NewCategoryGateway(db)),
+	}
+}
+
+func (p *PromotionController) GetAll(store entities.Store) ([]entities.Promotion, error) {
+	return p.UseCase.GetAll(store)
+}
+
+func (p *PromotionController) Create(store entities.Store, promotionDto dto.PromotionDto) (*entities.Promotion, error) {
+	return p.UseCase.Create(store, promotionDto)
+}
+
+func (p *PromotionController) Update(store entities.Store, promotionId int, promotionDto dto.PromotionDto) (*entities.Promotion, error) {
+	return p.UseCase.Update(store, uint32(promotionId), promotionDto)
+}
+
+func (p *PromotionController) Delete(store entities.Store, promotionId int) error {
+	return p.UseCase.Delete(store, uint32(promotionId))
+}
@@ -0,0 +1,71 @@
+package controllers
+
+import (
+	"github.com/8soat-grupo35/fastfood-order/internal/adapters/dto"
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+	mockUsecase "github.com/8soat-grupo35/fastfood-order/internal/interfaces/usecase/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+	"testing"
+)
+
+type PromotionControllerSuite struct {
+	suite.Suite
+	ctrl       *gomock.Controller
+	useCase    *mockUsecase.MockPromotionUseCase
+	controller *PromotionController
+}
+
+func (suite *PromotionControllerSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.useCase = mockUsecase.NewMockPromotionUseCase(suite.ctrl)
+	suite.controller = &PromotionController{UseCase: suite.useCase}
+}
+
+func (suite *PromotionControllerSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func (suite *PromotionControllerSuite) TestGetAll() {
+	expectedPromotions := []entities.Promotion{{ID: 4, Name: "Bem-vindo"}}
+
+	suite.useCase.EXPECT().GetAll(matriz).Return(expectedPromotions, nil)
+
+	promotions, err := suite.controller.GetAll(matriz)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedPromotions, promotions)
+}
+
+func (suite *PromotionControllerSuite) TestCreate() {
+	promotionDto := dto.PromotionDto{Name: "Bem-vindo", Code: "BEMVINDO"}
+	expectedPromotion := &entities.Promotion{ID: 4, Name: "Bem-vindo", Code: "BEMVINDO"}
+
+	suite.useCase.EXPECT().Create(matriz, promotionDto).Return(expectedPromotion, nil)
+
+	promotion, err := suite.controller.Create(matriz, promotionDto)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedPromotion, promotion)
+}
+
+func (suite *PromotionControllerSuite) TestUpdate() {
+	promotionDto := dto.PromotionDto{Name: "Bem-vindo", Code: "BEMVINDO"}
+	expectedPromotion := &entities.Promotion{ID: 4, Name: "Bem-vindo", Code: "BEMVINDO"}
+
+	suite.useCase.EXPECT().Update(matriz, uint32(4), promotionDto).Return(expectedPromotion, nil)
+
+	promotion, err := suite.controller.Update(matriz, 4, promotionDto)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedPromotion, promotion)
+}
+
+func (suite *PromotionControllerSuite) TestDelete() {
+	suite.useCase.EXPECT().Delete(matriz, uint32(4)).Return(nil)
+
+	err := suite.controller.Delete(matriz, 4)
+	assert.NoError(suite.T(), err)
+}
+
+func TestPromotionControllerSuite(t *testing.T) {
+	suite.Run(t, new(PromotionControllerSuite))
+}
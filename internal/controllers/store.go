@@ -0,0 +1,66 @@
+package controllers
+
+import (
+	"github.com/8soat-grupo35/fastfood-order/internal/adapters/dto"
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+	"github.com/8soat-grupo35/fastfood-order/internal/gateways"
+	controllersInterface "github.com/8soat-grupo35/fastfood-order/internal/interfaces/controllers"
+	"github.com/8soat-grupo35/fastfood-order/internal/interfaces/usecase"
+	"github.com/8soat-grupo35/fastfood-order/internal/usecases"
+
+	"gorm.io/gorm"
+)
+
+type StoreController struct {
+	UseCase usecase.StoreUseCase
+}
+
+func NewStoreController(db *gorm.DB) controllersInterface.StoreController {
+	return &StoreController{
+		UseCase: usecases.NewStoreUseCase(gateways.NewStoreGateway(db)),
+	}
+}
+
+func (s *StoreController) GetAll() ([]entities.Store, error) {
+	return s.UseCase.GetAll()
+}
+
+func (s *StoreController) Resolve(storeId int) (*entities.Store, error) {
+	return s.UseCase.Resolve(uint32(storeId))
+}
+
+func (s *StoreController) Create(storeDto dto.StoreDto) (*entities.Store, error) {
+	return s.UseCase.Create(storeDto)
+}
+
+func (s *StoreController) Update(storeId int, storeDto dto.StoreDto) (*entities.Store, error) {
+	return s.UseCase.Update(uint32(storeId), storeDto)
+}
+
+func (s *StoreController) GetStatus(store entities.Store) (*entities.StoreStatus, error) {
+	return s.UseCase.GetStatus(store)
+}
+
+func (s *StoreController) GetHours(store entities.Store) ([]entities.StoreHours, error) {
+	return s.UseCase.GetHours(store)
+}
+
+func (s *StoreController) UpdateHours(store entities.Store, hoursDto dto.StoreHoursDto) ([]entities.StoreHours, error) {
+	return s.UseCase.UpdateHours(store, hoursDto)
+}
+
+func (s *StoreController) GetCalendar(store entities.Store) ([]entities.StoreCalendarDay, error) {
+	return s.UseCase.GetCalendar(store)
+}
+
+func (s *StoreController) CreateCalendarDay(store entities.Store, dayDto dto.StoreCalendarDayDto) (*entities.StoreCalendarDay, error) {
+	return s.UseCase.CreateCalendarDay(store, dayDto)
+}
+
+func (s *StoreController) UpdateCalendarDay(store entities.Store, dayId int, dayDto dto.StoreCalendarDayDto) (*entities.StoreCalendarDay, error) {
+	return s.UseCase.UpdateCalendarDay(store, uint32(dayId), dayDto)
+}
+
+func (s *StoreController) DeleteCalendarDay(store entities.Store, dayId int) error {
+	return s.UseCase.DeleteCalendarDay(store, uint32(dayId))
+}
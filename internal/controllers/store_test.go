@@ -0,0 +1,114 @@
+package controllers
+
+import (
+	"github.com/8soat-grupo35/fastfood-order/internal/adapters/dto"
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+	mockUsecase "github.com/8soat-grupo35/fastfood-order/internal/interfaces/usecase/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+	"testing"
+	"time"
+)
+
+var matriz = entities.Store{ID: 1, Name: "Matriz", TimeZone: "UTC", PickupCodePrefix: "A", Active: true}
+
+type StoreControllerSuite struct {
+	suite.Suite
+	ctrl       *gomock.Controller
+	useCase    *mockUsecase.MockStoreUseCase
+	controller *StoreController
+}
+
+func (suite *StoreControllerSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.useCase = mockUsecase.NewMockStoreUseCase(suite.ctrl)
+	suite.controller = &StoreController{UseCase: suite.useCase}
+}
+
+func (suite *StoreControllerSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func (suite *StoreControllerSuite) TestGetAll() {
+	expectedStores := []entities.Store{matriz}
+
+	suite.useCase.EXPECT().GetAll().Return(expectedStores, nil)
+
+	stores, err := suite.controller.GetAll()
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedStores, stores)
+}
+
+func (suite *StoreControllerSuite) TestResolve() {
+	suite.useCase.EXPECT().Resolve(uint32(1)).Return(&matriz, nil)
+
+	store, err := suite.controller.Resolve(1)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), &matriz, store)
+}
+
+func (suite *StoreControllerSuite) TestCreate() {
+	storeDto := dto.StoreDto{Name: "Centro", TimeZone: "America/Sao_Paulo", PickupCodePrefix: "C"}
+	expectedStore := &entities.Store{ID: 2, Name: "Centro", TimeZone: "America/Sao_Paulo", PickupCodePrefix: "C", Active: true}
+
+	suite.useCase.EXPECT().Create(storeDto).Return(expectedStore, nil)
+
+	store, err := suite.controller.Create(storeDto)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedStore, store)
+}
+
+func (suite *StoreControllerSuite) TestUpdate() {
+	storeDto := dto.StoreDto{Name: "Centro", TimeZone: "America/Sao_Paulo", PickupCodePrefix: "C"}
+	expectedStore := &entities.Store{ID: 2, Name: "Centro", TimeZone: "America/Sao_Paulo", PickupCodePrefix: "C", Active: true}
+
+	suite.useCase.EXPECT().Update(uint32(2), storeDto).Return(expectedStore, nil)
+
+	store, err := suite.controller.Update(2, storeDto)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedStore, store)
+}
+
+func (suite *StoreControllerSuite) TestGetStatus() {
+	expectedStatus := &entities.StoreStatus{Open: true}
+
+	suite.useCase.EXPECT().GetStatus(matriz).Return(expectedStatus, nil)
+
+	status, err := suite.controller.GetStatus(matriz)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedStatus, status)
+}
+
+func (suite *StoreControllerSuite) TestUpdateHours() {
+	hoursDto := dto.StoreHoursDto{Days: []dto.StoreDayHoursDto{{Weekday: 1, OpenTime: "10:00", CloseTime: "22:00"}}}
+	expectedHours := []entities.StoreHours{{Weekday: time.Monday, OpenTime: "10:00", CloseTime: "22:00"}}
+
+	suite.useCase.EXPECT().UpdateHours(matriz, hoursDto).Return(expectedHours, nil)
+
+	hours, err := suite.controller.UpdateHours(matriz, hoursDto)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedHours, hours)
+}
+
+func (suite *StoreControllerSuite) TestUpdateCalendarDay() {
+	dayDto := dto.StoreCalendarDayDto{Date: "2026-12-25", Closed: true}
+	expectedDay := &entities.StoreCalendarDay{ID: 2, Closed: true}
+
+	suite.useCase.EXPECT().UpdateCalendarDay(matriz, uint32(2), dayDto).Return(expectedDay, nil)
+
+	day, err := suite.controller.UpdateCalendarDay(matriz, 2, dayDto)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedDay, day)
+}
+
+func (suite *StoreControllerSuite) TestDeleteCalendarDay() {
+	suite.useCase.EXPECT().DeleteCalendarDay(matriz, uint32(2)).Return(nil)
+
+	err := suite.controller.DeleteCalendarDay(matriz, 2)
+	assert.NoError(suite.T(), err)
+}
+
+func TestStoreControllerSuite(t *testing.T) {
+	suite.Run(t, new(StoreControllerSuite))
+}
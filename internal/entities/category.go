@@ -0,0 +1,65 @@
+package entities
+
+import (
+	"errors"
+	"github.com/8soat-grupo35/fastfood-order/internal/adapters/dto"
+	"strings"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-ozzo/ozzo-validation/v4/is"
+	"gorm.io/gorm"
+)
+
+// ErrCategoryNotRegistered is returned for a category name that no registered category has.
+var ErrCategoryNotRegistered = errors.New("must be a registered category")
+
+// Category groups the items of the menu. Items, combo slots and promotions refer to it by name too, so
+// renaming the category renames it on them. Inactive categories are left out of the menu.
+type Category struct {
+	ID           uint32         `gorm:"primary_key;auto_increment" json:"id"`
+	Name         string         `gorm:"size:30;not null;" json:"name"`
+	DisplayOrder int            `gorm:"not null;default:0" json:"display_order"`
+	Active       bool           `gorm:"not null;" json:"active"`
+	ImageUrl     string         `gorm:"size:255;not null;default:''" json:"image_url,omitempty"`
+	CreatedAt    time.Time      `json:"created_at"`
+	UpdatedAt    time.Time      `json:"updated_at"`
+	DeletedAt    gorm.DeletedAt `gorm:"index" json:"-"`
+} //@name domain.Category
+
+// NewCategory normalizes the name as the items refer to it. Categories are active unless told otherwise.
+func NewCategory(categoryDto dto.CategoryDto) (*Category, error) {
+	newCategory := Category{
+		Name:         strings.ToUpper(strings.TrimSpace(categoryDto.Name)),
+		DisplayOrder: categoryDto.DisplayOrder,
+		Active:       categoryDto.Active == nil || *categoryDto.Active,
+		ImageUrl:     strings.TrimSpace(categoryDto.ImageUrl),
+	}
+
+	err := newCategory.Validate()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &newCategory, nil
+}
+
+func (category Category) Validate() error {
+	return validation.ValidateStruct(
+		&category,
+		validation.Field(
+			&category.Name,
+			validation.Required,
+			validation.RuneLength(2, 30),
+		),
+		validation.Field(
+			&category.DisplayOrder,
+			validation.Min(0),
+		),
+		validation.Field(
+			&category.ImageUrl,
+			is.URL,
+		),
+	)
+}
@@ -0,0 +1,37 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/8soat-grupo35/fastfood-order/internal/adapters/dto"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCategoryNormalizesNameAndIsActiveByDefault(t *testing.T) {
+	category, err := NewCategory(dto.CategoryDto{Name: " café da manhã ", DisplayOrder: 5})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "CAFÉ DA MANHÃ", category.Name)
+	assert.Equal(t, 5, category.DisplayOrder)
+	assert.True(t, category.Active)
+}
+
+func TestNewCategoryKeepsInactiveFlag(t *testing.T) {
+	active := false
+
+	category, err := NewCategory(dto.CategoryDto{Name: "Sobremesa", Active: &active})
+
+	assert.NoError(t, err)
+	assert.False(t, category.Active)
+}
+
+func TestNewCategoryRejectsInvalidFields(t *testing.T) {
+	_, err := NewCategory(dto.CategoryDto{Name: "X", DisplayOrder: -1, ImageUrl: "not an url"})
+
+	errs, ok := err.(validation.Errors)
+	assert.True(t, ok)
+	assert.Contains(t, errs, "name")
+	assert.Contains(t, errs, "display_order")
+	assert.Contains(t, errs, "image_url")
+}
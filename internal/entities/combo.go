@@ -0,0 +1,324 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"github.com/8soat-grupo35/fastfood-order/internal/adapters/dto"
+	"strconv"
+	"strings"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-ozzo/ozzo-validation/v4/is"
+	"gorm.io/gorm"
+)
+
+const (
+	COMBO_FIXED_PRICE    = "PRECO_FIXO"
+	COMBO_PERCENTAGE_OFF = "PERCENTUAL"
+)
+
+const (
+	COMBO_MIN_SLOTS = 2
+	COMBO_MAX_SLOTS = 5
+)
+
+const COMBO_UNAVAILABLE_REASON = "combo is no longer available"
+
+// Combo sells one item of each slot together, for a fixed price or for a percentage off the price of
+// the items chosen. Variant price deltas and modifiers are not part of the deal and are charged in full.
+type Combo struct {
+	ID            uint32         `gorm:"primary_key;auto_increment" json:"id"`
+	Name          string         `gorm:"size:255;not null;" json:"name"`
+	ImageUrl      string         `gorm:"size:255;not null;" json:"image_url"`
+	PricingRule   string         `gorm:"size:20;not null;" json:"pricing_rule"`
+	Price         Money          `gorm:"type:numeric(12,2);" json:"price"`
+	PercentageOff float32        `json:"percentage_off"`
+	Slots         []ComboSlot    `gorm:"foreignKey:ComboID;constraint:OnDelete:CASCADE" json:"slots"`
+	CreatedAt     time.Time      `json:"created_at"`
+	UpdatedAt     time.Time      `json:"updated_at"`
+	DeletedAt     gorm.DeletedAt `gorm:"index" json:"-"`
+} //@name domain.Combo
+
+// ComboSlot is a category of the combo, such as the drink, and the items of it the customer may choose.
+type ComboSlot struct {
+	ID       uint32          `gorm:"primary_key;auto_increment" json:"id"`
+	ComboID  uint32          `json:"-"`
+	Category string          `gorm:"size:30;not null;" json:"category"`
+	Items    []ComboSlotItem `gorm:"foreignKey:ComboSlotID;constraint:OnDelete:CASCADE" json:"items"`
+} //@name domain.ComboSlot
+
+type ComboSlotItem struct {
+	ID          uint32 `gorm:"primary_key;auto_increment" json:"-"`
+	ComboSlotID uint32 `json:"-"`
+	ItemID      uint32 `json:"item_id"`
+} //@name domain.ComboSlotItem
+
+func NewCombo(comboDto dto.ComboDto) (*Combo, error) {
+	newCombo := Combo{
+		Name:          strings.TrimSpace(comboDto.Name),
+		ImageUrl:      comboDto.ImageUrl,
+		PricingRule:   strings.ToUpper(strings.TrimSpace(comboDto.PricingRule)),
+		Price:         NewMoney(comboDto.Price),
+		PercentageOff: comboDto.PercentageOff,
+	}
+
+	for _, slotDto := range comboDto.Slots {
+		slot := ComboSlot{
+			Category: strings.ToUpper(strings.TrimSpace(slotDto.Category)),
+		}
+
+		for _, itemId := range slotDto.ItemIDs {
+			slot.Items = append(slot.Items, ComboSlotItem{ItemID: itemId})
+		}
+
+		newCombo.Slots = append(newCombo.Slots, slot)
+	}
+
+	err := newCombo.Validate()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &newCombo, nil
+}
+
+func (combo Combo) Validate() error {
+	return validation.ValidateStruct(
+		&combo,
+		validation.Field(
+			&combo.Name,
+			validation.Required,
+			validation.Length(3, 255),
+		),
+		validation.Field(
+			&combo.ImageUrl,
+			validation.Required,
+			is.URL,
+		),
+		validation.Field(
+			&combo.PricingRule,
+			validation.Required,
+			validation.In(COMBO_FIXED_PRICE, COMBO_PERCENTAGE_OFF).Error("must be a valid value between (preco_fixo,percentual)"),
+		),
+		validation.Field(
+			&combo.Price,
+			validation.When(combo.PricingRule == COMBO_FIXED_PRICE, requiredMoney),
+			minMoney(0),
+		),
+		validation.Field(
+			&combo.PercentageOff,
+			validation.Required.When(combo.PricingRule == COMBO_PERCENTAGE_OFF),
+			validation.Min(float32(0)),
+			validation.Max(float32(100)).Exclusive(),
+		),
+		validation.Field(
+			&combo.Slots,
+			validation.Required,
+			validation.Length(COMBO_MIN_SLOTS, COMBO_MAX_SLOTS),
+		),
+	)
+}
+
+func (slot ComboSlot) Validate() error {
+	return validation.ValidateStruct(
+		&slot,
+		validation.Field(
+			&slot.Category,
+			validation.Required,
+		),
+		validation.Field(
+			&slot.Items,
+			validation.Required,
+			validation.By(func(value interface{}) error {
+				seen := make(map[uint32]bool, len(slot.Items))
+				for _, slotItem := range slot.Items {
+					if seen[slotItem.ItemID] {
+						return errors.New("must not repeat an item")
+					}
+					seen[slotItem.ItemID] = true
+				}
+				return nil
+			}),
+		),
+	)
+}
+
+func (slot ComboSlot) Allows(itemId uint32) bool {
+	for _, slotItem := range slot.Items {
+		if slotItem.ItemID == itemId {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (combo Combo) ItemIDs() (ids []uint32) {
+	for _, slot := range combo.Slots {
+		for _, slotItem := range slot.Items {
+			ids = append(ids, slotItem.ItemID)
+		}
+	}
+
+	return ids
+}
+
+func (combo Combo) Offers(itemId uint32) bool {
+	for _, slot := range combo.Slots {
+		if slot.Allows(itemId) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ValidateItems checks every item of the slots is in the catalog and belongs to the slot category, which
+// keeps the slots in registered categories.
+// Errors are reported by the position of the slot and of the item in it.
+func (combo Combo) ValidateItems(items []Item) error {
+	catalog := make(map[uint32]Item, len(items))
+	for _, item := range items {
+		catalog[item.ID] = item
+	}
+
+	slotErrors := validation.Errors{}
+	for i, slot := range combo.Slots {
+		itemErrors := validation.Errors{}
+		for j, slotItem := range slot.Items {
+			item, found := catalog[slotItem.ItemID]
+			if !found {
+				itemErrors[strconv.Itoa(j)] = fmt.Errorf("item %d not found", slotItem.ItemID)
+			} else if item.Category != slot.Category {
+				itemErrors[strconv.Itoa(j)] = fmt.Errorf("item %d is not a %s", item.ID, strings.ToLower(slot.Category))
+			}
+		}
+
+		if len(itemErrors) > 0 {
+			slotErrors[strconv.Itoa(i)] = validation.Errors{"item_ids": itemErrors}
+		}
+	}
+
+	if len(slotErrors) > 0 {
+		return validation.Errors{"slots": slotErrors}
+	}
+
+	return nil
+}
+
+// Discount is how much one combo takes off the price of the items chosen. A fixed price above the
+// price of the items gives no discount, so the combo never costs more than its items.
+func (combo Combo) Discount(itemsPrice Money) Money {
+	switch combo.PricingRule {
+	case COMBO_FIXED_PRICE:
+		return max(itemsPrice-combo.Price, 0)
+	case COMBO_PERCENTAGE_OFF:
+		return itemsPrice.Percent(combo.PercentageOff)
+	}
+
+	return 0
+}
+
+// FillsSlots tells if the items chosen take one slot each and leave none empty. The customer does not
+// tell the slots apart, so the items are matched to the slots that allow them.
+func (combo Combo) FillsSlots(itemIds []uint32) bool {
+	if len(itemIds) != len(combo.Slots) {
+		return false
+	}
+
+	itemOfSlot := make([]int, len(combo.Slots))
+	for slot := range itemOfSlot {
+		itemOfSlot[slot] = -1
+	}
+
+	var assign func(item int, visited []bool) bool
+	assign = func(item int, visited []bool) bool {
+		for slot := range combo.Slots {
+			if visited[slot] || !combo.Slots[slot].Allows(itemIds[item]) {
+				continue
+			}
+			visited[slot] = true
+
+			if itemOfSlot[slot] < 0 || assign(itemOfSlot[slot], visited) {
+				itemOfSlot[slot] = item
+				return true
+			}
+		}
+
+		return false
+	}
+
+	for item := range itemIds {
+		if !assign(item, make([]bool, len(combo.Slots))) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Expand turns the combo chosen at checkout into priced order lines of the given combo group. The
+// discount of the combo is split among the lines in proportion to the price of their items.
+func (combo Combo) Expand(orderCombo OrderCombo, items map[uint32]Item, group uint32) ([]OrderItem, error) {
+	lines := make([]OrderItem, len(orderCombo.Items))
+	itemPrices := make([]Money, len(orderCombo.Items))
+	var itemsPrice Money
+
+	lineErrors := validation.Errors{}
+	for i, line := range orderCombo.Items {
+		if !combo.Offers(line.ItemID) {
+			lineErrors[strconv.Itoa(i)] = validation.Errors{
+				"id": fmt.Errorf("item %d is not part of combo %d", line.ItemID, combo.ID),
+			}
+			continue
+		}
+
+		item, found := items[line.ItemID]
+		if !found {
+			lineErrors[strconv.Itoa(i)] = validation.Errors{
+				"id": fmt.Errorf("item %d not found", line.ItemID),
+			}
+			continue
+		}
+
+		if err := line.SnapshotItem(item); err != nil {
+			lineErrors[strconv.Itoa(i)] = err
+			continue
+		}
+
+		comboId := combo.ID
+		line.ComboID = &comboId
+		line.ComboName = combo.Name
+		line.ComboGroup = group
+
+		lines[i] = line
+		itemPrices[i] = item.Price
+		itemsPrice += item.Price
+	}
+
+	if len(lineErrors) > 0 {
+		return nil, validation.Errors{"items": lineErrors}
+	}
+
+	if !combo.FillsSlots(orderCombo.ItemIDs()) {
+		return nil, validation.Errors{
+			"items": fmt.Errorf("must choose one item for each of the %d slots of combo %d", len(combo.Slots), combo.ID),
+		}
+	}
+
+	discount := combo.Discount(itemsPrice)
+	var splitDiscount Money
+	for i := range lines {
+		share := discount - splitDiscount
+		if i < len(lines)-1 {
+			share = discount.Share(itemPrices[i], itemsPrice)
+		}
+		splitDiscount += share
+
+		lines[i].Discount = share.Times(orderCombo.Quantity)
+	}
+
+	return lines, nil
+}
@@ -0,0 +1,124 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/8soat-grupo35/fastfood-order/internal/adapters/dto"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func comboForTest() Combo {
+	return Combo{ID: 7, Name: "Combo Classico", PricingRule: COMBO_FIXED_PRICE, Price: 3500, Slots: []ComboSlot{
+		{Category: "LANCHE", Items: []ComboSlotItem{{ItemID: 1}, {ItemID: 4}}},
+		{Category: "ACOMPANHAMENTO", Items: []ComboSlotItem{{ItemID: 2}}},
+		{Category: "BEBIDA", Items: []ComboSlotItem{{ItemID: 3}}},
+	}}
+}
+
+func comboItemsForTest() map[uint32]Item {
+	return map[uint32]Item{
+		1: {ID: 1, Name: "X-Burguer", Category: "LANCHE", Price: 2800},
+		2: {ID: 2, Name: "Batata frita", Category: "ACOMPANHAMENTO", Price: 1200},
+		3: {ID: 3, Name: "Refrigerante", Category: "BEBIDA", Price: 790, Variants: []ItemVariant{{ID: 5, Label: "G", PriceDelta: 250, Available: true}}},
+	}
+}
+
+func TestNewComboNormalizesPricingRuleAndCategories(t *testing.T) {
+	combo, err := NewCombo(dto.ComboDto{
+		Name:          "Combo Kids",
+		ImageUrl:      "https://example.com/kids.png",
+		PricingRule:   "percentual",
+		PercentageOff: 15,
+		Slots: []dto.ComboSlotDto{
+			{Category: "lanche", ItemIDs: []uint32{1}},
+			{Category: "sobremesa", ItemIDs: []uint32{6}},
+		},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, COMBO_PERCENTAGE_OFF, combo.PricingRule)
+	assert.Equal(t, "SOBREMESA", combo.Slots[1].Category)
+	assert.Equal(t, []uint32{1, 6}, combo.ItemIDs())
+}
+
+func TestNewComboRequiresThePriceOfItsPricingRule(t *testing.T) {
+	_, err := NewCombo(dto.ComboDto{
+		Name:        "Combo Kids",
+		ImageUrl:    "https://example.com/kids.png",
+		PricingRule: COMBO_PERCENTAGE_OFF,
+		Price:       30,
+		Slots: []dto.ComboSlotDto{
+			{Category: "LANCHE", ItemIDs: []uint32{1}},
+			{Category: "BEBIDA", ItemIDs: []uint32{3, 3}},
+		},
+	})
+
+	errs, ok := err.(validation.Errors)
+	assert.True(t, ok)
+	assert.Contains(t, errs, "percentage_off")
+	assert.Equal(t, "must not repeat an item", errs["slots"].(validation.Errors)["1"].(validation.Errors)["items"].Error())
+}
+
+func TestComboDiscount(t *testing.T) {
+	fixedPrice := Combo{PricingRule: COMBO_FIXED_PRICE, Price: 3500}
+	percentageOff := Combo{PricingRule: COMBO_PERCENTAGE_OFF, PercentageOff: 10}
+
+	assert.Equal(t, Money(1290), fixedPrice.Discount(4790))
+	assert.Equal(t, Money(0), fixedPrice.Discount(3000))
+	assert.Equal(t, Money(479), percentageOff.Discount(4790))
+}
+
+func TestComboFillsSlotsMatchesItemsToTheSlotsThatAllowThem(t *testing.T) {
+	combo := Combo{Slots: []ComboSlot{
+		{Items: []ComboSlotItem{{ItemID: 1}, {ItemID: 2}}},
+		{Items: []ComboSlotItem{{ItemID: 1}}},
+	}}
+
+	assert.True(t, combo.FillsSlots([]uint32{1, 2}))
+	assert.False(t, combo.FillsSlots([]uint32{2, 2}))
+	assert.False(t, combo.FillsSlots([]uint32{1}))
+}
+
+func TestComboExpandSplitsTheDiscountAmongTheLines(t *testing.T) {
+	largeID := uint32(5)
+	orderCombo := OrderCombo{ComboID: 7, Quantity: 2, Items: []OrderItem{
+		{ItemID: 1, Quantity: 2},
+		{ItemID: 2, Quantity: 2},
+		{ItemID: 3, Quantity: 2, VariantID: &largeID},
+	}}
+
+	lines, err := comboForTest().Expand(orderCombo, comboItemsForTest(), 1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []Money{1508, 646, 426}, []Money{lines[0].Discount, lines[1].Discount, lines[2].Discount})
+	assert.Equal(t, Money(1040), lines[2].UnitPrice)
+	assert.Equal(t, "Combo Classico", lines[2].ComboName)
+
+	var total Money
+	for _, line := range lines {
+		total += line.Total()
+	}
+	assert.Equal(t, Money(7500), total)
+}
+
+func TestComboExpandReportsItemsOutsideTheCombo(t *testing.T) {
+	orderCombo := OrderCombo{ComboID: 7, Quantity: 1, Items: []OrderItem{
+		{ItemID: 1, Quantity: 1},
+		{ItemID: 9, Quantity: 1},
+		{ItemID: 2, Quantity: 1},
+	}}
+
+	_, err := comboForTest().Expand(orderCombo, comboItemsForTest(), 1)
+
+	assert.Equal(t, "items: (1: (id: item 9 is not part of combo 7.).).", err.Error())
+}
+
+func TestOrderExpandCombosReportsUnknownCombos(t *testing.T) {
+	order := Order{Combos: []OrderCombo{{ComboID: 8, Quantity: 1, Items: []OrderItem{{ItemID: 1, Quantity: 1}}}}}
+
+	err := order.ExpandCombos([]Combo{comboForTest()}, nil)
+
+	assert.Equal(t, "0: (id: combo 8 not found.).", err.Error())
+	assert.Len(t, order.Combos, 1)
+}
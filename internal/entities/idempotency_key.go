@@ -0,0 +1,88 @@
+package entities
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+const (
+	// IDEMPOTENCY_KEY_LEASE is how long a request holds its key before a retry may take it over, in case
+	// the request never finished, such as when the replica handling it went down.
+	IDEMPOTENCY_KEY_LEASE = time.Minute
+	// IDEMPOTENCY_KEY_RETENTION is how long the response of a finished request is replayed.
+	IDEMPOTENCY_KEY_RETENTION = 24 * time.Hour
+)
+
+// IdempotencyKey is a request made once. The request holds the key until LockedUntil while it runs, and
+// once it finished its response is replayed until ExpiresAt.
+type IdempotencyKey struct {
+	ID             uint32    `gorm:"primarykey;autoIncrement"`
+	Key            string    `gorm:"column:idempotency_key;size:255;not null"`
+	Scope          string    `gorm:"size:255;not null"`
+	RequestHash    string    `gorm:"size:64;not null"`
+	ResponseStatus int       `gorm:"not null;default:0"`
+	ResponseBody   string    `gorm:"type:text"`
+	LockedUntil    time.Time `gorm:"not null"`
+	ExpiresAt      time.Time `gorm:"not null"`
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+func NewIdempotencyKey(key string, scope string, requestBody []byte) (*IdempotencyKey, error) {
+	now := time.Now()
+
+	idempotencyKey := IdempotencyKey{
+		Key:         key,
+		Scope:       scope,
+		RequestHash: HashRequest(requestBody),
+		LockedUntil: now.Add(IDEMPOTENCY_KEY_LEASE),
+		ExpiresAt:   now.Add(IDEMPOTENCY_KEY_RETENTION),
+	}
+
+	err := idempotencyKey.Validate()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &idempotencyKey, nil
+}
+
+func HashRequest(requestBody []byte) string {
+	hash := sha256.Sum256(requestBody)
+	return hex.EncodeToString(hash[:])
+}
+
+func (k IdempotencyKey) Validate() error {
+	return validation.ValidateStruct(
+		&k,
+		validation.Field(
+			&k.Key,
+			validation.Required,
+			validation.Length(1, 255),
+		),
+		validation.Field(
+			&k.Scope,
+			validation.Required,
+		),
+	)
+}
+
+func (k IdempotencyKey) Matches(requestHash string) bool {
+	return k.RequestHash == requestHash
+}
+
+// IsCompleted tells whether the original request already finished and its response can be replayed.
+func (k IdempotencyKey) IsCompleted() bool {
+	return k.ResponseStatus != 0
+}
+
+// Complete stores the response to replay, which is kept for IDEMPOTENCY_KEY_RETENTION from now on.
+func (k *IdempotencyKey) Complete(responseStatus int, responseBody []byte) {
+	k.ResponseStatus = responseStatus
+	k.ResponseBody = string(responseBody)
+	k.ExpiresAt = time.Now().Add(IDEMPOTENCY_KEY_RETENTION)
+}
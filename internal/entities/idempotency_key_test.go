@@ -0,0 +1,42 @@
+package entities
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestNewIdempotencyKeyHashesRequestBody(t *testing.T) {
+	idempotencyKey, err := NewIdempotencyKey("totem-1-abc", "POST /v1/orders/checkout", []byte(`{"customer_id":1}`))
+
+	assert.NoError(t, err)
+	assert.Equal(t, "totem-1-abc", idempotencyKey.Key)
+	assert.Len(t, idempotencyKey.RequestHash, 64)
+	assert.True(t, idempotencyKey.Matches(HashRequest([]byte(`{"customer_id":1}`))))
+	assert.False(t, idempotencyKey.Matches(HashRequest([]byte(`{"customer_id":2}`))))
+	assert.False(t, idempotencyKey.IsCompleted())
+	assert.WithinDuration(t, time.Now().Add(IDEMPOTENCY_KEY_LEASE), idempotencyKey.LockedUntil, time.Second)
+	assert.WithinDuration(t, time.Now().Add(IDEMPOTENCY_KEY_RETENTION), idempotencyKey.ExpiresAt, time.Second)
+}
+
+func TestNewIdempotencyKeyReturnsErrorForTooLongKey(t *testing.T) {
+	key := make([]byte, 256)
+	for i := range key {
+		key[i] = 'a'
+	}
+
+	idempotencyKey, err := NewIdempotencyKey(string(key), "POST /v1/orders/checkout", nil)
+
+	assert.Error(t, err)
+	assert.Nil(t, idempotencyKey)
+}
+
+func TestCompleteStoresResponse(t *testing.T) {
+	idempotencyKey := IdempotencyKey{Key: "totem-1-abc"}
+
+	idempotencyKey.Complete(200, []byte(`{"id":1}`))
+
+	assert.True(t, idempotencyKey.IsCompleted())
+	assert.Equal(t, `{"id":1}`, idempotencyKey.ResponseBody)
+	assert.WithinDuration(t, time.Now().Add(IDEMPOTENCY_KEY_RETENTION), idempotencyKey.ExpiresAt, time.Second)
+}
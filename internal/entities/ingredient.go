@@ -0,0 +1,96 @@
+package entities
+
+import (
+	"github.com/8soat-grupo35/fastfood-order/internal/adapters/dto"
+	"strings"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"gorm.io/gorm"
+)
+
+const (
+	INGREDIENT_UNIT       = "UN"
+	INGREDIENT_GRAM       = "G"
+	INGREDIENT_KILOGRAM   = "KG"
+	INGREDIENT_MILLILITER = "ML"
+	INGREDIENT_LITER      = "L"
+)
+
+// Ingredient is something the kitchen uses to prepare the items. The stock and the quantities of the
+// recipes are given in the unit of the ingredient. Stocks keeps what is left at each store and Stock is
+// the one of the store applied by ApplyStock, none being left at stores without a stock.
+type Ingredient struct {
+	ID     uint32                 `gorm:"primary_key;auto_increment" json:"id"`
+	Name   string                 `gorm:"size:100;not null;" json:"name"`
+	Unit   string                 `gorm:"size:5;not null;" json:"unit"`
+	Stock  Quantity               `gorm:"-" json:"stock"`
+	Stocks []StoreIngredientStock `gorm:"foreignKey:IngredientID;constraint:OnDelete:CASCADE" json:"-"`
+	// LowStockThreshold is the stock below which the ingredient shows up in the low stock report. Zero
+	// leaves the ingredient out of the report.
+	LowStockThreshold Quantity       `gorm:"type:numeric(12,3);not null;default:0" json:"low_stock_threshold"`
+	CreatedAt         time.Time      `json:"created_at"`
+	UpdatedAt         time.Time      `json:"updated_at"`
+	DeletedAt         gorm.DeletedAt `gorm:"index" json:"-"`
+} //@name domain.Ingredient
+
+func NewIngredient(ingredientDto dto.IngredientDto) (*Ingredient, error) {
+	newIngredient := Ingredient{
+		Name:              strings.TrimSpace(ingredientDto.Name),
+		Unit:              strings.ToUpper(strings.TrimSpace(ingredientDto.Unit)),
+		Stock:             NewQuantity(ingredientDto.Stock),
+		LowStockThreshold: NewQuantity(ingredientDto.LowStockThreshold),
+	}
+
+	err := newIngredient.Validate()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &newIngredient, nil
+}
+
+func (ingredient Ingredient) Validate() error {
+	return validation.ValidateStruct(
+		&ingredient,
+		validation.Field(
+			&ingredient.Name,
+			validation.Required,
+			validation.Length(2, 100),
+		),
+		validation.Field(
+			&ingredient.Unit,
+			validation.Required,
+			validation.In(INGREDIENT_UNIT, INGREDIENT_GRAM, INGREDIENT_KILOGRAM, INGREDIENT_MILLILITER, INGREDIENT_LITER).Error("must be a valid value between (un,g,kg,ml,l)"),
+		),
+		validation.Field(
+			&ingredient.Stock,
+			minQuantity(0),
+		),
+		validation.Field(
+			&ingredient.LowStockThreshold,
+			minQuantity(0),
+		),
+	)
+}
+
+// ApplyStock sets the stock of the ingredient to the one left at the store.
+func (ingredient *Ingredient) ApplyStock(storeId uint32) {
+	ingredient.Stock = 0
+	for _, stock := range ingredient.Stocks {
+		if stock.StoreID == storeId {
+			ingredient.Stock = stock.Stock
+		}
+	}
+}
+
+// KeepStockAt keeps the stock of the ingredient as the one left at the store, so it is stored with the
+// ingredient.
+func (ingredient *Ingredient) KeepStockAt(storeId uint32) {
+	ingredient.Stocks = []StoreIngredientStock{{StoreID: storeId, IngredientID: ingredient.ID, Stock: ingredient.Stock}}
+}
+
+func (ingredient Ingredient) IsLowOnStock() bool {
+	return ingredient.Stock < ingredient.LowStockThreshold
+}
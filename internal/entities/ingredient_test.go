@@ -0,0 +1,82 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/8soat-grupo35/fastfood-order/internal/adapters/dto"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewIngredientNormalizesNameAndUnit(t *testing.T) {
+	ingredient, err := NewIngredient(dto.IngredientDto{
+		Name:              " Pão de hambúrguer ",
+		Unit:              "un",
+		Stock:             40,
+		LowStockThreshold: 10,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Pão de hambúrguer", ingredient.Name)
+	assert.Equal(t, INGREDIENT_UNIT, ingredient.Unit)
+	assert.False(t, ingredient.IsLowOnStock())
+}
+
+func TestNewIngredientRejectsUnknownUnitAndNegativeStock(t *testing.T) {
+	_, err := NewIngredient(dto.IngredientDto{
+		Name:  "Queijo",
+		Unit:  "fatia",
+		Stock: -1,
+	})
+
+	errs, ok := err.(validation.Errors)
+	assert.True(t, ok)
+	assert.EqualError(t, errs["unit"], "must be a valid value between (un,g,kg,ml,l)")
+	assert.Contains(t, errs, "stock")
+}
+
+func TestIngredientIsLowOnStock(t *testing.T) {
+	tests := []struct {
+		name       string
+		ingredient Ingredient
+		expected   bool
+	}{
+		{"below threshold", Ingredient{Stock: 400000, LowStockThreshold: 500000}, true},
+		{"at threshold", Ingredient{Stock: 500000, LowStockThreshold: 500000}, false},
+		{"without threshold", Ingredient{Stock: 0}, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, test.ingredient.IsLowOnStock())
+		})
+	}
+}
+
+func TestItemValidateRejectsRepeatedIngredient(t *testing.T) {
+	_, err := NewItem(dto.ItemDto{
+		Name:     "X-Burguer",
+		Category: "LANCHE",
+		Price:    28,
+		Recipe: []dto.RecipeIngredientDto{
+			{IngredientID: 1, Quantity: 150},
+			{IngredientID: 1, Quantity: 50},
+		},
+	})
+
+	errs, ok := err.(validation.Errors)
+	assert.True(t, ok)
+	assert.EqualError(t, errs["Recipe"], "must not repeat an ingredient")
+}
+
+func TestItemValidateIngredientsReportsUnregisteredOnes(t *testing.T) {
+	item := Item{Recipe: []RecipeIngredient{
+		{IngredientID: 1, Quantity: 150000},
+		{IngredientID: 2, Quantity: 1000},
+	}}
+
+	err := item.ValidateIngredients([]Ingredient{{ID: 1}})
+
+	assert.EqualError(t, err, "Recipe: (1: (IngredientID: ingredient 2 not found.).).")
+	assert.NoError(t, item.ValidateIngredients([]Ingredient{{ID: 1}, {ID: 2}}))
+}
@@ -1,45 +1,120 @@
 package entities
 
 import (
+	"fmt"
 	"github.com/8soat-grupo35/fastfood-order/internal/adapters/dto"
 	"strings"
+	"time"
 
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 	"github.com/go-ozzo/ozzo-validation/v4/is"
 	"gorm.io/gorm"
 )
 
+// Item is a product of the menu. Category is the name of the category of CategoryID, kept on the item
+// so the menu can be filtered and priced by it. Stocks keeps the units left at each store and Stock is
+// the one of the store applied by ApplyStock, nil when the stock of the item is not tracked there.
+// Recipe lists the ingredients one unit uses. Items with a recipe are
+// stocked by their ingredients, leaving Stock out. Paused items are off sale until they are made
+// available again, and MenuItems lists the menus the item is part of. Available tells if the item can
+// be sold now, being off sale and out of stock otherwise.
 type Item struct {
-	ID       uint32  `gorm:"primary_key;auto_increment"`
-	Name     string  `gorm:"size:255;not null;"`
-	Category string  `gorm:"size:30;not null;"`
-	Price    float32 `gorm:"not null;"`
-	ImageUrl string  `gorm:"size:255;not null;"`
+	ID         uint32             `gorm:"primary_key;auto_increment"`
+	Name       string             `gorm:"size:255;not null;"`
+	Category   string             `gorm:"size:30;not null;"`
+	CategoryID uint32             `gorm:"not null;"`
+	Price      Money              `gorm:"type:numeric(12,2);not null;"`
+	ImageUrl   string             `gorm:"size:255;not null;"`
+	Variants   []ItemVariant      `gorm:"foreignKey:ItemID;constraint:OnDelete:CASCADE" json:",omitempty"`
+	Modifiers  []ItemModifier     `gorm:"foreignKey:ItemID;constraint:OnDelete:CASCADE" json:",omitempty"`
+	Recipe     []RecipeIngredient `gorm:"foreignKey:ItemID;constraint:OnDelete:CASCADE" json:",omitempty"`
+	Stock      *uint32            `gorm:"-" json:",omitempty"`
+	Stocks     []StoreItemStock   `gorm:"foreignKey:ItemID;constraint:OnDelete:CASCADE" json:"-"`
+	Paused     bool               `gorm:"not null;"`
+	MenuItems  []MenuItem         `gorm:"foreignKey:ItemID" json:"-"`
+	Available  bool               `gorm:"-"`
+	offSale    bool
 	gorm.Model
 } //@name domain.Item
 
-func (item Item) ValidateCategory() error {
+// PlaceInCategory puts the item in the given category, which is nil when the category of the item is
+// not registered.
+func (item *Item) PlaceInCategory(category *Category) error {
+	if category == nil {
+		return validation.Errors{"Category": ErrCategoryNotRegistered}
+	}
 
-	return validation.ValidateStruct(
-		&item,
-		validation.Field(
-			&item.Category,
-			validation.In(item.allowedCategories()...).Error("must be a valid value between (lanche,sobremesa,acompanhamento,bebida)"),
-		),
-	)
+	item.Category = category.Name
+	item.CategoryID = category.ID
+
+	return nil
 }
 
-func (item Item) allowedCategories() []interface{} {
-	return []interface{}{
-		"LANCHE",
-		"ACOMPANHAMENTO",
-		"BEBIDA",
-		"SOBREMESA",
+// ApplyMenus prices the item by the menus of the store open at now, which must already be in the store
+// time zone, and takes it off sale while none of its exclusive menus in the store is open. Menus of the
+// other stores are left aside. When several open menus price the item, the lowest price wins.
+func (item *Item) ApplyMenus(storeId uint32, now time.Time) {
+	exclusive, open := false, false
+	var menuPrice *Money
+
+	for _, menuItem := range item.MenuItems {
+		if menuItem.Menu == nil || menuItem.Menu.StoreID != storeId {
+			continue
+		}
+
+		exclusive = exclusive || menuItem.Menu.Exclusive
+
+		if !menuItem.Menu.OpenAt(now) {
+			continue
+		}
+
+		open = open || menuItem.Menu.Exclusive
+
+		if menuItem.Price != nil && (menuPrice == nil || *menuItem.Price < *menuPrice) {
+			menuPrice = menuItem.Price
+		}
+	}
+
+	if menuPrice != nil {
+		item.Price = *menuPrice
+	}
+
+	item.offSale = exclusive && !open
+}
+
+// ApplyStock sets the stock of the item and of the ingredients of its recipe to the ones left at the
+// store.
+func (item *Item) ApplyStock(storeId uint32) {
+	item.Stock = nil
+	for _, stock := range item.Stocks {
+		if stock.StoreID == storeId {
+			units := stock.Stock
+			item.Stock = &units
+		}
+	}
+
+	for _, recipeIngredient := range item.Recipe {
+		if recipeIngredient.Ingredient != nil {
+			recipeIngredient.Ingredient.ApplyStock(storeId)
+		}
 	}
 }
 
+// KeepStockAt keeps the stock of the item as the one left at the store, so it is stored with the item.
+func (item *Item) KeepStockAt(storeId uint32) {
+	item.Stocks = nil
+	if item.Stock != nil {
+		item.Stocks = []StoreItemStock{{StoreID: storeId, ItemID: item.ID, Stock: *item.Stock}}
+	}
+}
+
+// OnSale tells if the item can be sold: it is not paused and, as told by the last ApplyMenus, one of its
+// exclusive menus is open.
+func (item Item) OnSale() bool {
+	return !item.Paused && !item.offSale
+}
+
 func (item Item) Validate() error {
-	allowedCategories := item.allowedCategories()
 	return validation.ValidateStruct(
 		&item,
 		validation.Field(
@@ -50,27 +125,95 @@ func (item Item) Validate() error {
 		validation.Field(
 			&item.Category,
 			validation.Required,
-			validation.In(allowedCategories...).Error("must be a valid value between (lanche,sobremesa,acompanhamento,bebida)"),
 		),
 		validation.Field(
 			&item.Price,
-			validation.Required,
-			validation.Min(0.01),
+			requiredMoney,
+			minMoney(1),
 		),
 		validation.Field(
 			&item.ImageUrl,
 			validation.Required,
 			is.URL,
 		),
+		validation.Field(
+			&item.Variants,
+			validation.By(func(value interface{}) error {
+				return distinctNames(item.VariantLabels(), "variant")
+			}),
+			validation.By(func(value interface{}) error {
+				return item.validateVariantPrices()
+			}),
+		),
+		validation.Field(
+			&item.Modifiers,
+			validation.By(func(value interface{}) error {
+				return distinctNames(item.ModifierNames(), "modifier")
+			}),
+		),
+		validation.Field(
+			&item.Recipe,
+			validation.By(func(value interface{}) error {
+				return item.validateRecipe()
+			}),
+		),
 	)
 }
 
+// InStock tells if the given units of the item can be sold. Items made from a recipe run out with an
+// ingredient of the recipe, their own stock being left out, while other items without a tracked stock
+// never run out. Ingredients not loaded are not checked.
+func (item Item) InStock(quantity uint32) bool {
+	if len(item.Recipe) == 0 && item.Stock != nil && *item.Stock < quantity {
+		return false
+	}
+
+	for _, recipeIngredient := range item.Recipe {
+		if recipeIngredient.Ingredient != nil && recipeIngredient.Ingredient.Stock < recipeIngredient.Quantity.Times(quantity) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (item Item) ModifierNames() (names []string) {
+	for _, modifier := range item.Modifiers {
+		names = append(names, modifier.Name)
+	}
+
+	return names
+}
+
 func NewItem(item dto.ItemDto) (*Item, error) {
 	newItem := Item{
 		Name:     item.Name,
-		Category: strings.ToUpper(item.Category),
-		Price:    item.Price,
+		Category: strings.ToUpper(strings.TrimSpace(item.Category)),
+		Price:    NewMoney(item.Price),
 		ImageUrl: item.ImageUrl,
+		Stock:    item.Stock,
+	}
+
+	for _, variant := range item.Variants {
+		newItem.Variants = append(newItem.Variants, ItemVariant{
+			Label:      strings.ToUpper(strings.TrimSpace(variant.Label)),
+			PriceDelta: NewMoney(variant.PriceDelta),
+			Available:  variant.Available == nil || *variant.Available,
+		})
+	}
+
+	for _, modifier := range item.Modifiers {
+		newItem.Modifiers = append(newItem.Modifiers, ItemModifier{
+			Name:  strings.TrimSpace(modifier.Name),
+			Price: NewMoney(modifier.Price),
+		})
+	}
+
+	for _, recipeIngredient := range item.Recipe {
+		newItem.Recipe = append(newItem.Recipe, RecipeIngredient{
+			IngredientID: recipeIngredient.IngredientID,
+			Quantity:     NewQuantity(recipeIngredient.Quantity),
+		})
 	}
 
 	err := newItem.Validate()
@@ -81,3 +224,17 @@ func NewItem(item dto.ItemDto) (*Item, error) {
 
 	return &newItem, err
 }
+
+// distinctNames rejects repeated names, which are compared ignoring case.
+func distinctNames(names []string, kind string) error {
+	seen := make(map[string]bool, len(names))
+	for _, name := range names {
+		key := strings.ToLower(strings.TrimSpace(name))
+		if seen[key] {
+			return fmt.Errorf("must not repeat a %s", kind)
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
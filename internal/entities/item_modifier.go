@@ -0,0 +1,41 @@
+package entities
+
+import (
+	"strings"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+// ItemModifier is an add-on the customer may ask for on an item, such as extra bacon. Its price is
+// added to the item price, free add-ons have a zero price.
+type ItemModifier struct {
+	ID     uint32 `gorm:"primary_key;auto_increment"`
+	ItemID uint32 `json:"-"`
+	Name   string `gorm:"size:100;not null;"`
+	Price  Money  `gorm:"type:numeric(12,2);not null;"`
+} //@name domain.ItemModifier
+
+func (modifier ItemModifier) Validate() error {
+	return validation.ValidateStruct(
+		&modifier,
+		validation.Field(
+			&modifier.Name,
+			validation.Required,
+			validation.Length(2, 100),
+		),
+		validation.Field(
+			&modifier.Price,
+			minMoney(0),
+		),
+	)
+}
+
+func (item Item) Modifier(name string) (ItemModifier, bool) {
+	for _, modifier := range item.Modifiers {
+		if strings.EqualFold(modifier.Name, strings.TrimSpace(name)) {
+			return modifier, true
+		}
+	}
+
+	return ItemModifier{}, false
+}
@@ -20,14 +20,95 @@ func TestNewItemCreatesValidItem(t *testing.T) {
 	assert.NotNil(t, item)
 	assert.Equal(t, "Burger", item.Name)
 	assert.Equal(t, "LANCHE", item.Category)
-	assert.Equal(t, float32(10.0), item.Price)
+	assert.Equal(t, Money(1000), item.Price)
 	assert.Equal(t, "http://image.com", item.ImageUrl)
 }
 
-func TestNewItemReturnsErrorForInvalidCategory(t *testing.T) {
+func TestNewItemKeepsModifiers(t *testing.T) {
+	itemDto := dto.ItemDto{
+		Name:      "Burger",
+		Category:  "LANCHE",
+		Price:     10.0,
+		ImageUrl:  "http://image.com",
+		Modifiers: []dto.ItemModifierDto{{Name: " Bacon extra ", Price: 4.5}, {Name: "Sem cebola"}},
+	}
+
+	item, err := NewItem(itemDto)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []ItemModifier{{Name: "Bacon extra", Price: 450}, {Name: "Sem cebola"}}, item.Modifiers)
+}
+
+func TestNewItemReturnsErrorForInvalidModifiers(t *testing.T) {
+	itemDto := dto.ItemDto{
+		Name:      "Burger",
+		Category:  "LANCHE",
+		Price:     10.0,
+		ImageUrl:  "http://image.com",
+		Modifiers: []dto.ItemModifierDto{{Name: "B", Price: -1}},
+	}
+
+	item, err := NewItem(itemDto)
+
+	assert.Error(t, err)
+	assert.Nil(t, item)
+	assert.Equal(t, "Modifiers: (0: (Name: the length must be between 2 and 100; Price: must be no less than 0.00.).).", err.Error())
+}
+
+func TestNewItemReturnsErrorForRepeatedModifiers(t *testing.T) {
+	itemDto := dto.ItemDto{
+		Name:      "Burger",
+		Category:  "LANCHE",
+		Price:     10.0,
+		ImageUrl:  "http://image.com",
+		Modifiers: []dto.ItemModifierDto{{Name: "Bacon extra"}, {Name: "BACON EXTRA"}},
+	}
+
+	_, err := NewItem(itemDto)
+
+	assert.Equal(t, "Modifiers: must not repeat a modifier.", err.Error())
+}
+
+func TestNewItemKeepsVariantsAvailableByDefault(t *testing.T) {
+	unavailable := false
+	itemDto := dto.ItemDto{
+		Name:     "Refrigerante",
+		Category: "BEBIDA",
+		Price:    7.9,
+		ImageUrl: "http://image.com",
+		Variants: []dto.ItemVariantDto{{Label: "p", PriceDelta: -2}, {Label: "G", PriceDelta: 2.5, Available: &unavailable}},
+	}
+
+	item, err := NewItem(itemDto)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []ItemVariant{{Label: "P", PriceDelta: -200, Available: true}, {Label: "G", PriceDelta: 250, Available: false}}, item.Variants)
+}
+
+func TestNewItemReturnsErrorForInvalidVariants(t *testing.T) {
+	itemDto := dto.ItemDto{
+		Name:     "Refrigerante",
+		Category: "BEBIDA",
+		Price:    7.9,
+		ImageUrl: "http://image.com",
+		Variants: []dto.ItemVariantDto{{Label: "P", PriceDelta: -8}, {Label: "p"}},
+	}
+
+	_, err := NewItem(itemDto)
+
+	assert.Equal(t, "Variants: must not repeat a variant.", err.Error())
+
+	itemDto.Variants = []dto.ItemVariantDto{{Label: "P", PriceDelta: -8}}
+
+	_, err = NewItem(itemDto)
+
+	assert.Equal(t, "Variants: variant P must cost at least 0.01.", err.Error())
+}
+
+func TestNewItemReturnsErrorForBlankCategory(t *testing.T) {
 	itemDto := dto.ItemDto{
 		Name:     "Burger",
-		Category: "INVALID",
+		Category: " ",
 		Price:    10.0,
 		ImageUrl: "http://image.com",
 	}
@@ -66,24 +147,25 @@ func TestNewItemReturnsErrorForInvalidImageUrl(t *testing.T) {
 	assert.Nil(t, item)
 }
 
-func TestValidateCategoryReturnsErrorForInvalidCategory(t *testing.T) {
+func TestPlaceInCategoryReturnsErrorForCategoryNotRegistered(t *testing.T) {
 	item := Item{
 		Category: "INVALID",
 	}
 
-	err := item.ValidateCategory()
+	err := item.PlaceInCategory(nil)
 
-	assert.Error(t, err)
+	assert.EqualError(t, err, "Category: must be a registered category.")
 }
 
-func TestValidateCategoryReturnsNoErrorForValidCategory(t *testing.T) {
+func TestPlaceInCategoryReferencesTheCategory(t *testing.T) {
 	item := Item{
-		Category: "LANCHE",
+		Category: "CAFÉ DA MANHÃ",
 	}
 
-	err := item.ValidateCategory()
+	err := item.PlaceInCategory(&Category{ID: 5, Name: "CAFÉ DA MANHÃ"})
 
 	assert.NoError(t, err)
+	assert.Equal(t, uint32(5), item.CategoryID)
 }
 
 func TestValidateReturnsErrorForInvalidItemName(t *testing.T) {
@@ -0,0 +1,63 @@
+package entities
+
+import (
+	"fmt"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+const ITEM_VARIANT_UNAVAILABLE_REASON = "variant is no longer available"
+
+// ItemVariant is a version of the item the customer must choose from, such as the P, M and G sizes
+// of a drink. Its price is the item price plus the price delta, which may be negative.
+type ItemVariant struct {
+	ID         uint32 `gorm:"primary_key;auto_increment"`
+	ItemID     uint32 `json:"-"`
+	Label      string `gorm:"size:30;not null;"`
+	PriceDelta Money  `gorm:"type:numeric(12,2);not null;"`
+	Available  bool   `gorm:"not null;"`
+} //@name domain.ItemVariant
+
+func (variant ItemVariant) Validate() error {
+	return validation.ValidateStruct(
+		&variant,
+		validation.Field(
+			&variant.Label,
+			validation.Required,
+			validation.Length(1, 30),
+		),
+	)
+}
+
+func (item Item) HasVariants() bool {
+	return len(item.Variants) > 0
+}
+
+func (item Item) Variant(variantId uint32) (ItemVariant, bool) {
+	for _, variant := range item.Variants {
+		if variant.ID == variantId {
+			return variant, true
+		}
+	}
+
+	return ItemVariant{}, false
+}
+
+func (item Item) VariantLabels() (labels []string) {
+	for _, variant := range item.Variants {
+		labels = append(labels, variant.Label)
+	}
+
+	return labels
+}
+
+// validateVariantPrices keeps every variant of the item with a positive price.
+func (item Item) validateVariantPrices() error {
+	for _, variant := range item.Variants {
+		if item.Price+variant.PriceDelta < 1 {
+			return fmt.Errorf("variant %s must cost at least 0.01", variant.Label)
+		}
+	}
+
+	return nil
+}
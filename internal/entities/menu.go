@@ -0,0 +1,182 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"github.com/8soat-grupo35/fastfood-order/internal/adapters/dto"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"gorm.io/gorm"
+)
+
+const TIME_OF_DAY_LAYOUT = "15:04"
+
+var timeOfDayPattern = regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`)
+
+// Menu is a part of the menu of a store open in a window of the day, in the store time zone. Items of
+// exclusive menus, such as the breakfast, are only sold while one of their exclusive menus is open. The
+// price of a menu item replaces the price of the item while the menu is open, as in a happy hour, and a
+// menu open the whole day gives the store its own prices.
+type Menu struct {
+	ID        uint32         `gorm:"primary_key;auto_increment" json:"id"`
+	StoreID   uint32         `gorm:"not null;" json:"-"`
+	Name      string         `gorm:"size:100;not null;" json:"name"`
+	StartTime string         `gorm:"size:5;not null;" json:"start_time"`
+	EndTime   string         `gorm:"size:5;not null;" json:"end_time"`
+	Exclusive bool           `gorm:"not null;" json:"exclusive"`
+	Items     []MenuItem     `gorm:"foreignKey:MenuID;constraint:OnDelete:CASCADE" json:"items"`
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+	DeletedAt gorm.DeletedAt `gorm:"index" json:"-"`
+} //@name domain.Menu
+
+// MenuItem puts an item in a menu. Menu is only loaded along with the menus of the item.
+type MenuItem struct {
+	ID     uint32 `gorm:"primary_key;auto_increment" json:"-"`
+	MenuID uint32 `json:"-"`
+	ItemID uint32 `json:"item_id"`
+	Price  *Money `gorm:"type:numeric(12,2);" json:"price,omitempty"`
+	Menu   *Menu  `gorm:"foreignKey:MenuID" json:"-"`
+} //@name domain.MenuItem
+
+func NewMenu(menuDto dto.MenuDto) (*Menu, error) {
+	newMenu := Menu{
+		Name:      strings.TrimSpace(menuDto.Name),
+		StartTime: strings.TrimSpace(menuDto.StartTime),
+		EndTime:   strings.TrimSpace(menuDto.EndTime),
+		Exclusive: menuDto.Exclusive,
+	}
+
+	for _, menuItemDto := range menuDto.Items {
+		menuItem := MenuItem{
+			ItemID: menuItemDto.ItemID,
+		}
+
+		if menuItemDto.Price != nil {
+			price := NewMoney(*menuItemDto.Price)
+			menuItem.Price = &price
+		}
+
+		newMenu.Items = append(newMenu.Items, menuItem)
+	}
+
+	err := newMenu.Validate()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &newMenu, nil
+}
+
+func (menu Menu) Validate() error {
+	return validation.ValidateStruct(
+		&menu,
+		validation.Field(
+			&menu.Name,
+			validation.Required,
+			validation.Length(3, 100),
+		),
+		validation.Field(
+			&menu.StartTime,
+			validation.Required,
+			validation.Match(timeOfDayPattern).Error("must be a time of the day as HH:MM"),
+		),
+		validation.Field(
+			&menu.EndTime,
+			validation.Required,
+			validation.Match(timeOfDayPattern).Error("must be a time of the day as HH:MM"),
+		),
+		validation.Field(
+			&menu.Items,
+			validation.Required,
+			validation.By(func(value interface{}) error {
+				seen := make(map[uint32]bool, len(menu.Items))
+				for _, menuItem := range menu.Items {
+					if seen[menuItem.ItemID] {
+						return errors.New("must not repeat an item")
+					}
+					seen[menuItem.ItemID] = true
+				}
+				return nil
+			}),
+		),
+	)
+}
+
+func (menuItem MenuItem) Validate() error {
+	return validation.ValidateStruct(
+		&menuItem,
+		validation.Field(
+			&menuItem.ItemID,
+			validation.Required,
+		),
+		validation.Field(
+			&menuItem.Price,
+			minMoney(1),
+		),
+	)
+}
+
+func (menu Menu) ItemIDs() (ids []uint32) {
+	for _, menuItem := range menu.Items {
+		ids = append(ids, menuItem.ItemID)
+	}
+
+	return ids
+}
+
+// ValidateItems checks every item of the menu is in the catalog. Errors are reported by the position of
+// the item in the menu.
+func (menu Menu) ValidateItems(items []Item) error {
+	catalog := make(map[uint32]bool, len(items))
+	for _, item := range items {
+		catalog[item.ID] = true
+	}
+
+	itemErrors := validation.Errors{}
+	for i, menuItem := range menu.Items {
+		if !catalog[menuItem.ItemID] {
+			itemErrors[strconv.Itoa(i)] = validation.Errors{
+				"item_id": fmt.Errorf("item %d not found", menuItem.ItemID),
+			}
+		}
+	}
+
+	if len(itemErrors) > 0 {
+		return validation.Errors{"items": itemErrors}
+	}
+
+	return nil
+}
+
+// OpenAt tells if the menu is open at the time of the day of now, which must already be in the store
+// time zone. The end of the window is exclusive, windows ending before they start go past midnight and
+// windows ending when they start last the whole day.
+func (menu Menu) OpenAt(now time.Time) bool {
+	start, end := minuteOfDay(menu.StartTime), minuteOfDay(menu.EndTime)
+	minute := now.Hour()*60 + now.Minute()
+
+	if start == end {
+		return true
+	}
+
+	if start < end {
+		return minute >= start && minute < end
+	}
+
+	return minute >= start || minute < end
+}
+
+func minuteOfDay(clock string) int {
+	parsed, err := time.Parse(TIME_OF_DAY_LAYOUT, clock)
+	if err != nil {
+		return 0
+	}
+
+	return parsed.Hour()*60 + parsed.Minute()
+}
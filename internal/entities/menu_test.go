@@ -0,0 +1,167 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/8soat-grupo35/fastfood-order/internal/adapters/dto"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func clockForTest(clock string) time.Time {
+	parsed, _ := time.Parse(TIME_OF_DAY_LAYOUT, clock)
+	return parsed
+}
+
+func TestNewMenuTrimsTheWindow(t *testing.T) {
+	price := 19.9
+	menu, err := NewMenu(dto.MenuDto{
+		Name:      " Happy hour ",
+		StartTime: " 17:00",
+		EndTime:   "19:00 ",
+		Items:     []dto.MenuItemDto{{ItemID: 1, Price: &price}, {ItemID: 2}},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Happy hour", menu.Name)
+	assert.Equal(t, "17:00", menu.StartTime)
+	assert.Equal(t, "19:00", menu.EndTime)
+	assert.Equal(t, []uint32{1, 2}, menu.ItemIDs())
+	assert.Equal(t, Money(1990), *menu.Items[0].Price)
+}
+
+func TestNewMenuReturnsErrorForInvalidWindow(t *testing.T) {
+	_, err := NewMenu(dto.MenuDto{
+		Name:      "Café da manhã",
+		StartTime: "7:00",
+		EndTime:   "24:00",
+		Items:     []dto.MenuItemDto{{ItemID: 1}},
+	})
+
+	errs, ok := err.(validation.Errors)
+	assert.True(t, ok)
+	assert.EqualError(t, errs["start_time"], "must be a time of the day as HH:MM")
+	assert.EqualError(t, errs["end_time"], "must be a time of the day as HH:MM")
+}
+
+func TestMenuEndingWhenItStartsIsOpenTheWholeDay(t *testing.T) {
+	menu, err := NewMenu(dto.MenuDto{
+		Name:      "Preços da unidade",
+		StartTime: "00:00",
+		EndTime:   "00:00",
+		Items:     []dto.MenuItemDto{{ItemID: 1}},
+	})
+
+	assert.NoError(t, err)
+	assert.True(t, menu.OpenAt(clockForTest("00:00")))
+	assert.True(t, menu.OpenAt(clockForTest("23:59")))
+}
+
+func TestNewMenuReturnsErrorForRepeatedItemAndInvalidPrice(t *testing.T) {
+	price := 0.0
+	_, err := NewMenu(dto.MenuDto{
+		Name:      "Happy hour",
+		StartTime: "17:00",
+		EndTime:   "19:00",
+		Items:     []dto.MenuItemDto{{ItemID: 1, Price: &price}, {ItemID: 1}},
+	})
+
+	errs, ok := err.(validation.Errors)
+	assert.True(t, ok)
+	assert.EqualError(t, errs["items"], "must not repeat an item")
+
+	_, err = NewMenu(dto.MenuDto{
+		Name:      "Happy hour",
+		StartTime: "17:00",
+		EndTime:   "19:00",
+		Items:     []dto.MenuItemDto{{ItemID: 1, Price: &price}},
+	})
+
+	assert.EqualError(t, err, "items: (0: (price: must be no less than 0.01.).).")
+}
+
+func TestMenuOpenAt(t *testing.T) {
+	breakfast := Menu{StartTime: "06:00", EndTime: "11:00"}
+
+	assert.False(t, breakfast.OpenAt(clockForTest("05:59")))
+	assert.True(t, breakfast.OpenAt(clockForTest("06:00")))
+	assert.True(t, breakfast.OpenAt(clockForTest("10:59")))
+	assert.False(t, breakfast.OpenAt(clockForTest("11:00")))
+}
+
+func TestMenuOpenAtGoesPastMidnight(t *testing.T) {
+	lateNight := Menu{StartTime: "22:00", EndTime: "02:00"}
+
+	assert.True(t, lateNight.OpenAt(clockForTest("23:30")))
+	assert.True(t, lateNight.OpenAt(clockForTest("01:59")))
+	assert.False(t, lateNight.OpenAt(clockForTest("02:00")))
+	assert.False(t, lateNight.OpenAt(clockForTest("12:00")))
+}
+
+func TestMenuValidateItemsReportsItemsNotFound(t *testing.T) {
+	menu := Menu{Items: []MenuItem{{ItemID: 1}, {ItemID: 9}}}
+
+	err := menu.ValidateItems([]Item{{ID: 1}})
+
+	assert.EqualError(t, err, "items: (1: (item_id: item 9 not found.).).")
+}
+
+func TestApplyMenusTakesItemOffSaleOutOfItsExclusiveMenus(t *testing.T) {
+	breakfast := &Menu{StoreID: 1, StartTime: "06:00", EndTime: "11:00", Exclusive: true}
+	item := Item{ID: 1, Price: 600, MenuItems: []MenuItem{{ItemID: 1, Menu: breakfast}}}
+
+	item.ApplyMenus(1, clockForTest("08:00"))
+	assert.True(t, item.OnSale())
+
+	item.ApplyMenus(1, clockForTest("15:00"))
+	assert.False(t, item.OnSale())
+}
+
+func TestApplyMenusTakesPausedItemOffSale(t *testing.T) {
+	item := Item{ID: 1, Price: 600, Paused: true}
+
+	item.ApplyMenus(1, clockForTest("08:00"))
+
+	assert.False(t, item.OnSale())
+}
+
+func TestApplyMenusChargesTheLowestPriceOfTheMenusOpen(t *testing.T) {
+	happyHourPrice, lateNightPrice, lunchPrice := Money(2200), Money(2000), Money(1800)
+	happyHour := &Menu{StoreID: 1, StartTime: "17:00", EndTime: "19:00"}
+	lateNight := &Menu{StoreID: 1, StartTime: "18:00", EndTime: "02:00"}
+	lunch := &Menu{StoreID: 1, StartTime: "11:00", EndTime: "14:00"}
+	item := Item{ID: 1, Price: 2800, MenuItems: []MenuItem{
+		{ItemID: 1, Price: &happyHourPrice, Menu: happyHour},
+		{ItemID: 1, Price: &lateNightPrice, Menu: lateNight},
+		{ItemID: 1, Price: &lunchPrice, Menu: lunch},
+	}}
+
+	item.ApplyMenus(1, clockForTest("18:30"))
+
+	assert.True(t, item.OnSale())
+	assert.Equal(t, Money(2000), item.Price)
+}
+
+func TestApplyMenusLeavesTheMenusOfOtherStoresAside(t *testing.T) {
+	otherStorePrice := Money(1990)
+	otherStoreBreakfast := &Menu{StoreID: 2, StartTime: "06:00", EndTime: "11:00", Exclusive: true}
+	otherStorePrices := &Menu{StoreID: 2, StartTime: "00:00", EndTime: "00:00"}
+	item := Item{ID: 1, Price: 2800, MenuItems: []MenuItem{
+		{ItemID: 1, Menu: otherStoreBreakfast},
+		{ItemID: 1, Price: &otherStorePrice, Menu: otherStorePrices},
+	}}
+
+	item.ApplyMenus(1, clockForTest("15:00"))
+
+	assert.True(t, item.OnSale())
+	assert.Equal(t, Money(2800), item.Price)
+}
+
+func TestSnapshotItemRejectsItemOffSale(t *testing.T) {
+	orderItem := OrderItem{ItemID: 1, Quantity: 1}
+
+	err := orderItem.SnapshotItem(Item{ID: 1, Price: 2800, offSale: true})
+
+	assert.EqualError(t, err, "id: item 1 is not on sale now.")
+}
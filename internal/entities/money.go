@@ -0,0 +1,186 @@
+package entities
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+// MONEY_CURRENCY is the currency of every amount of the franchise.
+const MONEY_CURRENCY = "BRL"
+
+// Money is an amount of MONEY_CURRENCY in cents, so prices, discounts and totals add up exactly. Amounts
+// are written to the database and to JSON as decimals with two places, such as 28.90. Amounts with more
+// places and the percentages and shares of amounts are rounded to the cent, half away from zero.
+type Money int64
+
+// NewMoney rounds an amount given as a decimal, such as the prices sent to the API, to the cent.
+func NewMoney(amount float64) Money {
+	return Money(math.Round(amount * 100))
+}
+
+// ParseMoney reads an amount written as a decimal, such as 28.9 or -2.50, rounding it to the cent.
+func ParseMoney(amount string) (Money, error) {
+	value, err := parseFixedPoint(amount, 2)
+	if err != nil {
+		return 0, fmt.Errorf("%q is not an amount", amount)
+	}
+
+	return Money(value), nil
+}
+
+// parseFixedPoint reads a decimal as a whole number of its given decimal places, rounding the places
+// left over half away from zero.
+func parseFixedPoint(decimal string, places int) (int64, error) {
+	text := strings.TrimSpace(decimal)
+	negative := strings.HasPrefix(text, "-")
+	text = strings.TrimPrefix(strings.TrimPrefix(text, "-"), "+")
+
+	units, fraction, _ := strings.Cut(text, ".")
+	if units == "" && fraction == "" {
+		return 0, fmt.Errorf("%q is not a decimal", decimal)
+	}
+
+	for _, digits := range []string{units, fraction} {
+		if strings.Trim(digits, "0123456789") != "" {
+			return 0, fmt.Errorf("%q is not a decimal", decimal)
+		}
+	}
+
+	roundUp := len(fraction) > places && fraction[places] >= '5'
+	fraction = (fraction + strings.Repeat("0", places))[:places]
+
+	value, err := strconv.ParseInt("0"+units+fraction, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%q is not a decimal", decimal)
+	}
+
+	if roundUp {
+		value++
+	}
+
+	if negative {
+		value = -value
+	}
+
+	return value, nil
+}
+
+func (money Money) Cents() int64 {
+	return int64(money)
+}
+
+// Float64 is the amount as a decimal, for the services that take amounts as numbers.
+func (money Money) Float64() float64 {
+	return float64(money) / 100
+}
+
+func (money Money) String() string {
+	sign := ""
+	cents := int64(money)
+	if cents < 0 {
+		sign, cents = "-", -cents
+	}
+
+	return fmt.Sprintf("%s%d.%02d", sign, cents/100, cents%100)
+}
+
+func (money Money) Times(quantity uint32) Money {
+	return money * Money(quantity)
+}
+
+// Percent is the percentage of the amount, rounded to the cent.
+func (money Money) Percent(percentage float32) Money {
+	return Money(math.Round(float64(money) * float64(percentage) / 100))
+}
+
+// Share is the part of the amount in the proportion of part to whole, rounded to the cent. Shares of the
+// same amount may not add up to it, so the last share is usually what is left of the amount.
+func (money Money) Share(part Money, whole Money) Money {
+	if whole == 0 {
+		return 0
+	}
+
+	return Money(math.Round(float64(money) * float64(part) / float64(whole)))
+}
+
+func (money Money) MarshalJSON() ([]byte, error) {
+	return []byte(money.String()), nil
+}
+
+func (money *Money) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	parsed, err := ParseMoney(strings.Trim(string(data), `"`))
+	if err != nil {
+		return err
+	}
+
+	*money = parsed
+	return nil
+}
+
+// Value writes the amount to the numeric columns as a decimal, so they keep the exact amount.
+func (money Money) Value() (driver.Value, error) {
+	return money.String(), nil
+}
+
+func (money *Money) Scan(value interface{}) (err error) {
+	switch value := value.(type) {
+	case nil:
+		*money = 0
+	case int64:
+		*money = Money(value * 100)
+	case float64:
+		*money = NewMoney(value)
+	case []byte:
+		*money, err = ParseMoney(string(value))
+	case string:
+		*money, err = ParseMoney(value)
+	default:
+		err = fmt.Errorf("cannot scan %T into an amount", value)
+	}
+
+	return err
+}
+
+// requiredMoney and minMoney take the place of validation.Required and validation.Min for amounts, which
+// those rules would look at as the decimal they are written to the database as.
+var requiredMoney = validation.By(func(value interface{}) error {
+	if amount, ok := moneyOf(value); ok && amount == 0 {
+		return validation.ErrRequired
+	}
+
+	return nil
+})
+
+func minMoney(min Money) validation.Rule {
+	return validation.By(func(value interface{}) error {
+		if amount, ok := moneyOf(value); ok && amount < min {
+			return validation.ErrMinGreaterEqualThanRequired.SetParams(map[string]interface{}{"threshold": min})
+		}
+
+		return nil
+	})
+}
+
+// moneyOf is the amount of a Money field, which is not ok for an optional amount left out.
+func moneyOf(value interface{}) (Money, bool) {
+	switch value := value.(type) {
+	case Money:
+		return value, true
+	case *Money:
+		if value == nil {
+			return 0, false
+		}
+		return *value, true
+	}
+
+	return 0, false
+}
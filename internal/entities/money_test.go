@@ -0,0 +1,103 @@
+package entities
+
+import (
+	"encoding/json"
+	"github.com/8soat-grupo35/fastfood-order/internal/adapters/dto"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNewMoneyRoundsToTheCent(t *testing.T) {
+	assert.Equal(t, Money(2890), NewMoney(28.9))
+	assert.Equal(t, Money(30), NewMoney(0.1+0.2))
+	assert.Equal(t, Money(1001), NewMoney(10.005))
+	assert.Equal(t, Money(-250), NewMoney(-2.5))
+}
+
+func TestParseMoney(t *testing.T) {
+	cases := map[string]Money{
+		"28.9":    2890,
+		"28.90":   2890,
+		"28":      2800,
+		".5":      50,
+		"-2.50":   -250,
+		"10.005":  1001,
+		"10.0049": 1000,
+		"-0.015":  -2,
+	}
+
+	for amount, expected := range cases {
+		parsed, err := ParseMoney(amount)
+		assert.NoError(t, err, amount)
+		assert.Equal(t, expected, parsed, amount)
+	}
+
+	for _, amount := range []string{"", "-", "R$ 10", "1e3", "10,50"} {
+		_, err := ParseMoney(amount)
+		assert.Error(t, err, amount)
+	}
+}
+
+func TestMoneyString(t *testing.T) {
+	assert.Equal(t, "28.90", Money(2890).String())
+	assert.Equal(t, "0.05", Money(5).String())
+	assert.Equal(t, "-2.50", Money(-250).String())
+}
+
+func TestMoneyAddsUpExactly(t *testing.T) {
+	var total Money
+	for i := 0; i < 10; i++ {
+		total += NewMoney(0.1)
+	}
+
+	assert.Equal(t, Money(100), total)
+	assert.Equal(t, Money(2370), Money(790).Times(3))
+}
+
+func TestMoneyPercentAndShareRoundHalfAwayFromZero(t *testing.T) {
+	assert.Equal(t, Money(257), Money(2570).Percent(10))
+	assert.Equal(t, Money(3), Money(5).Percent(50))
+	assert.Equal(t, Money(754), Money(1290).Share(2800, 4790))
+	assert.Equal(t, Money(0), Money(1290).Share(2800, 0))
+}
+
+func TestMoneyJSON(t *testing.T) {
+	price := Money(1990)
+	encoded, err := json.Marshal(MenuItem{ItemID: 1, Price: &price})
+	assert.NoError(t, err)
+	assert.Contains(t, string(encoded), `"price":19.90`)
+
+	var order Order
+	err = json.Unmarshal([]byte(`{"subtotal":10.1,"discount":"0.2","total":9.90}`), &order)
+	assert.NoError(t, err)
+	assert.Equal(t, Money(1010), order.Subtotal)
+	assert.Equal(t, Money(20), order.Discount)
+	assert.Equal(t, Money(990), order.Total)
+}
+
+func TestMoneyDatabaseValue(t *testing.T) {
+	value, err := Money(2890).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "28.90", value)
+
+	var money Money
+	for scanned, expected := range map[interface{}]Money{"28.9": 2890, "28.900000": 2890, int64(28): 2800, 28.9: 2890, nil: 0} {
+		assert.NoError(t, money.Scan(scanned))
+		assert.Equal(t, expected, money)
+	}
+	assert.NoError(t, money.Scan([]byte("7.90")))
+	assert.Equal(t, Money(790), money)
+
+	assert.Error(t, money.Scan(true))
+}
+
+func TestMoneyValidationComparesTheAmount(t *testing.T) {
+	_, err := NewItem(dto.ItemDto{Name: "Burger", Category: "LANCHE", Price: 0.004, ImageUrl: "http://image.com"})
+	assert.EqualError(t, err, "Price: cannot be blank.")
+
+	_, err = NewItem(dto.ItemDto{Name: "Burger", Category: "LANCHE", Price: -1, ImageUrl: "http://image.com"})
+	assert.EqualError(t, err, "Price: must be no less than 0.01.")
+
+	_, err = NewItem(dto.ItemDto{Name: "Burger", Category: "LANCHE", Price: 0.01, ImageUrl: "http://image.com"})
+	assert.NoError(t, err)
+}
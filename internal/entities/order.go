@@ -1,7 +1,10 @@
 package entities
 
 import (
+	"fmt"
 	"github.com/8soat-grupo35/fastfood-order/internal/adapters/dto"
+	"strconv"
+	"strings"
 	"time"
 
 	validation "github.com/go-ozzo/ozzo-validation/v4"
@@ -12,31 +15,107 @@ const (
 	IN_PREPARATION_STATUS = "EM_PREPARACAO"
 	DONE_STATUS           = "PRONTO"
 	FINISHED_STATUS       = "FINALIZADO"
+	CANCELED_STATUS       = "CANCELADO"
+)
+
+const (
+	PAYMENT_PENDING_STATUS  = "PENDENTE"
+	PAYMENT_APPROVED_STATUS = "APROVADO"
+	PAYMENT_REJECTED_STATUS = "RECUSADO"
+	PAYMENT_EXPIRED_STATUS  = "EXPIRADO"
+)
+
+const (
+	ORDER_ITEM_MAX_QUANTITY     = 20
+	ORDER_ITEM_NOTES_MAX_LENGTH = 140
+	ORDER_ITEM_MAX_MODIFIERS    = 10
 )
 
 type OrderItem struct {
 	ID       uint32 `gorm:"primarykey;autoIncrement" json:"-"`
 	OrderID  uint32 `json:"-"`
 	ItemID   uint32 `json:"id"`
-	Quantity uint32 `json:"quantity"`
-	Item     Item   `gorm:"references:ID" json:"-"`
+	ItemName string `gorm:"size:255" json:"item_name"`
+	// VariantID is required when the item has variants. The label is kept to show it after the variant changes.
+	VariantID    *uint32 `json:"variant_id,omitempty"`
+	VariantLabel string  `gorm:"size:30" json:"variant_label,omitempty"`
+	// UnitPrice is the item price plus the variant price delta and the price of the modifiers, for one unit.
+	UnitPrice Money               `gorm:"type:numeric(12,2);" json:"unit_price"`
+	Quantity  uint32              `json:"quantity"`
+	Notes     string              `gorm:"size:140" json:"notes,omitempty"`
+	Modifiers []OrderItemModifier `gorm:"foreignKey:OrderItemID;references:ID;constraint:OnDelete:CASCADE" json:"modifiers,omitempty"`
+	// ComboID is set on the lines of a combo. The lines of the same combo share the combo group, its
+	// position in the checkout, and the Discount is their share of the combo discount for the whole quantity.
+	ComboID    *uint32 `json:"combo_id,omitempty"`
+	ComboName  string  `gorm:"size:255" json:"combo_name,omitempty"`
+	ComboGroup uint32  `json:"combo_group,omitempty"`
+	Discount   Money   `gorm:"type:numeric(12,2);" json:"discount,omitempty"`
+	// FromRecipe is set on the lines of items made from a recipe, which take the ingredients of the recipe
+	// from the stock instead of units of the item.
+	FromRecipe bool `gorm:"not null;default:false" json:"-"`
+	Item       Item `gorm:"references:ID" json:"-"`
 } //@name domain.OrderItem
 
+// OrderItemModifier snapshots the add-on the customer asked for on an order line.
+type OrderItemModifier struct {
+	ID          uint32 `gorm:"primarykey;autoIncrement" json:"-"`
+	OrderItemID uint32 `json:"-"`
+	Name        string `gorm:"size:100" json:"name"`
+	Price       Money  `gorm:"type:numeric(12,2);" json:"price"`
+} //@name domain.OrderItemModifier
+
+// OrderCombo is a combo chosen at checkout. Its items are ordered in the quantity of the combo and
+// become order lines once the combo is expanded.
+type OrderCombo struct {
+	ComboID  uint32      `json:"id"`
+	Quantity uint32      `json:"quantity"`
+	Items    []OrderItem `json:"items"`
+}
+
 type Order struct {
-	ID         uint32      `gorm:"primarykey;autoIncrement" json:"id"`
-	Items      []OrderItem `gorm:"foreignKey:OrderID;references:ID;constraint:OnDelete:CASCADE" json:"items"`
-	CustomerID uint32      `json:"customer_id"`
-	Status     string      `json:"status"`
-	CreatedAt  time.Time   `json:"created_at"`
-	UpdatedAt  time.Time   `json:"updated_at"`
+	ID            uint32      `gorm:"primarykey;autoIncrement" json:"id"`
+	StoreID       uint32      `gorm:"not null" json:"store_id"`
+	TrackingCode  string      `gorm:"size:12" json:"tracking_code"`
+	PickupCode    string      `gorm:"size:20" json:"pickup_code"`
+	Items         []OrderItem `gorm:"foreignKey:OrderID;references:ID;constraint:OnDelete:CASCADE" json:"items"`
+	CustomerID    *uint32     `json:"customer_id"`
+	CustomerName  string      `gorm:"size:100" json:"customer_name,omitempty"`
+	Status        string      `json:"status"`
+	PaymentStatus string      `gorm:"size:50" json:"payment_status"`
+	Subtotal      Money       `gorm:"type:numeric(12,2);" json:"subtotal"`
+	Discount      Money       `gorm:"type:numeric(12,2);" json:"discount"`
+	// Discounts is the breakdown of the Discount by promotion.
+	Discounts            []OrderDiscount `gorm:"foreignKey:OrderID;references:ID;constraint:OnDelete:CASCADE" json:"discounts,omitempty"`
+	Total                Money           `gorm:"type:numeric(12,2);" json:"total"`
+	CanceledBy           string          `gorm:"size:255" json:"canceled_by,omitempty"`
+	CancellationReason   string          `gorm:"size:255" json:"cancellation_reason,omitempty"`
+	CanceledAt           *time.Time      `json:"canceled_at,omitempty"`
+	PreparationStartedAt *time.Time      `json:"preparation_started_at,omitempty"`
+	ReadyAt              *time.Time      `json:"ready_at,omitempty"`
+	CreatedAt            time.Time       `json:"created_at"`
+	UpdatedAt            time.Time       `json:"updated_at"`
+	// StatusChanges holds the transitions made since the order was loaded, until the repository stores them.
+	StatusChanges []OrderStatusHistory `gorm:"-" json:"-"`
+	// Combos holds the combos chosen at checkout until they are expanded into order lines.
+	Combos []OrderCombo `gorm:"-" json:"combos,omitempty"`
+	// Coupon is the coupon code given at checkout, kept in the discount breakdown once applied.
+	Coupon string `gorm:"-" json:"-"`
+	// Ingredients is what the order took from the stock of ingredients, given back if it is canceled.
+	Ingredients []OrderIngredient `gorm:"foreignKey:OrderID;references:ID;constraint:OnDelete:CASCADE" json:"-"`
+	// previousPaymentStatus is the payment status the order was loaded with, once ChangePaymentStatus changed it.
+	previousPaymentStatus string
 } //@name domain.Order
 
 func NewOrder(orderDto dto.OrderDto) (*Order, error) {
 	newOrder := Order{
-		CustomerID: orderDto.CustomerID,
-		Items:      OrderItemToDomain(orderDto),
-		Status:     RECEIVED_STATUS,
+		CustomerID:    orderDto.CustomerID,
+		CustomerName:  strings.TrimSpace(orderDto.CustomerName),
+		Items:         OrderItemToDomain(orderDto),
+		Combos:        OrderComboToDomain(orderDto),
+		Coupon:        NormalizeCouponCode(orderDto.Coupon),
+		PaymentStatus: PAYMENT_PENDING_STATUS,
 	}
+	newOrder.ChangeStatus(RECEIVED_STATUS, SYSTEM_ACTOR)
 
 	err := newOrder.Validate()
 
@@ -44,37 +123,497 @@ func NewOrder(orderDto dto.OrderDto) (*Order, error) {
 		return nil, err
 	}
 
+	newOrder.TrackingCode, err = NewTrackingCode()
+
+	if err != nil {
+		return nil, err
+	}
+
 	return &newOrder, err
 }
 
 func OrderItemToDomain(orderDto dto.OrderDto) (list []OrderItem) {
 
 	for _, orderItemDto := range orderDto.Items {
-		list = append(list, OrderItem{
-			ItemID:   orderItemDto.Id,
-			Quantity: orderItemDto.Quantity,
-		})
+		orderItem := OrderItem{
+			ItemID:    orderItemDto.Id,
+			VariantID: orderItemDto.VariantID,
+			Quantity:  orderItemDto.Quantity,
+			Notes:     strings.TrimSpace(orderItemDto.Notes),
+		}
+
+		for _, modifier := range orderItemDto.Modifiers {
+			orderItem.Modifiers = append(orderItem.Modifiers, OrderItemModifier{
+				Name: strings.TrimSpace(modifier),
+			})
+		}
+
+		list = append(list, orderItem)
 	}
 
 	return list
 }
 
+func OrderComboToDomain(orderDto dto.OrderDto) (list []OrderCombo) {
+	for _, orderComboDto := range orderDto.Combos {
+		orderCombo := OrderCombo{
+			ComboID:  orderComboDto.Id,
+			Quantity: orderComboDto.Quantity,
+		}
+
+		for _, comboItemDto := range orderComboDto.Items {
+			orderItem := OrderItem{
+				ItemID:    comboItemDto.Id,
+				VariantID: comboItemDto.VariantID,
+				Quantity:  orderComboDto.Quantity,
+				Notes:     strings.TrimSpace(comboItemDto.Notes),
+			}
+
+			for _, modifier := range comboItemDto.Modifiers {
+				orderItem.Modifiers = append(orderItem.Modifiers, OrderItemModifier{
+					Name: strings.TrimSpace(modifier),
+				})
+			}
+
+			orderCombo.Items = append(orderCombo.Items, orderItem)
+		}
+
+		list = append(list, orderCombo)
+	}
+
+	return list
+}
+
+func (order Order) IsGuest() bool {
+	return order.CustomerID == nil
+}
+
+// IdentifyCustomer uses the registered customer name to call out the order at pickup, unless a nickname was given.
+func (order *Order) IdentifyCustomer(customer Customer) {
+	if order.CustomerName == "" {
+		order.CustomerName = customer.Name
+	}
+}
+
+// SnapshotItem copies the catalog data that must not change after checkout into the order line, adding
+// the variant price delta and the price of the modifiers to the unit price. Items off sale are rejected,
+// and modifiers the item does not offer are reported by position.
+func (orderItem *OrderItem) SnapshotItem(item Item) error {
+	if !item.OnSale() {
+		return validation.Errors{
+			"id": fmt.Errorf("item %d is not on sale now", item.ID),
+		}
+	}
+
+	unitPrice := item.Price
+	lineErrors := validation.Errors{}
+
+	variant, err := orderItem.chooseVariant(item)
+	if err != nil {
+		lineErrors["variant_id"] = err
+	} else if variant != nil {
+		orderItem.VariantLabel = variant.Label
+		unitPrice += variant.PriceDelta
+	}
+
+	modifierErrors := validation.Errors{}
+	for i := range orderItem.Modifiers {
+		modifier, found := item.Modifier(orderItem.Modifiers[i].Name)
+		if !found {
+			modifierErrors[strconv.Itoa(i)] = fmt.Errorf("modifier %s is not available for item %d", orderItem.Modifiers[i].Name, item.ID)
+			continue
+		}
+
+		orderItem.Modifiers[i].Name = modifier.Name
+		orderItem.Modifiers[i].Price = modifier.Price
+		unitPrice += modifier.Price
+	}
+
+	if len(modifierErrors) > 0 {
+		lineErrors["modifiers"] = modifierErrors
+	}
+
+	if len(lineErrors) > 0 {
+		return lineErrors
+	}
+
+	orderItem.ItemName = item.Name
+	orderItem.UnitPrice = unitPrice
+
+	return nil
+}
+
+func (orderItem OrderItem) chooseVariant(item Item) (*ItemVariant, error) {
+	if orderItem.VariantID == nil {
+		if item.HasVariants() {
+			return nil, fmt.Errorf("must choose a variant of item %d", item.ID)
+		}
+		return nil, nil
+	}
+
+	variant, found := item.Variant(*orderItem.VariantID)
+	if !found {
+		return nil, fmt.Errorf("variant %d not found for item %d", *orderItem.VariantID, item.ID)
+	}
+
+	if !variant.Available {
+		return nil, fmt.Errorf("variant %s of item %d is not available", variant.Label, item.ID)
+	}
+
+	return &variant, nil
+}
+
+func (orderItem OrderItem) ModifierNames() (names []string) {
+	for _, modifier := range orderItem.Modifiers {
+		names = append(names, modifier.Name)
+	}
+
+	return names
+}
+
+func (orderItem OrderItem) Total() Money {
+	return orderItem.UnitPrice.Times(orderItem.Quantity) - orderItem.Discount
+}
+
+func (orderItem OrderItem) IsCombo() bool {
+	return orderItem.ComboID != nil
+}
+
+// ItemIDs lists the items of the order lines and of the combos chosen at checkout.
+func (order Order) ItemIDs() (ids []uint32) {
+	for _, orderItem := range order.Items {
+		ids = append(ids, orderItem.ItemID)
+	}
+
+	for _, orderCombo := range order.Combos {
+		ids = append(ids, orderCombo.ItemIDs()...)
+	}
+
+	return ids
+}
+
+// ComboIDs lists the combos of the order lines and the combos chosen at checkout.
+func (order Order) ComboIDs() (ids []uint32) {
+	for _, orderItem := range order.Items {
+		if orderItem.IsCombo() {
+			ids = append(ids, *orderItem.ComboID)
+		}
+	}
+
+	for _, orderCombo := range order.Combos {
+		ids = append(ids, orderCombo.ComboID)
+	}
+
+	return ids
+}
+
+func (orderCombo OrderCombo) ItemIDs() (ids []uint32) {
+	for _, orderItem := range orderCombo.Items {
+		ids = append(ids, orderItem.ItemID)
+	}
+
+	return ids
+}
+
+func (orderCombo OrderCombo) Validate() error {
+	return validation.ValidateStruct(
+		&orderCombo,
+		validation.Field(
+			&orderCombo.ComboID,
+			validation.Required,
+		),
+		validation.Field(
+			&orderCombo.Quantity,
+			validation.Required,
+			validation.Max(uint32(ORDER_ITEM_MAX_QUANTITY)),
+		),
+		validation.Field(
+			&orderCombo.Items,
+			validation.Required,
+		),
+	)
+}
+
+func (orderItem OrderItem) Validate() error {
+	return validation.ValidateStruct(
+		&orderItem,
+		validation.Field(
+			&orderItem.ItemID,
+			validation.Required,
+		),
+		validation.Field(
+			&orderItem.Quantity,
+			validation.Required,
+			validation.Max(uint32(ORDER_ITEM_MAX_QUANTITY)),
+		),
+		validation.Field(
+			&orderItem.Notes,
+			validation.Length(0, ORDER_ITEM_NOTES_MAX_LENGTH),
+		),
+		validation.Field(
+			&orderItem.Modifiers,
+			validation.Length(0, ORDER_ITEM_MAX_MODIFIERS),
+			validation.By(func(value interface{}) error {
+				return distinctNames(orderItem.ModifierNames(), "modifier")
+			}),
+		),
+	)
+}
+
+func (modifier OrderItemModifier) Validate() error {
+	return validation.ValidateStruct(
+		&modifier,
+		validation.Field(
+			&modifier.Name,
+			validation.Required,
+		),
+	)
+}
+
+// PriceItems snapshots the current catalog price of every order line and recalculates the order totals.
+// Lines whose item is not in the catalog are reported by their position in the order.
+func (order *Order) PriceItems(items []Item) error {
+	catalog := make(map[uint32]Item, len(items))
+	for _, item := range items {
+		catalog[item.ID] = item
+	}
+
+	lineErrors := validation.Errors{}
+	for i := range order.Items {
+		item, found := catalog[order.Items[i].ItemID]
+		if !found {
+			lineErrors[strconv.Itoa(i)] = validation.Errors{
+				"id": fmt.Errorf("item %d not found", order.Items[i].ItemID),
+			}
+			continue
+		}
+
+		if err := order.Items[i].SnapshotItem(item); err != nil {
+			lineErrors[strconv.Itoa(i)] = err
+		}
+	}
+
+	if len(lineErrors) > 0 {
+		return lineErrors
+	}
+
+	order.CalculateTotals()
+
+	return nil
+}
+
+// ExpandCombos adds the combos chosen at checkout to the order as priced lines and recalculates the
+// order totals. Combos not in the catalog and items that do not fill their slots are reported by the
+// position of the combo in the order.
+func (order *Order) ExpandCombos(combos []Combo, items []Item) error {
+	comboCatalog := make(map[uint32]Combo, len(combos))
+	for _, combo := range combos {
+		comboCatalog[combo.ID] = combo
+	}
+
+	itemCatalog := make(map[uint32]Item, len(items))
+	for _, item := range items {
+		itemCatalog[item.ID] = item
+	}
+
+	var lines []OrderItem
+	comboErrors := validation.Errors{}
+	for i, orderCombo := range order.Combos {
+		combo, found := comboCatalog[orderCombo.ComboID]
+		if !found {
+			comboErrors[strconv.Itoa(i)] = validation.Errors{
+				"id": fmt.Errorf("combo %d not found", orderCombo.ComboID),
+			}
+			continue
+		}
+
+		comboLines, err := combo.Expand(orderCombo, itemCatalog, uint32(i+1))
+		if err != nil {
+			comboErrors[strconv.Itoa(i)] = err
+			continue
+		}
+
+		lines = append(lines, comboLines...)
+	}
+
+	if len(comboErrors) > 0 {
+		return comboErrors
+	}
+
+	order.Items = append(order.Items, lines...)
+	order.Combos = nil
+	order.CalculateTotals()
+
+	return nil
+}
+
+func (order *Order) CalculateTotals() {
+	var subtotal Money
+	for _, orderItem := range order.Items {
+		subtotal += orderItem.Total()
+	}
+
+	order.Subtotal = subtotal
+	order.Total = max(order.Subtotal-order.Discount, 0)
+}
+
 func (order Order) Validate() error {
 	return validation.ValidateStruct(
 		&order,
 		validation.Field(
 			&order.Items,
 			validation.Required.When(len(order.Items) > 0).Error("must be one or more item"),
-			validation.Required,
+			validation.Required.When(len(order.Combos) == 0),
+		),
+		validation.Field(
+			&order.Combos,
 		),
 		validation.Field(
 			&order.CustomerID,
-			validation.Required,
+			validation.Required.When(order.CustomerName == "").Error("must be informed when the order has no customer name"),
+		),
+		validation.Field(
+			&order.CustomerName,
+			validation.Length(2, 100),
 		),
 		validation.Field(
 			&order.Status,
+			order.statusRules()...,
+		),
+	)
+}
+
+func (order Order) ValidateStatus() error {
+	return validation.ValidateStruct(
+		&order,
+		validation.Field(
+			&order.Status,
+			order.statusRules()...,
+		),
+	)
+}
+
+func (order Order) statusRules() []validation.Rule {
+	return []validation.Rule{
+		validation.Required,
+		validation.In(DONE_STATUS, IN_PREPARATION_STATUS, RECEIVED_STATUS, FINISHED_STATUS, CANCELED_STATUS),
+	}
+}
+
+func (order Order) ValidatePaymentStatus() error {
+	return validation.ValidateStruct(
+		&order,
+		validation.Field(
+			&order.PaymentStatus,
+			validation.Required,
+			validation.In(PAYMENT_APPROVED_STATUS, PAYMENT_REJECTED_STATUS, PAYMENT_EXPIRED_STATUS),
+		),
+	)
+}
+
+// CanChangePaymentStatusTo only allows a pending payment to be settled, except for repeated notifications of the same result.
+func (order Order) CanChangePaymentStatusTo(paymentStatus string) bool {
+	return order.PaymentStatus == PAYMENT_PENDING_STATUS || order.PaymentStatus == paymentStatus
+}
+
+// ChangePaymentStatus settles the payment of the order, keeping the payment status it was loaded with.
+func (order *Order) ChangePaymentStatus(paymentStatus string) {
+	if order.previousPaymentStatus == "" {
+		order.previousPaymentStatus = order.PaymentStatus
+	}
+
+	order.PaymentStatus = paymentStatus
+}
+
+// PreviousStatus and PreviousPaymentStatus are the statuses the order was loaded with, before the changes
+// not stored yet. The repository only stores the changes while the stored order still has them, so two
+// concurrent changes of the same order never overwrite each other.
+func (order Order) PreviousStatus() string {
+	if len(order.StatusChanges) > 0 {
+		return order.StatusChanges[0].PreviousStatus
+	}
+
+	return order.Status
+}
+
+func (order Order) PreviousPaymentStatus() string {
+	if order.previousPaymentStatus != "" {
+		return order.previousPaymentStatus
+	}
+
+	return order.PaymentStatus
+}
+
+func (order Order) IsPaid() bool {
+	return order.PaymentStatus == PAYMENT_APPROVED_STATUS
+}
+
+// IsOnKitchenBoard tells if the order is one the kitchen boards show: paid and not yet finished or canceled.
+func (order Order) IsOnKitchenBoard() bool {
+	return order.IsPaid() && KitchenPriority(order.Status) <= len(kitchenStatuses)
+}
+
+// IsPaymentFailed tells if the payment of the order was rejected or expired, leaving nothing to reverse.
+func (order Order) IsPaymentFailed() bool {
+	return order.PaymentStatus == PAYMENT_REJECTED_STATUS || order.PaymentStatus == PAYMENT_EXPIRED_STATUS
+}
+
+// ChangeStatus moves the order to the given status, recording when its preparation started and ended
+// and adding the transition to the status history. An empty changedBy is recorded as the system.
+func (order *Order) ChangeStatus(status string, changedBy string) {
+	changedAt := time.Now()
+
+	switch status {
+	case IN_PREPARATION_STATUS:
+		order.PreparationStartedAt = &changedAt
+	case DONE_STATUS:
+		order.ReadyAt = &changedAt
+	case CANCELED_STATUS:
+		order.CanceledAt = &changedAt
+	}
+
+	if changedBy == "" {
+		changedBy = SYSTEM_ACTOR
+	}
+
+	order.StatusChanges = append(order.StatusChanges, OrderStatusHistory{
+		OrderID:        order.ID,
+		PreviousStatus: order.Status,
+		Status:         status,
+		ChangedBy:      changedBy,
+		ChangedAt:      changedAt,
+	})
+	order.Status = status
+}
+
+func (order *Order) Cancel(canceledBy string, reason string) {
+	order.ChangeStatus(CANCELED_STATUS, canceledBy)
+	order.CanceledBy = canceledBy
+	order.CancellationReason = reason
+}
+
+// CancelUnpaid cancels the order whose payment was rejected or expired, on behalf of the system.
+func (order *Order) CancelUnpaid() {
+	reason := "payment rejected"
+	if order.PaymentStatus == PAYMENT_EXPIRED_STATUS {
+		reason = "payment expired"
+	}
+
+	order.Cancel(SYSTEM_ACTOR, reason)
+}
+
+func (order Order) ValidateCancellation() error {
+	return validation.ValidateStruct(
+		&order,
+		validation.Field(
+			&order.CanceledBy,
+			validation.Required,
+			validation.Length(3, 255),
+		),
+		validation.Field(
+			&order.CancellationReason,
 			validation.Required,
-			validation.In(DONE_STATUS, IN_PREPARATION_STATUS, RECEIVED_STATUS, FINISHED_STATUS),
+			validation.Length(3, 255),
 		),
 	)
 }
@@ -0,0 +1,34 @@
+package entities
+
+import "time"
+
+const (
+	ORDER_CREATED_EVENT                = "order.created"
+	ORDER_STATUS_CHANGED_EVENT         = "order.status_changed"
+	ORDER_PAYMENT_STATUS_CHANGED_EVENT = "order.payment_status_changed"
+	ORDER_SNAPSHOT_EVENT               = "orders.snapshot"
+)
+
+type OrderEvent struct {
+	Type          string    `json:"type"`
+	StoreID       uint32    `json:"store_id"`
+	OrderID       uint32    `json:"order_id"`
+	PickupCode    string    `json:"pickup_code"`
+	Status        string    `json:"status"`
+	PaymentStatus string    `json:"payment_status"`
+	CustomerName  string    `json:"customer_name,omitempty"`
+	OccurredAt    time.Time `json:"occurred_at"`
+} //@name domain.OrderEvent
+
+func NewOrderEvent(eventType string, order Order) OrderEvent {
+	return OrderEvent{
+		Type:          eventType,
+		StoreID:       order.StoreID,
+		OrderID:       order.ID,
+		PickupCode:    order.PickupCode,
+		Status:        order.Status,
+		PaymentStatus: order.PaymentStatus,
+		CustomerName:  order.CustomerName,
+		OccurredAt:    time.Now(),
+	}
+}
@@ -0,0 +1,19 @@
+package entities
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNewOrderEventCopiesOrderState(t *testing.T) {
+	order := Order{ID: 7, Status: IN_PREPARATION_STATUS, PaymentStatus: PAYMENT_APPROVED_STATUS, CustomerName: "Maria"}
+
+	event := NewOrderEvent(ORDER_STATUS_CHANGED_EVENT, order)
+
+	assert.Equal(t, ORDER_STATUS_CHANGED_EVENT, event.Type)
+	assert.Equal(t, uint32(7), event.OrderID)
+	assert.Equal(t, IN_PREPARATION_STATUS, event.Status)
+	assert.Equal(t, PAYMENT_APPROVED_STATUS, event.PaymentStatus)
+	assert.Equal(t, "Maria", event.CustomerName)
+	assert.False(t, event.OccurredAt.IsZero())
+}
@@ -0,0 +1,227 @@
+package entities
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/8soat-grupo35/fastfood-order/internal/adapters/dto"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+const (
+	DEFAULT_ORDER_PAGE_SIZE = 20
+	MAX_ORDER_PAGE_SIZE     = 100
+)
+
+// kitchenStatuses are the statuses shown on the kitchen board, in the order it lists them.
+var kitchenStatuses = []string{DONE_STATUS, IN_PREPARATION_STATUS, RECEIVED_STATUS}
+
+// OrderFilter selects the orders of a store to list. Without statuses only the paid orders still on the
+// kitchen board are listed. CreatedTo is exclusive and a zero Limit lists every matching order.
+type OrderFilter struct {
+	StoreID     uint32       `json:"store_id"`
+	Statuses    []string     `json:"status"`
+	CustomerID  *uint32      `json:"customer_id"`
+	CreatedFrom *time.Time   `json:"created_from"`
+	CreatedTo   *time.Time   `json:"created_to"`
+	PickupCode  string       `json:"pickup_code"`
+	After       *OrderCursor `json:"cursor"`
+	Limit       int          `json:"limit"`
+}
+
+// OrderCursor points at the last order of a page, in the kitchen board ordering.
+type OrderCursor struct {
+	Priority  int       `json:"p"`
+	CreatedAt time.Time `json:"c"`
+	ID        uint32    `json:"i"`
+}
+
+type OrderPage struct {
+	Orders     []Order
+	NextCursor string
+}
+
+// NewOrderFilter reads the filter from the query parameters. Dates without a time are whole days in
+// the store time zone, so created_to=2024-05-10 includes every order of that day.
+func NewOrderFilter(filterDto dto.OrderFilterDto, location *time.Location) (*OrderFilter, error) {
+	if location == nil {
+		location = time.UTC
+	}
+
+	filter := OrderFilter{
+		PickupCode: strings.ToUpper(strings.TrimSpace(filterDto.PickupCode)),
+		Limit:      filterDto.Limit,
+	}
+
+	for _, status := range filterDto.Status {
+		for _, value := range strings.Split(status, ",") {
+			if value = strings.ToUpper(strings.TrimSpace(value)); value != "" {
+				filter.Statuses = append(filter.Statuses, value)
+			}
+		}
+	}
+
+	if filterDto.CustomerID != 0 {
+		filter.CustomerID = &filterDto.CustomerID
+	}
+
+	errs := validation.Errors{}
+
+	if filterDto.CreatedFrom != "" {
+		createdFrom, _, err := parseFilterDate(filterDto.CreatedFrom, location)
+		if err != nil {
+			errs["created_from"] = err
+		}
+		filter.CreatedFrom = createdFrom
+	}
+
+	if filterDto.CreatedTo != "" {
+		createdTo, wholeDay, err := parseFilterDate(filterDto.CreatedTo, location)
+		if err != nil {
+			errs["created_to"] = err
+		} else if wholeDay {
+			nextDay := createdTo.AddDate(0, 0, 1)
+			createdTo = &nextDay
+		}
+		filter.CreatedTo = createdTo
+	}
+
+	if filterDto.Cursor != "" {
+		cursor, err := DecodeOrderCursor(filterDto.Cursor)
+		if err != nil {
+			errs["cursor"] = err
+		}
+		filter.After = cursor
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	if filter.Limit == 0 && !filter.IsWholeKitchenBoard() {
+		filter.Limit = DEFAULT_ORDER_PAGE_SIZE
+	}
+
+	err := filter.Validate()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &filter, nil
+}
+
+func parseFilterDate(value string, location *time.Location) (*time.Time, bool, error) {
+	date, err := time.ParseInLocation(time.DateOnly, value, location)
+	if err == nil {
+		return &date, true, nil
+	}
+
+	date, err = time.Parse(time.RFC3339, value)
+	if err == nil {
+		return &date, false, nil
+	}
+
+	return nil, false, errors.New("must be a date (YYYY-MM-DD) or a RFC 3339 timestamp")
+}
+
+func (filter OrderFilter) Validate() error {
+	return validation.ValidateStruct(
+		&filter,
+		validation.Field(
+			&filter.Statuses,
+			validation.Each(validation.In(DONE_STATUS, IN_PREPARATION_STATUS, RECEIVED_STATUS, FINISHED_STATUS, CANCELED_STATUS)),
+		),
+		validation.Field(
+			&filter.CreatedTo,
+			validation.By(func(value interface{}) error {
+				if filter.CreatedFrom != nil && filter.CreatedTo != nil && !filter.CreatedTo.After(*filter.CreatedFrom) {
+					return errors.New("must be after created_from")
+				}
+				return nil
+			}),
+		),
+		validation.Field(
+			&filter.Limit,
+			validation.Min(1),
+			validation.Max(MAX_ORDER_PAGE_SIZE),
+		),
+	)
+}
+
+func (filter OrderFilter) IsKitchenBoard() bool {
+	return len(filter.Statuses) == 0
+}
+
+// IsWholeKitchenBoard tells whether the filter is the kitchen board without any other filter or page.
+// The boards list it whole, as they did before the listing had pages.
+func (filter OrderFilter) IsWholeKitchenBoard() bool {
+	return filter.IsKitchenBoard() &&
+		filter.CustomerID == nil &&
+		filter.CreatedFrom == nil &&
+		filter.CreatedTo == nil &&
+		filter.PickupCode == "" &&
+		filter.After == nil &&
+		filter.Limit == 0
+}
+
+// KitchenPriority is the position of the status on the kitchen board. Statuses that are not shown
+// there come last.
+func KitchenPriority(status string) int {
+	for i, kitchenStatus := range kitchenStatuses {
+		if status == kitchenStatus {
+			return i + 1
+		}
+	}
+
+	return len(kitchenStatuses) + 1
+}
+
+func NewOrderCursor(order Order) OrderCursor {
+	return OrderCursor{
+		Priority:  KitchenPriority(order.Status),
+		CreatedAt: order.CreatedAt,
+		ID:        order.ID,
+	}
+}
+
+func (cursor OrderCursor) Encode() string {
+	payload, _ := json.Marshal(cursor)
+
+	return base64.RawURLEncoding.EncodeToString(payload)
+}
+
+func DecodeOrderCursor(value string) (*OrderCursor, error) {
+	invalidCursor := errors.New("is not a valid cursor")
+
+	payload, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, invalidCursor
+	}
+
+	cursor := OrderCursor{}
+	if err = json.Unmarshal(payload, &cursor); err != nil || cursor.ID == 0 {
+		return nil, invalidCursor
+	}
+
+	return &cursor, nil
+}
+
+// NewOrderPage trims the orders to the page size. The repository is asked for one order more than the
+// page size, so an extra order means there is a next page, which starts after the last order kept. A zero
+// page size keeps every order.
+func NewOrderPage(orders []Order, pageSize int) OrderPage {
+	if pageSize == 0 || len(orders) <= pageSize {
+		return OrderPage{Orders: orders}
+	}
+
+	orders = orders[:pageSize]
+
+	return OrderPage{
+		Orders:     orders,
+		NextCursor: NewOrderCursor(orders[pageSize-1]).Encode(),
+	}
+}
@@ -0,0 +1,97 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/8soat-grupo35/fastfood-order/internal/adapters/dto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewOrderFilterDefaultsToKitchenBoard(t *testing.T) {
+	filter, err := NewOrderFilter(dto.OrderFilterDto{}, time.UTC)
+
+	assert.NoError(t, err)
+	assert.True(t, filter.IsKitchenBoard())
+	assert.True(t, filter.IsWholeKitchenBoard())
+	assert.Equal(t, 0, filter.Limit)
+	assert.Nil(t, filter.CustomerID)
+}
+
+func TestNewOrderFilterPagesFilteredKitchenBoard(t *testing.T) {
+	filter, err := NewOrderFilter(dto.OrderFilterDto{PickupCode: "A-042"}, time.UTC)
+
+	assert.NoError(t, err)
+	assert.True(t, filter.IsKitchenBoard())
+	assert.False(t, filter.IsWholeKitchenBoard())
+	assert.Equal(t, DEFAULT_ORDER_PAGE_SIZE, filter.Limit)
+}
+
+func TestNewOrderFilterReadsWholeDaysInStoreTimeZone(t *testing.T) {
+	location, _ := time.LoadLocation("America/Sao_Paulo")
+
+	filter, err := NewOrderFilter(dto.OrderFilterDto{
+		Status:      []string{"finalizado,CANCELADO"},
+		CreatedFrom: "2024-05-10",
+		CreatedTo:   "2024-05-10",
+		PickupCode:  "a-042",
+	}, location)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{FINISHED_STATUS, CANCELED_STATUS}, filter.Statuses)
+	assert.Equal(t, time.Date(2024, 5, 10, 3, 0, 0, 0, time.UTC), filter.CreatedFrom.UTC())
+	assert.Equal(t, time.Date(2024, 5, 11, 3, 0, 0, 0, time.UTC), filter.CreatedTo.UTC())
+	assert.Equal(t, "A-042", filter.PickupCode)
+}
+
+func TestNewOrderFilterReturnsErrorOnInvalidParameters(t *testing.T) {
+	_, err := NewOrderFilter(dto.OrderFilterDto{
+		Status:      []string{"INVALID_STATUS"},
+		CreatedFrom: "yesterday",
+		Cursor:      "not a cursor",
+		Limit:       MAX_ORDER_PAGE_SIZE + 1,
+	}, time.UTC)
+
+	assert.ErrorContains(t, err, "created_from")
+	assert.ErrorContains(t, err, "cursor")
+
+	_, err = NewOrderFilter(dto.OrderFilterDto{Status: []string{"INVALID_STATUS"}, Limit: MAX_ORDER_PAGE_SIZE + 1}, time.UTC)
+
+	assert.ErrorContains(t, err, "status")
+	assert.ErrorContains(t, err, "limit")
+}
+
+func TestNewOrderFilterReturnsErrorOnInvertedDateRange(t *testing.T) {
+	_, err := NewOrderFilter(dto.OrderFilterDto{CreatedFrom: "2024-05-10", CreatedTo: "2024-05-09"}, time.UTC)
+
+	assert.ErrorContains(t, err, "created_to")
+}
+
+func TestOrderCursorRoundTrip(t *testing.T) {
+	order := Order{ID: 7, Status: IN_PREPARATION_STATUS, CreatedAt: time.Date(2024, 5, 10, 12, 30, 0, 123456000, time.UTC)}
+
+	cursor, err := DecodeOrderCursor(NewOrderCursor(order).Encode())
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, cursor.Priority)
+	assert.Equal(t, uint32(7), cursor.ID)
+	assert.True(t, order.CreatedAt.Equal(cursor.CreatedAt))
+}
+
+func TestKitchenPriorityListsOtherStatusesLast(t *testing.T) {
+	assert.Equal(t, 1, KitchenPriority(DONE_STATUS))
+	assert.Equal(t, 3, KitchenPriority(RECEIVED_STATUS))
+	assert.Equal(t, 4, KitchenPriority(FINISHED_STATUS))
+}
+
+func TestNewOrderPage(t *testing.T) {
+	orders := []Order{{ID: 1}, {ID: 2}, {ID: 3}}
+
+	page := NewOrderPage(orders, 2)
+	assert.Len(t, page.Orders, 2)
+	assert.NotEmpty(t, page.NextCursor)
+
+	lastPage := NewOrderPage(orders, 3)
+	assert.Len(t, lastPage.Orders, 3)
+	assert.Empty(t, lastPage.NextCursor)
+}
@@ -0,0 +1,26 @@
+package entities
+
+import "errors"
+
+// ErrOrderChanged is returned when the order was changed by someone else since it was loaded, such as a
+// cancellation racing the kitchen moving the order to preparation.
+var ErrOrderChanged = errors.New("order was changed since it was loaded")
+
+// orderStatusTransitions lists, for each status, the statuses an order may move to next.
+var orderStatusTransitions = map[string][]string{
+	RECEIVED_STATUS:       {IN_PREPARATION_STATUS, CANCELED_STATUS},
+	IN_PREPARATION_STATUS: {DONE_STATUS, CANCELED_STATUS},
+	DONE_STATUS:           {FINISHED_STATUS},
+	FINISHED_STATUS:       {},
+	CANCELED_STATUS:       {},
+}
+
+func (order Order) CanTransitionTo(status string) bool {
+	for _, allowedStatus := range orderStatusTransitions[order.Status] {
+		if allowedStatus == status {
+			return true
+		}
+	}
+
+	return false
+}
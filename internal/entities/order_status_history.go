@@ -0,0 +1,19 @@
+package entities
+
+import "time"
+
+// SYSTEM_ACTOR is recorded for the status changes that were not made by a person, such as the checkout.
+const SYSTEM_ACTOR = "system"
+
+type OrderStatusHistory struct {
+	ID             uint32    `gorm:"primarykey;autoIncrement" json:"-"`
+	OrderID        uint32    `json:"order_id"`
+	PreviousStatus string    `gorm:"size:50" json:"previous_status,omitempty"`
+	Status         string    `gorm:"size:50" json:"status"`
+	ChangedBy      string    `gorm:"size:255" json:"changed_by"`
+	ChangedAt      time.Time `json:"changed_at"`
+} //@name domain.OrderStatusHistory
+
+func (OrderStatusHistory) TableName() string {
+	return "order_status_history"
+}
@@ -0,0 +1,51 @@
+package entities
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestCanTransitionToAllowsNextStatus(t *testing.T) {
+	assert.True(t, Order{Status: RECEIVED_STATUS}.CanTransitionTo(IN_PREPARATION_STATUS))
+	assert.True(t, Order{Status: IN_PREPARATION_STATUS}.CanTransitionTo(DONE_STATUS))
+	assert.True(t, Order{Status: DONE_STATUS}.CanTransitionTo(FINISHED_STATUS))
+}
+
+func TestCanTransitionToRejectsSkippedStatus(t *testing.T) {
+	assert.False(t, Order{Status: RECEIVED_STATUS}.CanTransitionTo(FINISHED_STATUS))
+	assert.False(t, Order{Status: RECEIVED_STATUS}.CanTransitionTo(DONE_STATUS))
+}
+
+func TestCanTransitionToRejectsBackwardStatus(t *testing.T) {
+	assert.False(t, Order{Status: DONE_STATUS}.CanTransitionTo(RECEIVED_STATUS))
+	assert.False(t, Order{Status: FINISHED_STATUS}.CanTransitionTo(DONE_STATUS))
+}
+
+func TestCanTransitionToAllowsCancellationBeforeDone(t *testing.T) {
+	assert.True(t, Order{Status: RECEIVED_STATUS}.CanTransitionTo(CANCELED_STATUS))
+	assert.True(t, Order{Status: IN_PREPARATION_STATUS}.CanTransitionTo(CANCELED_STATUS))
+}
+
+func TestCanTransitionToRejectsCancellationAfterDone(t *testing.T) {
+	assert.False(t, Order{Status: DONE_STATUS}.CanTransitionTo(CANCELED_STATUS))
+	assert.False(t, Order{Status: FINISHED_STATUS}.CanTransitionTo(CANCELED_STATUS))
+	assert.False(t, Order{Status: CANCELED_STATUS}.CanTransitionTo(RECEIVED_STATUS))
+}
+
+func TestCanTransitionToRejectsSameStatus(t *testing.T) {
+	assert.False(t, Order{Status: IN_PREPARATION_STATUS}.CanTransitionTo(IN_PREPARATION_STATUS))
+}
+
+func TestPreviousStatusesAreTheOnesTheOrderWasLoadedWith(t *testing.T) {
+	order := Order{Status: RECEIVED_STATUS, PaymentStatus: PAYMENT_PENDING_STATUS}
+	assert.Equal(t, RECEIVED_STATUS, order.PreviousStatus())
+	assert.Equal(t, PAYMENT_PENDING_STATUS, order.PreviousPaymentStatus())
+
+	order.ChangePaymentStatus(PAYMENT_APPROVED_STATUS)
+	order.ChangeStatus(IN_PREPARATION_STATUS, "cozinha")
+	order.ChangeStatus(DONE_STATUS, "cozinha")
+
+	assert.Equal(t, RECEIVED_STATUS, order.PreviousStatus())
+	assert.Equal(t, PAYMENT_PENDING_STATUS, order.PreviousPaymentStatus())
+	assert.Equal(t, PAYMENT_APPROVED_STATUS, order.PaymentStatus)
+}
@@ -3,13 +3,16 @@ package entities
 import (
 	"github.com/8soat-grupo35/fastfood-order/internal/adapters/dto"
 	"github.com/stretchr/testify/assert"
+	"strings"
 	"testing"
 	"time"
 )
 
+var registeredCustomerID = uint32(1)
+
 func TestNewOrderCreatesValidOrder(t *testing.T) {
 	orderDto := dto.OrderDto{
-		CustomerID: 1,
+		CustomerID: &registeredCustomerID,
 		Items: []dto.OrderItemDto{
 			{Id: 1, Quantity: 2},
 		},
@@ -19,8 +22,9 @@ func TestNewOrderCreatesValidOrder(t *testing.T) {
 
 	assert.NoError(t, err)
 	assert.NotNil(t, order)
-	assert.Equal(t, uint32(1), order.CustomerID)
+	assert.Equal(t, &registeredCustomerID, order.CustomerID)
 	assert.Equal(t, RECEIVED_STATUS, order.Status)
+	assert.Len(t, order.TrackingCode, TRACKING_CODE_LENGTH)
 	assert.Len(t, order.Items, 1)
 	assert.Equal(t, uint32(1), order.Items[0].ItemID)
 	assert.Equal(t, uint32(2), order.Items[0].Quantity)
@@ -28,8 +32,7 @@ func TestNewOrderCreatesValidOrder(t *testing.T) {
 
 func TestNewOrderReturnsErrorForInvalidOrder(t *testing.T) {
 	orderDto := dto.OrderDto{
-		CustomerID: 0,
-		Items:      []dto.OrderItemDto{},
+		Items: []dto.OrderItemDto{},
 	}
 
 	order, err := NewOrder(orderDto)
@@ -38,9 +41,34 @@ func TestNewOrderReturnsErrorForInvalidOrder(t *testing.T) {
 	assert.Nil(t, order)
 }
 
+func TestNewOrderCreatesGuestOrderWithCustomerName(t *testing.T) {
+	orderDto := dto.OrderDto{
+		CustomerName: "  Maria ",
+		Items: []dto.OrderItemDto{
+			{Id: 1, Quantity: 1},
+		},
+	}
+
+	order, err := NewOrder(orderDto)
+
+	assert.NoError(t, err)
+	assert.True(t, order.IsGuest())
+	assert.Equal(t, "Maria", order.CustomerName)
+}
+
+func TestIdentifyCustomerKeepsGivenNickname(t *testing.T) {
+	order := Order{CustomerID: &registeredCustomerID}
+	order.IdentifyCustomer(Customer{Name: "John Doe"})
+	assert.Equal(t, "John Doe", order.CustomerName)
+
+	order = Order{CustomerID: &registeredCustomerID, CustomerName: "Johnny"}
+	order.IdentifyCustomer(Customer{Name: "John Doe"})
+	assert.Equal(t, "Johnny", order.CustomerName)
+}
+
 func TestValidateReturnsErrorForInvalidStatus(t *testing.T) {
 	order := Order{
-		CustomerID: 1,
+		CustomerID: &registeredCustomerID,
 		Items: []OrderItem{
 			{ItemID: 1, Quantity: 2},
 		},
@@ -54,7 +82,6 @@ func TestValidateReturnsErrorForInvalidStatus(t *testing.T) {
 
 func TestValidateReturnsErrorForMissingCustomerID(t *testing.T) {
 	order := Order{
-		CustomerID: 0,
 		Items: []OrderItem{
 			{ItemID: 1, Quantity: 2},
 		},
@@ -68,7 +95,7 @@ func TestValidateReturnsErrorForMissingCustomerID(t *testing.T) {
 
 func TestValidateReturnsErrorForEmptyItems(t *testing.T) {
 	order := Order{
-		CustomerID: 1,
+		CustomerID: &registeredCustomerID,
 		Items:      []OrderItem{},
 		Status:     RECEIVED_STATUS,
 	}
@@ -80,7 +107,7 @@ func TestValidateReturnsErrorForEmptyItems(t *testing.T) {
 
 func TestValidateReturnsNoErrorForValidOrder(t *testing.T) {
 	order := Order{
-		CustomerID: 1,
+		CustomerID: &registeredCustomerID,
 		Items: []OrderItem{
 			{ItemID: 1, Quantity: 2},
 		},
@@ -93,3 +120,294 @@ func TestValidateReturnsNoErrorForValidOrder(t *testing.T) {
 
 	assert.NoError(t, err)
 }
+
+func TestValidateStatusReturnsErrorForUnknownStatus(t *testing.T) {
+	order := Order{Status: "INVALID_STATUS"}
+
+	err := order.ValidateStatus()
+
+	assert.Error(t, err)
+}
+
+func TestValidateStatusReturnsNoErrorForKnownStatus(t *testing.T) {
+	order := Order{Status: DONE_STATUS}
+
+	err := order.ValidateStatus()
+
+	assert.NoError(t, err)
+}
+
+func TestChangeStatusRecordsPreparationTimes(t *testing.T) {
+	order := Order{Status: RECEIVED_STATUS}
+
+	order.ChangeStatus(IN_PREPARATION_STATUS, "cozinha")
+	assert.Equal(t, IN_PREPARATION_STATUS, order.Status)
+	assert.NotNil(t, order.PreparationStartedAt)
+	assert.Nil(t, order.ReadyAt)
+
+	order.ChangeStatus(DONE_STATUS, "cozinha")
+	assert.Equal(t, DONE_STATUS, order.Status)
+	assert.NotNil(t, order.ReadyAt)
+}
+
+func TestChangeStatusRecordsStatusHistory(t *testing.T) {
+	order := Order{Status: RECEIVED_STATUS}
+
+	order.ChangeStatus(IN_PREPARATION_STATUS, "cozinha")
+	order.ChangeStatus(DONE_STATUS, "")
+
+	assert.Len(t, order.StatusChanges, 2)
+	assert.Equal(t, RECEIVED_STATUS, order.StatusChanges[0].PreviousStatus)
+	assert.Equal(t, IN_PREPARATION_STATUS, order.StatusChanges[0].Status)
+	assert.Equal(t, "cozinha", order.StatusChanges[0].ChangedBy)
+	assert.Equal(t, IN_PREPARATION_STATUS, order.StatusChanges[1].PreviousStatus)
+	assert.Equal(t, SYSTEM_ACTOR, order.StatusChanges[1].ChangedBy)
+}
+
+func TestCancelSetsCancellationData(t *testing.T) {
+	order := Order{Status: RECEIVED_STATUS}
+
+	order.Cancel("atendente", "cliente desistiu")
+
+	assert.Equal(t, CANCELED_STATUS, order.Status)
+	assert.Equal(t, "atendente", order.CanceledBy)
+	assert.Equal(t, "cliente desistiu", order.CancellationReason)
+	assert.NotNil(t, order.CanceledAt)
+}
+
+func TestCancelUnpaidCancelsOnBehalfOfTheSystem(t *testing.T) {
+	order := Order{Status: RECEIVED_STATUS, PaymentStatus: PAYMENT_PENDING_STATUS}
+
+	order.ChangePaymentStatus(PAYMENT_EXPIRED_STATUS)
+	order.CancelUnpaid()
+
+	assert.True(t, order.IsPaymentFailed())
+	assert.Equal(t, CANCELED_STATUS, order.Status)
+	assert.Equal(t, SYSTEM_ACTOR, order.CanceledBy)
+	assert.Equal(t, "payment expired", order.CancellationReason)
+	assert.NoError(t, order.ValidateCancellation())
+}
+
+func TestIsOnKitchenBoardTakesOnlyPaidOrdersInTheKitchen(t *testing.T) {
+	assert.True(t, Order{Status: RECEIVED_STATUS, PaymentStatus: PAYMENT_APPROVED_STATUS}.IsOnKitchenBoard())
+	assert.True(t, Order{Status: DONE_STATUS, PaymentStatus: PAYMENT_APPROVED_STATUS}.IsOnKitchenBoard())
+	assert.False(t, Order{Status: RECEIVED_STATUS, PaymentStatus: PAYMENT_PENDING_STATUS}.IsOnKitchenBoard())
+	assert.False(t, Order{Status: CANCELED_STATUS, PaymentStatus: PAYMENT_APPROVED_STATUS}.IsOnKitchenBoard())
+	assert.False(t, Order{Status: FINISHED_STATUS, PaymentStatus: PAYMENT_APPROVED_STATUS}.IsOnKitchenBoard())
+}
+
+func TestValidateCancellationReturnsErrorForMissingReason(t *testing.T) {
+	order := Order{CanceledBy: "atendente"}
+
+	err := order.ValidateCancellation()
+
+	assert.Error(t, err)
+}
+
+func TestValidateCancellationReturnsNoErrorForValidCancellation(t *testing.T) {
+	order := Order{CanceledBy: "atendente", CancellationReason: "cliente desistiu"}
+
+	err := order.ValidateCancellation()
+
+	assert.NoError(t, err)
+}
+
+func TestPriceItemsSnapshotsItemsAndCalculatesTotals(t *testing.T) {
+	order := Order{
+		Items: []OrderItem{
+			{ItemID: 1, Quantity: 2},
+			{ItemID: 2, Quantity: 1},
+		},
+		Discount: 500,
+	}
+	items := []Item{
+		{ID: 1, Name: "X-Burguer", Price: 2850},
+		{ID: 2, Name: "Refrigerante", Price: 790},
+	}
+
+	err := order.PriceItems(items)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "X-Burguer", order.Items[0].ItemName)
+	assert.Equal(t, Money(2850), order.Items[0].UnitPrice)
+	assert.Equal(t, Money(6490), order.Subtotal)
+	assert.Equal(t, Money(5990), order.Total)
+}
+
+func TestPriceItemsReturnsErrorForUnknownItem(t *testing.T) {
+	order := Order{
+		Items: []OrderItem{
+			{ItemID: 3, Quantity: 1},
+		},
+	}
+
+	err := order.PriceItems([]Item{{ID: 1, Price: 1000}})
+
+	assert.Error(t, err)
+	assert.Equal(t, "0: (id: item 3 not found.).", err.Error())
+}
+
+func TestValidateReturnsErrorForInvalidQuantities(t *testing.T) {
+	order := Order{
+		Items: []OrderItem{
+			{ItemID: 1, Quantity: 0},
+			{ItemID: 2, Quantity: ORDER_ITEM_MAX_QUANTITY + 1},
+		},
+		CustomerID: &registeredCustomerID,
+		Status:     RECEIVED_STATUS,
+	}
+
+	err := order.Validate()
+
+	assert.Error(t, err)
+	assert.Equal(t, "items: (0: (quantity: cannot be blank.); 1: (quantity: must be no greater than 20.).).", err.Error())
+}
+
+func TestValidateReturnsErrorForInvalidNotesAndModifiers(t *testing.T) {
+	order := Order{
+		Items: []OrderItem{
+			{ItemID: 1, Quantity: 1, Notes: strings.Repeat("a", ORDER_ITEM_NOTES_MAX_LENGTH+1)},
+			{ItemID: 2, Quantity: 1, Modifiers: []OrderItemModifier{{Name: "Bacon extra"}, {Name: "bacon EXTRA"}}},
+		},
+		CustomerID: &registeredCustomerID,
+		Status:     RECEIVED_STATUS,
+	}
+
+	err := order.Validate()
+
+	assert.Error(t, err)
+	assert.Equal(t, "items: (0: (notes: the length must be no more than 140.); 1: (modifiers: must not repeat a modifier.).).", err.Error())
+}
+
+func TestNewOrderKeepsNotesAndModifiers(t *testing.T) {
+	orderDto := dto.OrderDto{
+		CustomerID: &registeredCustomerID,
+		Items: []dto.OrderItemDto{
+			{Id: 1, Quantity: 1, Notes: "  sem cebola ", Modifiers: []string{" Bacon extra"}},
+		},
+	}
+
+	order, err := NewOrder(orderDto)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "sem cebola", order.Items[0].Notes)
+	assert.Equal(t, []OrderItemModifier{{Name: "Bacon extra"}}, order.Items[0].Modifiers)
+}
+
+func TestPriceItemsAddsModifierPricesToTheLine(t *testing.T) {
+	order := Order{
+		Items: []OrderItem{
+			{ItemID: 1, Quantity: 2, Modifiers: []OrderItemModifier{{Name: "bacon extra"}, {Name: "Sem cebola"}}},
+		},
+	}
+	items := []Item{
+		{ID: 1, Name: "X-Burguer", Price: 2850, Modifiers: []ItemModifier{
+			{Name: "Bacon extra", Price: 450},
+			{Name: "Sem cebola", Price: 0},
+		}},
+	}
+
+	err := order.PriceItems(items)
+
+	assert.NoError(t, err)
+	assert.Equal(t, Money(3300), order.Items[0].UnitPrice)
+	assert.Equal(t, OrderItemModifier{Name: "Bacon extra", Price: 450}, order.Items[0].Modifiers[0])
+	assert.Equal(t, Money(6600), order.Total)
+}
+
+func TestPriceItemsAddsVariantPriceDelta(t *testing.T) {
+	largeID := uint32(3)
+	order := Order{
+		Items: []OrderItem{
+			{ItemID: 1, VariantID: &largeID, Quantity: 2},
+		},
+	}
+	items := []Item{
+		{ID: 1, Name: "Refrigerante", Price: 790, Variants: []ItemVariant{
+			{ID: 2, Label: "M", Available: true},
+			{ID: 3, Label: "G", PriceDelta: 250, Available: true},
+		}},
+	}
+
+	err := order.PriceItems(items)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Refrigerante", order.Items[0].ItemName)
+	assert.Equal(t, "G", order.Items[0].VariantLabel)
+	assert.Equal(t, Money(1040), order.Items[0].UnitPrice)
+	assert.Equal(t, Money(2080), order.Total)
+}
+
+func TestPriceItemsReturnsErrorForMissingOrUnavailableVariant(t *testing.T) {
+	smallID := uint32(1)
+	order := Order{
+		Items: []OrderItem{
+			{ItemID: 1, Quantity: 1},
+			{ItemID: 1, VariantID: &smallID, Quantity: 1},
+		},
+	}
+	items := []Item{
+		{ID: 1, Price: 790, Variants: []ItemVariant{{ID: 1, Label: "P", Available: false}}},
+	}
+
+	err := order.PriceItems(items)
+
+	assert.Error(t, err)
+	assert.Equal(t, "0: (variant_id: must choose a variant of item 1.); 1: (variant_id: variant P of item 1 is not available.).", err.Error())
+}
+
+func TestPriceItemsReturnsErrorForModifierNotOffered(t *testing.T) {
+	order := Order{
+		Items: []OrderItem{
+			{ItemID: 1, Quantity: 1, Modifiers: []OrderItemModifier{{Name: "Cheddar"}}},
+		},
+	}
+
+	err := order.PriceItems([]Item{{ID: 1, Price: 1000}})
+
+	assert.Error(t, err)
+	assert.Equal(t, "0: (modifiers: (0: modifier Cheddar is not available for item 1.).).", err.Error())
+}
+
+func TestCalculateTotalsDoesNotReturnNegativeTotal(t *testing.T) {
+	order := Order{
+		Items: []OrderItem{
+			{ItemID: 1, Quantity: 1, UnitPrice: 1000},
+		},
+		Discount: 1500,
+	}
+
+	order.CalculateTotals()
+
+	assert.Equal(t, Money(1000), order.Subtotal)
+	assert.Equal(t, Money(0), order.Total)
+}
+
+func TestNewOrderStartsWithPendingPayment(t *testing.T) {
+	orderDto := dto.OrderDto{
+		CustomerID: &registeredCustomerID,
+		Items: []dto.OrderItemDto{
+			{Id: 1, Quantity: 1},
+		},
+	}
+
+	order, err := NewOrder(orderDto)
+
+	assert.NoError(t, err)
+	assert.Equal(t, PAYMENT_PENDING_STATUS, order.PaymentStatus)
+	assert.False(t, order.IsPaid())
+}
+
+func TestValidatePaymentStatusReturnsErrorForUnknownStatus(t *testing.T) {
+	order := Order{PaymentStatus: PAYMENT_PENDING_STATUS}
+
+	err := order.ValidatePaymentStatus()
+
+	assert.Error(t, err)
+}
+
+func TestCanChangePaymentStatusToOnlyFromPending(t *testing.T) {
+	assert.True(t, Order{PaymentStatus: PAYMENT_PENDING_STATUS}.CanChangePaymentStatusTo(PAYMENT_APPROVED_STATUS))
+	assert.True(t, Order{PaymentStatus: PAYMENT_APPROVED_STATUS}.CanChangePaymentStatusTo(PAYMENT_APPROVED_STATUS))
+	assert.False(t, Order{PaymentStatus: PAYMENT_REJECTED_STATUS}.CanChangePaymentStatusTo(PAYMENT_APPROVED_STATUS))
+}
@@ -0,0 +1,100 @@
+package entities
+
+import (
+	"crypto/rand"
+	"math/big"
+	"time"
+)
+
+const (
+	TRACKING_CODE_LENGTH = 8
+	// DEFAULT_PREPARATION_TIME is used for the estimates until the kitchen has finished orders to learn from.
+	DEFAULT_PREPARATION_TIME = 10 * time.Minute
+)
+
+// trackingCodeAlphabet leaves out characters that are easily confused when read from a receipt, such as 0/O and 1/I.
+const trackingCodeAlphabet = "23456789ABCDEFGHJKMNPQRSTUVWXYZ"
+
+type OrderTracking struct {
+	TrackingCode     string
+	PickupCode       string
+	Status           string
+	PaymentStatus    string
+	CustomerName     string
+	OrdersAhead      *int
+	EstimatedReadyAt *time.Time
+}
+
+func NewTrackingCode() (string, error) {
+	code := make([]byte, TRACKING_CODE_LENGTH)
+	alphabetSize := big.NewInt(int64(len(trackingCodeAlphabet)))
+
+	for i := range code {
+		index, err := rand.Int(rand.Reader, alphabetSize)
+		if err != nil {
+			return "", err
+		}
+
+		code[i] = trackingCodeAlphabet[index.Int64()]
+	}
+
+	return string(code), nil
+}
+
+// NewOrderTracking places the order in the kitchen queue, as listed by the kitchen board, counting the
+// paid orders that still have to be prepared before it. The ready time assumes the kitchen prepares the
+// orders ahead one after another, each taking the average preparation time.
+func NewOrderTracking(order Order, queue []Order, averagePreparationTime time.Duration, now time.Time) OrderTracking {
+	tracking := OrderTracking{
+		TrackingCode:  order.TrackingCode,
+		PickupCode:    order.PickupCode,
+		Status:        order.Status,
+		PaymentStatus: order.PaymentStatus,
+		CustomerName:  order.CustomerName,
+	}
+
+	if averagePreparationTime <= 0 {
+		averagePreparationTime = DEFAULT_PREPARATION_TIME
+	}
+
+	switch {
+	case order.Status == DONE_STATUS:
+		ordersAhead := 0
+		tracking.OrdersAhead = &ordersAhead
+		tracking.EstimatedReadyAt = order.ReadyAt
+	case order.Status == IN_PREPARATION_STATUS && order.PreparationStartedAt != nil:
+		ordersAhead := countOrdersAhead(order, queue)
+		tracking.OrdersAhead = &ordersAhead
+		estimatedReadyAt := latest(order.PreparationStartedAt.Add(averagePreparationTime), now)
+		tracking.EstimatedReadyAt = &estimatedReadyAt
+	case (order.Status == RECEIVED_STATUS || order.Status == IN_PREPARATION_STATUS) && order.IsPaid():
+		ordersAhead := countOrdersAhead(order, queue)
+		tracking.OrdersAhead = &ordersAhead
+		estimatedReadyAt := now.Add(averagePreparationTime * time.Duration(ordersAhead+1))
+		tracking.EstimatedReadyAt = &estimatedReadyAt
+	}
+
+	return tracking
+}
+
+func countOrdersAhead(order Order, queue []Order) (ordersAhead int) {
+	for _, queuedOrder := range queue {
+		if queuedOrder.ID == order.ID {
+			break
+		}
+
+		if queuedOrder.Status == RECEIVED_STATUS || queuedOrder.Status == IN_PREPARATION_STATUS {
+			ordersAhead++
+		}
+	}
+
+	return ordersAhead
+}
+
+func latest(first time.Time, second time.Time) time.Time {
+	if first.After(second) {
+		return first
+	}
+
+	return second
+}
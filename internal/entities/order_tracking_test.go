@@ -0,0 +1,65 @@
+package entities
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestNewTrackingCodeUsesReadableAlphabet(t *testing.T) {
+	code, err := NewTrackingCode()
+
+	assert.NoError(t, err)
+	assert.Len(t, code, TRACKING_CODE_LENGTH)
+	for _, character := range code {
+		assert.Contains(t, trackingCodeAlphabet, string(character))
+	}
+}
+
+func TestNewOrderTrackingCountsOrdersAheadOfReceivedOrder(t *testing.T) {
+	now := time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC)
+	order := Order{ID: 4, TrackingCode: "ABCD2345", Status: RECEIVED_STATUS, PaymentStatus: PAYMENT_APPROVED_STATUS}
+	queue := []Order{
+		{ID: 1, Status: DONE_STATUS},
+		{ID: 2, Status: IN_PREPARATION_STATUS},
+		{ID: 3, Status: RECEIVED_STATUS},
+		order,
+		{ID: 5, Status: RECEIVED_STATUS},
+	}
+
+	tracking := NewOrderTracking(order, queue, 5*time.Minute, now)
+
+	assert.Equal(t, "ABCD2345", tracking.TrackingCode)
+	assert.Equal(t, 2, *tracking.OrdersAhead)
+	assert.Equal(t, now.Add(15*time.Minute), *tracking.EstimatedReadyAt)
+}
+
+func TestNewOrderTrackingUsesPreparationStartForOrderInPreparation(t *testing.T) {
+	now := time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC)
+	startedAt := now.Add(-2 * time.Minute)
+	order := Order{ID: 1, Status: IN_PREPARATION_STATUS, PaymentStatus: PAYMENT_APPROVED_STATUS, PreparationStartedAt: &startedAt}
+
+	tracking := NewOrderTracking(order, []Order{order}, 0, now)
+
+	assert.Equal(t, 0, *tracking.OrdersAhead)
+	assert.Equal(t, startedAt.Add(DEFAULT_PREPARATION_TIME), *tracking.EstimatedReadyAt)
+}
+
+func TestNewOrderTrackingDoesNotEstimateUnpaidOrder(t *testing.T) {
+	order := Order{ID: 1, Status: RECEIVED_STATUS, PaymentStatus: PAYMENT_PENDING_STATUS}
+
+	tracking := NewOrderTracking(order, nil, time.Minute, time.Now())
+
+	assert.Nil(t, tracking.OrdersAhead)
+	assert.Nil(t, tracking.EstimatedReadyAt)
+}
+
+func TestNewOrderTrackingReturnsReadyTimeOfDoneOrder(t *testing.T) {
+	readyAt := time.Date(2024, 5, 10, 11, 50, 0, 0, time.UTC)
+	order := Order{ID: 1, Status: DONE_STATUS, PaymentStatus: PAYMENT_APPROVED_STATUS, ReadyAt: &readyAt}
+
+	tracking := NewOrderTracking(order, nil, time.Minute, time.Now())
+
+	assert.Equal(t, 0, *tracking.OrdersAhead)
+	assert.Equal(t, readyAt, *tracking.EstimatedReadyAt)
+}
@@ -0,0 +1,102 @@
+package entities
+
+import (
+	"encoding/json"
+	"github.com/8soat-grupo35/fastfood-order/internal/adapters/dto"
+	"math"
+	"time"
+)
+
+const (
+	OUTBOX_PENDING_STATUS = "PENDENTE"
+	OUTBOX_SENT_STATUS    = "ENVIADO"
+	OUTBOX_FAILED_STATUS  = "FALHA"
+)
+
+const (
+	PAYMENT_REQUESTED_EVENT = "payment.requested"
+	PAYMENT_REVERSAL_EVENT  = "payment.reversal"
+)
+
+const (
+	OUTBOX_MAX_ATTEMPTS   = 10
+	OUTBOX_MAX_RETRY_WAIT = 5 * time.Minute
+)
+
+type OutboxMessage struct {
+	ID            uint32     `gorm:"primarykey;autoIncrement" json:"id"`
+	EventType     string     `gorm:"size:50;not null" json:"event_type"`
+	AggregateID   uint32     `gorm:"not null" json:"aggregate_id"`
+	Payload       string     `gorm:"type:text;not null" json:"payload"`
+	Status        string     `gorm:"size:20;not null" json:"status"`
+	Attempts      uint32     `gorm:"not null" json:"attempts"`
+	LastError     string     `gorm:"size:255" json:"last_error"`
+	NextAttemptAt time.Time  `gorm:"not null" json:"next_attempt_at"`
+	SentAt        *time.Time `json:"sent_at"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+} //@name domain.OutboxMessage
+
+func NewOutboxMessage(eventType string, aggregateID uint32, payload interface{}) (*OutboxMessage, error) {
+	payloadData, err := json.Marshal(payload)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &OutboxMessage{
+		EventType:     eventType,
+		AggregateID:   aggregateID,
+		Payload:       string(payloadData),
+		Status:        OUTBOX_PENDING_STATUS,
+		NextAttemptAt: time.Now(),
+	}, nil
+}
+
+func NewPaymentRequestedMessage(order Order) (*OutboxMessage, error) {
+	return NewOutboxMessage(PAYMENT_REQUESTED_EVENT, order.ID, dto.OrderPaymentDto{
+		OrderID: int(order.ID),
+		Amount:  order.Total.Float64(),
+	})
+}
+
+func NewPaymentReversalMessage(order Order) (*OutboxMessage, error) {
+	return NewOutboxMessage(PAYMENT_REVERSAL_EVENT, order.ID, dto.OrderPaymentDto{
+		OrderID: int(order.ID),
+	})
+}
+
+func (message *OutboxMessage) MarkSent() {
+	sentAt := time.Now()
+
+	message.Status = OUTBOX_SENT_STATUS
+	message.Attempts++
+	message.LastError = ""
+	message.SentAt = &sentAt
+}
+
+// MarkFailed schedules the next delivery with exponential backoff, giving up after OUTBOX_MAX_ATTEMPTS.
+func (message *OutboxMessage) MarkFailed(deliveryError error) {
+	message.Attempts++
+	message.LastError = deliveryError.Error()
+	if len(message.LastError) > 255 {
+		message.LastError = message.LastError[:255]
+	}
+
+	if message.Attempts >= OUTBOX_MAX_ATTEMPTS {
+		message.Status = OUTBOX_FAILED_STATUS
+		return
+	}
+
+	message.NextAttemptAt = time.Now().Add(message.retryWait())
+}
+
+func (message OutboxMessage) retryWait() time.Duration {
+	wait := time.Duration(math.Pow(2, float64(message.Attempts))) * time.Second
+
+	if wait > OUTBOX_MAX_RETRY_WAIT {
+		return OUTBOX_MAX_RETRY_WAIT
+	}
+
+	return wait
+}
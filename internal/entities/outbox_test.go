@@ -0,0 +1,63 @@
+package entities
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestNewPaymentRequestedMessageSerializesPayment(t *testing.T) {
+	order := Order{ID: 7, Total: 5600}
+
+	message, err := NewPaymentRequestedMessage(order)
+
+	assert.NoError(t, err)
+	assert.Equal(t, PAYMENT_REQUESTED_EVENT, message.EventType)
+	assert.Equal(t, uint32(7), message.AggregateID)
+	assert.Equal(t, `{"orderId":7,"amount":56}`, message.Payload)
+	assert.Equal(t, OUTBOX_PENDING_STATUS, message.Status)
+}
+
+func TestNewPaymentReversalMessageSerializesOrder(t *testing.T) {
+	order := Order{ID: 7, Total: 5600}
+
+	message, err := NewPaymentReversalMessage(order)
+
+	assert.NoError(t, err)
+	assert.Equal(t, PAYMENT_REVERSAL_EVENT, message.EventType)
+	assert.Equal(t, uint32(7), message.AggregateID)
+	assert.Equal(t, `{"orderId":7,"amount":0}`, message.Payload)
+	assert.Equal(t, OUTBOX_PENDING_STATUS, message.Status)
+}
+
+func TestMarkSentRecordsDelivery(t *testing.T) {
+	message := OutboxMessage{Status: OUTBOX_PENDING_STATUS, LastError: "timeout"}
+
+	message.MarkSent()
+
+	assert.Equal(t, OUTBOX_SENT_STATUS, message.Status)
+	assert.Equal(t, uint32(1), message.Attempts)
+	assert.Empty(t, message.LastError)
+	assert.NotNil(t, message.SentAt)
+}
+
+func TestMarkFailedSchedulesRetry(t *testing.T) {
+	message := OutboxMessage{Status: OUTBOX_PENDING_STATUS, Attempts: 2}
+
+	message.MarkFailed(errors.New("unexpected status code: 500"))
+
+	assert.Equal(t, OUTBOX_PENDING_STATUS, message.Status)
+	assert.Equal(t, uint32(3), message.Attempts)
+	assert.Equal(t, "unexpected status code: 500", message.LastError)
+	assert.WithinDuration(t, time.Now().Add(8*time.Second), message.NextAttemptAt, time.Second)
+}
+
+func TestMarkFailedGivesUpAfterMaxAttempts(t *testing.T) {
+	message := OutboxMessage{Status: OUTBOX_PENDING_STATUS, Attempts: OUTBOX_MAX_ATTEMPTS - 1}
+
+	message.MarkFailed(errors.New("connection refused"))
+
+	assert.Equal(t, OUTBOX_FAILED_STATUS, message.Status)
+	assert.Equal(t, uint32(OUTBOX_MAX_ATTEMPTS), message.Attempts)
+}
@@ -0,0 +1,26 @@
+package entities
+
+import (
+	"fmt"
+	"time"
+)
+
+// PickupCodeFormat describes the short codes called out at the counter, which restart from 1 every
+// day in the store time zone.
+type PickupCodeFormat struct {
+	Prefix   string
+	Location *time.Location
+}
+
+func (format PickupCodeFormat) BusinessDate(now time.Time) string {
+	location := format.Location
+	if location == nil {
+		location = time.UTC
+	}
+
+	return now.In(location).Format(time.DateOnly)
+}
+
+func (format PickupCodeFormat) Code(number int) string {
+	return fmt.Sprintf("%s-%03d", format.Prefix, number)
+}
@@ -0,0 +1,24 @@
+package entities
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestPickupCodeFormatPadsNumber(t *testing.T) {
+	format := PickupCodeFormat{Prefix: "A"}
+
+	assert.Equal(t, "A-007", format.Code(7))
+	assert.Equal(t, "A-042", format.Code(42))
+	assert.Equal(t, "A-1234", format.Code(1234))
+}
+
+func TestPickupCodeFormatUsesStoreTimeZoneForBusinessDate(t *testing.T) {
+	location := time.FixedZone("BRT", -3*60*60)
+	format := PickupCodeFormat{Prefix: "A", Location: location}
+	now := time.Date(2024, 5, 11, 1, 30, 0, 0, time.UTC)
+
+	assert.Equal(t, "2024-05-10", format.BusinessDate(now))
+	assert.Equal(t, "2024-05-11", PickupCodeFormat{Prefix: "A"}.BusinessDate(now))
+}
@@ -0,0 +1,326 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"github.com/8soat-grupo35/fastfood-order/internal/adapters/dto"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"gorm.io/gorm"
+)
+
+const (
+	PROMOTION_CATEGORY_PERCENTAGE = "PERCENTUAL_CATEGORIA"
+	PROMOTION_BUY_X_GET_Y         = "LEVE_X_GANHE_Y"
+	PROMOTION_FIXED_AMOUNT        = "VALOR_FIXO"
+)
+
+var couponCodePattern = regexp.MustCompile(`^[A-Z0-9_-]+$`)
+
+// ErrPromotionRunOut is returned when the last use of a promotion was taken by another order during the checkout.
+var ErrPromotionRunOut = errors.New("promotion has run out")
+
+// ErrPromotionAlreadyUsed is returned when another checkout of the customer used up a promotion limited per customer.
+var ErrPromotionAlreadyUsed = errors.New("promotion has already been used by the customer")
+
+// Promotion is a discount of a store applied at checkout, by itself or, for coupons, when their code is given.
+type Promotion struct {
+	ID      uint32 `gorm:"primary_key;auto_increment" json:"id"`
+	StoreID uint32 `gorm:"not null;" json:"-"`
+	Name    string `gorm:"size:100;not null;" json:"name"`
+	// Code is the coupon code, unique in the store and empty for automatic promotions.
+	Code string `gorm:"size:30;not null;default:''" json:"code,omitempty"`
+	Kind string `gorm:"size:30;not null;" json:"kind"`
+	// Category is the item category discounted by the PERCENTUAL_CATEGORIA and LEVE_X_GANHE_Y promotions.
+	Category      string  `gorm:"size:30" json:"category,omitempty"`
+	PercentageOff float32 `json:"percentage_off,omitempty"`
+	// BuyQuantity and FreeQuantity make the cheapest FreeQuantity of every group of units of the category free.
+	BuyQuantity    uint32 `json:"buy_quantity,omitempty"`
+	FreeQuantity   uint32 `json:"free_quantity,omitempty"`
+	Amount         Money  `gorm:"type:numeric(12,2);" json:"amount,omitempty"`
+	MinimumTotal   Money  `gorm:"type:numeric(12,2);" json:"minimum_total,omitempty"`
+	FirstOrderOnly bool   `json:"first_order_only"`
+	// StartsAt and the exclusive EndsAt optionally limit when the promotion is valid.
+	StartsAt *time.Time `json:"starts_at,omitempty"`
+	EndsAt   *time.Time `json:"ends_at,omitempty"`
+	// UsageLimit and PerCustomerLimit cap the uses of the promotion overall and per customer, zero meaning unlimited.
+	UsageLimit       uint32         `json:"usage_limit,omitempty"`
+	PerCustomerLimit uint32         `json:"per_customer_limit,omitempty"`
+	TimesUsed        uint32         `gorm:"not null;default:0" json:"times_used"`
+	CreatedAt        time.Time      `json:"created_at"`
+	UpdatedAt        time.Time      `json:"updated_at"`
+	DeletedAt        gorm.DeletedAt `gorm:"index" json:"-"`
+} //@name domain.Promotion
+
+// OrderDiscount records how much a promotion took off the order.
+type OrderDiscount struct {
+	ID            uint32 `gorm:"primarykey;autoIncrement" json:"-"`
+	OrderID       uint32 `json:"-"`
+	PromotionID   uint32 `json:"promotion_id"`
+	PromotionName string `gorm:"size:100" json:"promotion_name"`
+	Code          string `gorm:"size:30" json:"code,omitempty"`
+	Amount        Money  `gorm:"type:numeric(12,2);" json:"amount"`
+	// FirstOrderOnly and PerCustomerLimit are copied from the promotion to check its limits again on storing.
+	FirstOrderOnly   bool   `gorm:"-" json:"-"`
+	PerCustomerLimit uint32 `gorm:"-" json:"-"`
+} //@name domain.OrderDiscount
+
+// PromotionHistory is what the customer of the order already did, for the promotions limited per customer.
+type PromotionHistory struct {
+	CustomerOrders int64
+	Redemptions    map[uint32]int64
+}
+
+func NewPromotion(promotionDto dto.PromotionDto) (*Promotion, error) {
+	newPromotion := Promotion{
+		Name:             strings.TrimSpace(promotionDto.Name),
+		Code:             NormalizeCouponCode(promotionDto.Code),
+		Kind:             strings.ToUpper(strings.TrimSpace(promotionDto.Kind)),
+		Category:         strings.ToUpper(strings.TrimSpace(promotionDto.Category)),
+		PercentageOff:    promotionDto.PercentageOff,
+		BuyQuantity:      promotionDto.BuyQuantity,
+		FreeQuantity:     promotionDto.FreeQuantity,
+		Amount:           NewMoney(promotionDto.Amount),
+		MinimumTotal:     NewMoney(promotionDto.MinimumTotal),
+		FirstOrderOnly:   promotionDto.FirstOrderOnly,
+		StartsAt:         promotionDto.StartsAt,
+		EndsAt:           promotionDto.EndsAt,
+		UsageLimit:       promotionDto.UsageLimit,
+		PerCustomerLimit: promotionDto.PerCustomerLimit,
+	}
+
+	err := newPromotion.Validate()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &newPromotion, nil
+}
+
+func NormalizeCouponCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+func (promotion Promotion) Validate() error {
+	byCategory := promotion.Kind == PROMOTION_CATEGORY_PERCENTAGE || promotion.Kind == PROMOTION_BUY_X_GET_Y
+
+	return validation.ValidateStruct(
+		&promotion,
+		validation.Field(
+			&promotion.Name,
+			validation.Required,
+			validation.Length(3, 100),
+		),
+		validation.Field(
+			&promotion.Code,
+			validation.Length(3, 30),
+			validation.Match(couponCodePattern).Error("must have only letters, digits, - and _"),
+		),
+		validation.Field(
+			&promotion.Kind,
+			validation.Required,
+			validation.In(PROMOTION_CATEGORY_PERCENTAGE, PROMOTION_BUY_X_GET_Y, PROMOTION_FIXED_AMOUNT).Error("must be a valid value between (percentual_categoria,leve_x_ganhe_y,valor_fixo)"),
+		),
+		validation.Field(
+			&promotion.Category,
+			validation.Required.When(byCategory),
+		),
+		validation.Field(
+			&promotion.PercentageOff,
+			validation.Required.When(promotion.Kind == PROMOTION_CATEGORY_PERCENTAGE),
+			validation.Min(float32(0)),
+			validation.Max(float32(100)),
+		),
+		validation.Field(
+			&promotion.BuyQuantity,
+			validation.Required.When(promotion.Kind == PROMOTION_BUY_X_GET_Y),
+		),
+		validation.Field(
+			&promotion.FreeQuantity,
+			validation.Required.When(promotion.Kind == PROMOTION_BUY_X_GET_Y),
+		),
+		validation.Field(
+			&promotion.Amount,
+			validation.When(promotion.Kind == PROMOTION_FIXED_AMOUNT, requiredMoney),
+			minMoney(0),
+		),
+		validation.Field(
+			&promotion.MinimumTotal,
+			minMoney(0),
+		),
+		validation.Field(
+			&promotion.EndsAt,
+			validation.By(func(value interface{}) error {
+				if promotion.StartsAt != nil && promotion.EndsAt != nil && !promotion.EndsAt.After(*promotion.StartsAt) {
+					return errors.New("must be after starts_at")
+				}
+				return nil
+			}),
+		),
+	)
+}
+
+// ValidateCategory checks the category of the promotion, if it has one, is registered.
+func (promotion Promotion) ValidateCategory(category *Category) error {
+	if promotion.Category != "" && category == nil {
+		return validation.Errors{"category": ErrCategoryNotRegistered}
+	}
+
+	return nil
+}
+
+func (promotion Promotion) IsCoupon() bool {
+	return promotion.Code != ""
+}
+
+// LimitedPerCustomer tells if the promotion depends on the earlier orders of the customer.
+func (promotion Promotion) LimitedPerCustomer() bool {
+	return promotion.FirstOrderOnly || promotion.PerCustomerLimit > 0
+}
+
+// DiscountFor is how much the promotion takes off the order, or why it does not apply to it.
+func (promotion Promotion) DiscountFor(order Order, items map[uint32]Item, history PromotionHistory, now time.Time) (Money, error) {
+	if (promotion.StartsAt != nil && now.Before(*promotion.StartsAt)) || (promotion.EndsAt != nil && !now.Before(*promotion.EndsAt)) {
+		return 0, errors.New("is not valid now")
+	}
+
+	if promotion.UsageLimit > 0 && promotion.TimesUsed >= promotion.UsageLimit {
+		return 0, errors.New("has run out")
+	}
+
+	if promotion.LimitedPerCustomer() {
+		if order.IsGuest() {
+			return 0, errors.New("requires an identified customer")
+		}
+
+		if promotion.FirstOrderOnly && history.CustomerOrders > 0 {
+			return 0, errors.New("is only valid on the first order")
+		}
+
+		if promotion.PerCustomerLimit > 0 && history.Redemptions[promotion.ID] >= int64(promotion.PerCustomerLimit) {
+			return 0, errors.New("has already been used the most times allowed per customer")
+		}
+	}
+
+	if order.Subtotal < promotion.MinimumTotal {
+		return 0, fmt.Errorf("requires a subtotal of at least %s", promotion.MinimumTotal)
+	}
+
+	var discount Money
+	switch promotion.Kind {
+	case PROMOTION_CATEGORY_PERCENTAGE:
+		var categoryTotal Money
+		for _, orderItem := range promotion.categoryLines(order, items) {
+			categoryTotal += orderItem.Total()
+		}
+		discount = categoryTotal.Percent(promotion.PercentageOff)
+	case PROMOTION_BUY_X_GET_Y:
+		discount = promotion.freeUnitsPrice(promotion.categoryLines(order, items))
+	case PROMOTION_FIXED_AMOUNT:
+		discount = promotion.Amount
+	}
+
+	discount = min(discount, order.Subtotal)
+	if discount <= 0 {
+		return 0, errors.New("does not apply to the items of the order")
+	}
+
+	return discount, nil
+}
+
+func (promotion Promotion) categoryLines(order Order, items map[uint32]Item) (lines []OrderItem) {
+	for _, orderItem := range order.Items {
+		if !orderItem.IsCombo() && items[orderItem.ItemID].Category == promotion.Category {
+			lines = append(lines, orderItem)
+		}
+	}
+
+	return lines
+}
+
+func (promotion Promotion) freeUnitsPrice(lines []OrderItem) Money {
+	var unitPrices []Money
+	for _, orderItem := range lines {
+		for i := uint32(0); i < orderItem.Quantity; i++ {
+			unitPrices = append(unitPrices, orderItem.UnitPrice)
+		}
+	}
+
+	sort.Slice(unitPrices, func(i, j int) bool { return unitPrices[i] < unitPrices[j] })
+
+	groupSize := int(promotion.BuyQuantity + promotion.FreeQuantity)
+	freeUnits := len(unitPrices) / groupSize * int(promotion.FreeQuantity)
+
+	var price Money
+	for _, unitPrice := range unitPrices[:freeUnits] {
+		price += unitPrice
+	}
+
+	return price
+}
+
+// ApplyPromotions records the discount of every promotion that fits the order and recalculates its totals.
+func (order *Order) ApplyPromotions(promotions []Promotion, items []Item, history PromotionHistory, now time.Time) error {
+	catalog := make(map[uint32]Item, len(items))
+	for _, item := range items {
+		catalog[item.ID] = item
+	}
+
+	order.Discounts = nil
+	var discount Money
+	for _, promotion := range promotions {
+		amount, err := promotion.DiscountFor(*order, catalog, history, now)
+		if err != nil {
+			if promotion.IsCoupon() {
+				return validation.Errors{"coupon": err}
+			}
+			continue
+		}
+
+		order.Discounts = append(order.Discounts, OrderDiscount{
+			PromotionID:      promotion.ID,
+			PromotionName:    promotion.Name,
+			Code:             promotion.Code,
+			Amount:           amount,
+			FirstOrderOnly:   promotion.FirstOrderOnly,
+			PerCustomerLimit: promotion.PerCustomerLimit,
+		})
+		discount += amount
+	}
+
+	order.Discount = min(discount, order.Subtotal)
+	order.CalculateTotals()
+
+	return nil
+}
+
+// HasDiscountsLimitedPerCustomer tells if a discount of the order comes from a promotion limited per customer.
+func (order Order) HasDiscountsLimitedPerCustomer() bool {
+	for _, discount := range order.Discounts {
+		if discount.FirstOrderOnly || discount.PerCustomerLimit > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// CheckCustomerLimits returns ErrPromotionAlreadyUsed when the customer history no longer allows a discount.
+func (order Order) CheckCustomerLimits(history PromotionHistory) error {
+	for _, discount := range order.Discounts {
+		if discount.FirstOrderOnly && history.CustomerOrders > 0 {
+			return ErrPromotionAlreadyUsed
+		}
+
+		if discount.PerCustomerLimit > 0 && history.Redemptions[discount.PromotionID] >= int64(discount.PerCustomerLimit) {
+			return ErrPromotionAlreadyUsed
+		}
+	}
+
+	return nil
+}
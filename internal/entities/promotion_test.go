@@ -0,0 +1,181 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/8soat-grupo35/fastfood-order/internal/adapters/dto"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func promotionOrderForTest() (Order, map[uint32]Item) {
+	customerID := uint32(1)
+	order := Order{
+		CustomerID: &customerID,
+		Items: []OrderItem{
+			{ItemID: 1, UnitPrice: 2800, Quantity: 1},
+			{ItemID: 3, UnitPrice: 790, Quantity: 2},
+			{ItemID: 4, UnitPrice: 990, Quantity: 1},
+		},
+	}
+	order.CalculateTotals()
+
+	items := map[uint32]Item{
+		1: {ID: 1, Category: "LANCHE", Price: 2800},
+		3: {ID: 3, Category: "BEBIDA", Price: 790},
+		4: {ID: 4, Category: "BEBIDA", Price: 990},
+	}
+
+	return order, items
+}
+
+func TestNewPromotionNormalizesCodeAndKind(t *testing.T) {
+	promotion, err := NewPromotion(dto.PromotionDto{
+		Name:   "Dez reais de desconto",
+		Code:   " bemvindo10 ",
+		Kind:   "valor_fixo",
+		Amount: 10,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "BEMVINDO10", promotion.Code)
+	assert.Equal(t, PROMOTION_FIXED_AMOUNT, promotion.Kind)
+	assert.True(t, promotion.IsCoupon())
+}
+
+func TestNewPromotionRequiresTheFieldsOfItsKind(t *testing.T) {
+	startsAt := time.Date(2024, 5, 10, 0, 0, 0, 0, time.UTC)
+	endsAt := startsAt.Add(-time.Hour)
+
+	_, err := NewPromotion(dto.PromotionDto{
+		Name:     "Leve 3 pague 2",
+		Kind:     PROMOTION_BUY_X_GET_Y,
+		StartsAt: &startsAt,
+		EndsAt:   &endsAt,
+	})
+
+	errs, ok := err.(validation.Errors)
+	assert.True(t, ok)
+	assert.Contains(t, errs, "category")
+	assert.Contains(t, errs, "buy_quantity")
+	assert.Contains(t, errs, "free_quantity")
+	assert.Equal(t, "must be after starts_at", errs["ends_at"].Error())
+}
+
+func TestPromotionDiscountForCategoryPercentageLeavesOtherCategoriesOut(t *testing.T) {
+	order, items := promotionOrderForTest()
+	promotion := Promotion{Kind: PROMOTION_CATEGORY_PERCENTAGE, Category: "BEBIDA", PercentageOff: 10}
+
+	discount, err := promotion.DiscountFor(order, items, PromotionHistory{}, time.Now())
+
+	assert.NoError(t, err)
+	assert.Equal(t, Money(257), discount)
+}
+
+func TestPromotionDiscountForBuyXGetYFreesTheCheapestUnits(t *testing.T) {
+	order, items := promotionOrderForTest()
+	promotion := Promotion{Kind: PROMOTION_BUY_X_GET_Y, Category: "BEBIDA", BuyQuantity: 2, FreeQuantity: 1}
+
+	discount, err := promotion.DiscountFor(order, items, PromotionHistory{}, time.Now())
+
+	assert.NoError(t, err)
+	assert.Equal(t, Money(790), discount)
+}
+
+func TestPromotionDiscountForLeavesComboLinesOut(t *testing.T) {
+	order, items := promotionOrderForTest()
+	comboID := uint32(7)
+	for i := range order.Items {
+		order.Items[i].ComboID = &comboID
+	}
+	promotion := Promotion{Kind: PROMOTION_CATEGORY_PERCENTAGE, Category: "BEBIDA", PercentageOff: 10}
+
+	_, err := promotion.DiscountFor(order, items, PromotionHistory{}, time.Now())
+
+	assert.EqualError(t, err, "does not apply to the items of the order")
+}
+
+func TestPromotionDiscountForChecksTheConditions(t *testing.T) {
+	order, items := promotionOrderForTest()
+	now := time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC)
+	later := now.Add(time.Hour)
+
+	tests := []struct {
+		name      string
+		promotion Promotion
+		order     Order
+		history   PromotionHistory
+		expected  string
+	}{
+		{"not started", Promotion{StartsAt: &later}, order, PromotionHistory{}, "is not valid now"},
+		{"ended", Promotion{EndsAt: &now}, order, PromotionHistory{}, "is not valid now"},
+		{"run out", Promotion{UsageLimit: 5, TimesUsed: 5}, order, PromotionHistory{}, "has run out"},
+		{"guest", Promotion{FirstOrderOnly: true}, Order{Items: order.Items, Subtotal: order.Subtotal}, PromotionHistory{}, "requires an identified customer"},
+		{"not first order", Promotion{FirstOrderOnly: true}, order, PromotionHistory{CustomerOrders: 1}, "is only valid on the first order"},
+		{"per customer", Promotion{ID: 3, PerCustomerLimit: 1}, order, PromotionHistory{Redemptions: map[uint32]int64{3: 1}}, "has already been used the most times allowed per customer"},
+		{"minimum total", Promotion{MinimumTotal: 8000}, order, PromotionHistory{}, "requires a subtotal of at least 80.00"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.promotion.Kind = PROMOTION_FIXED_AMOUNT
+			test.promotion.Amount = 10
+
+			_, err := test.promotion.DiscountFor(test.order, items, test.history, now)
+
+			assert.EqualError(t, err, test.expected)
+		})
+	}
+}
+
+func TestOrderApplyPromotionsStacksDiscountsUpToTheSubtotal(t *testing.T) {
+	order, items := promotionOrderForTest()
+	promotions := []Promotion{
+		{ID: 1, Name: "Bebidas 10%", Kind: PROMOTION_CATEGORY_PERCENTAGE, Category: "BEBIDA", PercentageOff: 10},
+		{ID: 2, Name: "Sobremesa 20%", Kind: PROMOTION_CATEGORY_PERCENTAGE, Category: "SOBREMESA", PercentageOff: 20},
+		{ID: 3, Name: "Bem-vindo", Code: "BEMVINDO", Kind: PROMOTION_FIXED_AMOUNT, Amount: 6000},
+	}
+
+	err := order.ApplyPromotions(promotions, []Item{items[1], items[3], items[4]}, PromotionHistory{}, time.Now())
+
+	assert.NoError(t, err)
+	assert.Equal(t, []OrderDiscount{
+		{PromotionID: 1, PromotionName: "Bebidas 10%", Amount: 257},
+		{PromotionID: 3, PromotionName: "Bem-vindo", Code: "BEMVINDO", Amount: 5370},
+	}, order.Discounts)
+	assert.Equal(t, Money(5370), order.Discount)
+	assert.Equal(t, Money(0), order.Total)
+}
+
+func TestOrderApplyPromotionsReportsCouponThatDoesNotFit(t *testing.T) {
+	order, items := promotionOrderForTest()
+	promotions := []Promotion{{ID: 3, Code: "PRIMEIRA", Kind: PROMOTION_FIXED_AMOUNT, Amount: 1000, FirstOrderOnly: true}}
+
+	err := order.ApplyPromotions(promotions, []Item{items[1]}, PromotionHistory{CustomerOrders: 2}, time.Now())
+
+	assert.EqualError(t, err, "coupon: is only valid on the first order.")
+	assert.Empty(t, order.Discounts)
+}
+
+func TestOrderCheckCustomerLimits(t *testing.T) {
+	customerID := uint32(1)
+	order := Order{CustomerID: &customerID, Discounts: []OrderDiscount{
+		{PromotionID: 3, FirstOrderOnly: true},
+		{PromotionID: 4, PerCustomerLimit: 2},
+	}}
+
+	assert.True(t, order.HasDiscountsLimitedPerCustomer())
+	assert.NoError(t, order.CheckCustomerLimits(PromotionHistory{Redemptions: map[uint32]int64{4: 1}}))
+	assert.ErrorIs(t, order.CheckCustomerLimits(PromotionHistory{CustomerOrders: 1}), ErrPromotionAlreadyUsed)
+	assert.ErrorIs(t, order.CheckCustomerLimits(PromotionHistory{Redemptions: map[uint32]int64{4: 2}}), ErrPromotionAlreadyUsed)
+	assert.False(t, Order{Discounts: []OrderDiscount{{PromotionID: 5}}}.HasDiscountsLimitedPerCustomer())
+}
+
+func TestPromotionValidateCategory(t *testing.T) {
+	promotion := Promotion{Kind: PROMOTION_CATEGORY_PERCENTAGE, Category: "BRUNCH", PercentageOff: 10}
+
+	assert.EqualError(t, promotion.ValidateCategory(nil), "category: must be a registered category.")
+	assert.NoError(t, promotion.ValidateCategory(&Category{ID: 6, Name: "BRUNCH"}))
+	assert.NoError(t, Promotion{Kind: PROMOTION_FIXED_AMOUNT, Amount: 1000}.ValidateCategory(nil))
+}
@@ -0,0 +1,111 @@
+package entities
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"strings"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+// Quantity is an amount of an ingredient in thousandths of its unit, so the stock taken and given back by
+// the orders adds up exactly. Quantities are written to the database and to JSON as decimals with up to
+// three places, such as 0.15. Quantities with more places are rounded half away from zero.
+type Quantity int64
+
+// NewQuantity rounds a quantity given as a decimal, such as the ones sent to the API, to three places.
+func NewQuantity(quantity float64) Quantity {
+	return Quantity(math.Round(quantity * 1000))
+}
+
+// ParseQuantity reads a quantity written as a decimal, such as 0.15 or 2, rounding it to three places.
+func ParseQuantity(quantity string) (Quantity, error) {
+	value, err := parseFixedPoint(quantity, 3)
+	if err != nil {
+		return 0, fmt.Errorf("%q is not a quantity", quantity)
+	}
+
+	return Quantity(value), nil
+}
+
+func (quantity Quantity) Times(units uint32) Quantity {
+	return quantity * Quantity(units)
+}
+
+func (quantity Quantity) String() string {
+	sign := ""
+	thousandths := int64(quantity)
+	if thousandths < 0 {
+		sign, thousandths = "-", -thousandths
+	}
+
+	fraction := strings.TrimRight(fmt.Sprintf("%03d", thousandths%1000), "0")
+	if fraction == "" {
+		return fmt.Sprintf("%s%d", sign, thousandths/1000)
+	}
+
+	return fmt.Sprintf("%s%d.%s", sign, thousandths/1000, fraction)
+}
+
+func (quantity Quantity) MarshalJSON() ([]byte, error) {
+	return []byte(quantity.String()), nil
+}
+
+func (quantity *Quantity) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	parsed, err := ParseQuantity(strings.Trim(string(data), `"`))
+	if err != nil {
+		return err
+	}
+
+	*quantity = parsed
+	return nil
+}
+
+// Value writes the quantity to the numeric columns as a decimal, so they keep the exact quantity.
+func (quantity Quantity) Value() (driver.Value, error) {
+	return quantity.String(), nil
+}
+
+func (quantity *Quantity) Scan(value interface{}) (err error) {
+	switch value := value.(type) {
+	case nil:
+		*quantity = 0
+	case int64:
+		*quantity = Quantity(value * 1000)
+	case float64:
+		*quantity = NewQuantity(value)
+	case []byte:
+		*quantity, err = ParseQuantity(string(value))
+	case string:
+		*quantity, err = ParseQuantity(value)
+	default:
+		err = fmt.Errorf("cannot scan %T into a quantity", value)
+	}
+
+	return err
+}
+
+// requiredQuantity and minQuantity take the place of validation.Required and validation.Min for
+// quantities, as requiredMoney and minMoney do for amounts.
+var requiredQuantity = validation.By(func(value interface{}) error {
+	if quantity, ok := value.(Quantity); ok && quantity == 0 {
+		return validation.ErrRequired
+	}
+
+	return nil
+})
+
+func minQuantity(min Quantity) validation.Rule {
+	return validation.By(func(value interface{}) error {
+		if quantity, ok := value.(Quantity); ok && quantity < min {
+			return validation.ErrMinGreaterEqualThanRequired.SetParams(map[string]interface{}{"threshold": min})
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,99 @@
+package entities
+
+import (
+	"encoding/json"
+	"github.com/8soat-grupo35/fastfood-order/internal/adapters/dto"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNewQuantityRoundsToThreePlaces(t *testing.T) {
+	assert.Equal(t, Quantity(100), NewQuantity(0.1))
+	assert.Equal(t, Quantity(300), NewQuantity(0.1+0.2))
+	assert.Equal(t, Quantity(150), NewQuantity(float64(float32(0.15))))
+	assert.Equal(t, Quantity(2001), NewQuantity(2.0005))
+}
+
+func TestParseQuantity(t *testing.T) {
+	cases := map[string]Quantity{
+		"0.1":     100,
+		"0.100":   100,
+		"2":       2000,
+		".5":      500,
+		"0.0005":  1,
+		"0.00049": 0,
+		"-1.5":    -1500,
+	}
+
+	for quantity, expected := range cases {
+		parsed, err := ParseQuantity(quantity)
+		assert.NoError(t, err, quantity)
+		assert.Equal(t, expected, parsed, quantity)
+	}
+
+	for _, quantity := range []string{"", "-", "1e3", "0,5", "2 kg"} {
+		_, err := ParseQuantity(quantity)
+		assert.Error(t, err, quantity)
+	}
+}
+
+func TestQuantityString(t *testing.T) {
+	assert.Equal(t, "0.1", Quantity(100).String())
+	assert.Equal(t, "0.125", Quantity(125).String())
+	assert.Equal(t, "2", Quantity(2000).String())
+	assert.Equal(t, "-0.05", Quantity(-50).String())
+}
+
+func TestQuantityAddsUpExactly(t *testing.T) {
+	var stock Quantity
+	for i := 0; i < 10; i++ {
+		stock += NewQuantity(0.1)
+	}
+
+	assert.Equal(t, Quantity(1000), stock)
+	assert.Equal(t, Quantity(450), Quantity(150).Times(3))
+}
+
+func TestQuantityJSON(t *testing.T) {
+	encoded, err := json.Marshal(Ingredient{Stock: 100, LowStockThreshold: 2000})
+	assert.NoError(t, err)
+	assert.Contains(t, string(encoded), `"stock":0.1,"low_stock_threshold":2`)
+
+	var ingredient Ingredient
+	err = json.Unmarshal([]byte(`{"stock":0.1,"low_stock_threshold":"0.25"}`), &ingredient)
+	assert.NoError(t, err)
+	assert.Equal(t, Quantity(100), ingredient.Stock)
+	assert.Equal(t, Quantity(250), ingredient.LowStockThreshold)
+}
+
+func TestQuantityDatabaseValue(t *testing.T) {
+	value, err := Quantity(100).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "0.1", value)
+
+	var quantity Quantity
+	for scanned, expected := range map[interface{}]Quantity{"0.1": 100, "0.100000": 100, int64(2): 2000, 0.1: 100, nil: 0} {
+		assert.NoError(t, quantity.Scan(scanned))
+		assert.Equal(t, expected, quantity)
+	}
+	assert.NoError(t, quantity.Scan([]byte("0.15")))
+	assert.Equal(t, Quantity(150), quantity)
+
+	assert.Error(t, quantity.Scan(true))
+}
+
+func TestQuantityValidationComparesTheQuantity(t *testing.T) {
+	_, err := NewItem(dto.ItemDto{
+		Name:     "X-Burguer",
+		Category: "LANCHE",
+		Price:    28,
+		Recipe:   []dto.RecipeIngredientDto{{IngredientID: 1, Quantity: 0.0004}},
+	})
+	errs, ok := err.(validation.Errors)
+	assert.True(t, ok)
+	assert.EqualError(t, errs["Recipe"], "0: (Quantity: cannot be blank.).")
+
+	_, err = NewIngredient(dto.IngredientDto{Name: "Queijo", Unit: "g", Stock: -0.001})
+	assert.EqualError(t, err, "stock: must be no less than 0.")
+}
@@ -0,0 +1,78 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+// RecipeIngredient is how much of an ingredient one unit of the item uses, in the unit of the
+// ingredient. Variants and modifiers use the same recipe as the item.
+type RecipeIngredient struct {
+	ID           uint32      `gorm:"primary_key;auto_increment"`
+	ItemID       uint32      `json:"-"`
+	IngredientID uint32      `gorm:"not null;"`
+	Quantity     Quantity    `gorm:"type:numeric(12,3);not null;"`
+	Ingredient   *Ingredient `json:"-"`
+} //@name domain.RecipeIngredient
+
+func (recipeIngredient RecipeIngredient) Validate() error {
+	return validation.ValidateStruct(
+		&recipeIngredient,
+		validation.Field(
+			&recipeIngredient.IngredientID,
+			validation.Required,
+		),
+		validation.Field(
+			&recipeIngredient.Quantity,
+			requiredQuantity,
+			minQuantity(0),
+		),
+	)
+}
+
+func (item Item) IngredientIDs() (ids []uint32) {
+	for _, recipeIngredient := range item.Recipe {
+		ids = append(ids, recipeIngredient.IngredientID)
+	}
+
+	return ids
+}
+
+// validateRecipe keeps every ingredient once in the recipe of the item.
+func (item Item) validateRecipe() error {
+	seen := make(map[uint32]bool, len(item.Recipe))
+	for _, recipeIngredient := range item.Recipe {
+		if seen[recipeIngredient.IngredientID] {
+			return errors.New("must not repeat an ingredient")
+		}
+		seen[recipeIngredient.IngredientID] = true
+	}
+
+	return nil
+}
+
+// ValidateIngredients checks every ingredient of the recipe is registered.
+func (item Item) ValidateIngredients(ingredients []Ingredient) error {
+	registered := make(map[uint32]bool, len(ingredients))
+	for _, ingredient := range ingredients {
+		registered[ingredient.ID] = true
+	}
+
+	recipeErrors := validation.Errors{}
+	for i, recipeIngredient := range item.Recipe {
+		if !registered[recipeIngredient.IngredientID] {
+			recipeErrors[strconv.Itoa(i)] = validation.Errors{
+				"IngredientID": fmt.Errorf("ingredient %d not found", recipeIngredient.IngredientID),
+			}
+		}
+	}
+
+	if len(recipeErrors) > 0 {
+		return validation.Errors{"Recipe": recipeErrors}
+	}
+
+	return nil
+}
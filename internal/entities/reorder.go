@@ -0,0 +1,183 @@
+package entities
+
+import "github.com/8soat-grupo35/fastfood-order/internal/adapters/dto"
+
+const (
+	ITEM_UNAVAILABLE_REASON  = "item is no longer available"
+	ITEM_OUT_OF_STOCK_REASON = "item is out of stock"
+)
+
+type DroppedOrderItem struct {
+	ItemID       uint32
+	ItemName     string
+	VariantLabel string
+	ComboName    string
+	Quantity     uint32
+	Reason       string
+}
+
+type Reorder struct {
+	Order        Order
+	DroppedItems []DroppedOrderItem
+}
+
+// NewReorderDto builds the checkout of a new order with the lines of an earlier order, for the same
+// customer. Lines whose item or variant is no longer available, whose item is off sale now or does not
+// have the units of the line in stock, are dropped and reported, while the modifiers the item no longer
+// offers are left out of the line. Combos are repeated whole or dropped whole, when the combo or any of
+// its items can no longer be ordered. The stock is checked adding up the lines kept before.
+func NewReorderDto(order Order, availableItems []Item, availableCombos []Combo) (dto.OrderDto, []DroppedOrderItem) {
+	available := make(map[uint32]Item, len(availableItems))
+	for _, item := range availableItems {
+		if item.OnSale() {
+			available[item.ID] = item
+		}
+	}
+
+	availableCombo := make(map[uint32]Combo, len(availableCombos))
+	for _, combo := range availableCombos {
+		availableCombo[combo.ID] = combo
+	}
+
+	orderDto := dto.OrderDto{
+		CustomerID:   order.CustomerID,
+		CustomerName: order.CustomerName,
+	}
+	droppedItems := []DroppedOrderItem{}
+	taken := map[uint32]uint32{}
+	comboLines := map[uint32][]OrderItem{}
+	var comboGroups []uint32
+
+	for _, orderItem := range order.Items {
+		if orderItem.IsCombo() {
+			if _, seen := comboLines[orderItem.ComboGroup]; !seen {
+				comboGroups = append(comboGroups, orderItem.ComboGroup)
+			}
+			comboLines[orderItem.ComboGroup] = append(comboLines[orderItem.ComboGroup], orderItem)
+			continue
+		}
+
+		item, found := available[orderItem.ItemID]
+		if !found {
+			droppedItems = append(droppedItems, DroppedOrderItem{
+				ItemID:   orderItem.ItemID,
+				ItemName: orderItem.ItemName,
+				Quantity: orderItem.Quantity,
+				Reason:   ITEM_UNAVAILABLE_REASON,
+			})
+			continue
+		}
+
+		if !orderItem.variantAvailable(item) {
+			droppedItems = append(droppedItems, DroppedOrderItem{
+				ItemID:       orderItem.ItemID,
+				ItemName:     orderItem.ItemName,
+				VariantLabel: orderItem.VariantLabel,
+				Quantity:     orderItem.Quantity,
+				Reason:       ITEM_VARIANT_UNAVAILABLE_REASON,
+			})
+			continue
+		}
+
+		if !item.InStock(taken[item.ID] + orderItem.Quantity) {
+			droppedItems = append(droppedItems, DroppedOrderItem{
+				ItemID:       orderItem.ItemID,
+				ItemName:     orderItem.ItemName,
+				VariantLabel: orderItem.VariantLabel,
+				Quantity:     orderItem.Quantity,
+				Reason:       ITEM_OUT_OF_STOCK_REASON,
+			})
+			continue
+		}
+		taken[item.ID] += orderItem.Quantity
+
+		orderItemDto := dto.OrderItemDto{
+			Id:        orderItem.ItemID,
+			VariantID: orderItem.VariantID,
+			Quantity:  orderItem.Quantity,
+			Notes:     orderItem.Notes,
+			Modifiers: orderItem.offeredModifiers(item),
+		}
+
+		orderDto.Items = append(orderDto.Items, orderItemDto)
+	}
+
+	for _, group := range comboGroups {
+		lines := comboLines[group]
+		combo, found := availableCombo[*lines[0].ComboID]
+
+		orderComboDto := dto.OrderComboDto{
+			Id:       *lines[0].ComboID,
+			Quantity: lines[0].Quantity,
+		}
+		itemIds := []uint32{}
+		reason := COMBO_UNAVAILABLE_REASON
+		comboTaken := map[uint32]uint32{}
+
+		for _, orderItem := range lines {
+			item, itemFound := available[orderItem.ItemID]
+			if !itemFound || !orderItem.variantAvailable(item) {
+				found = false
+				break
+			}
+
+			comboTaken[item.ID] += orderItem.Quantity
+			if !item.InStock(taken[item.ID] + comboTaken[item.ID]) {
+				found = false
+				reason = ITEM_OUT_OF_STOCK_REASON
+				break
+			}
+
+			itemIds = append(itemIds, orderItem.ItemID)
+			orderComboDto.Items = append(orderComboDto.Items, dto.OrderComboItemDto{
+				Id:        orderItem.ItemID,
+				VariantID: orderItem.VariantID,
+				Notes:     orderItem.Notes,
+				Modifiers: orderItem.offeredModifiers(item),
+			})
+		}
+
+		if !found || !combo.FillsSlots(itemIds) {
+			for _, orderItem := range lines {
+				droppedItems = append(droppedItems, DroppedOrderItem{
+					ItemID:       orderItem.ItemID,
+					ItemName:     orderItem.ItemName,
+					VariantLabel: orderItem.VariantLabel,
+					ComboName:    orderItem.ComboName,
+					Quantity:     orderItem.Quantity,
+					Reason:       reason,
+				})
+			}
+			continue
+		}
+
+		for itemId, quantity := range comboTaken {
+			taken[itemId] += quantity
+		}
+		orderDto.Combos = append(orderDto.Combos, orderComboDto)
+	}
+
+	return orderDto, droppedItems
+}
+
+func (orderItem OrderItem) offeredModifiers(item Item) (names []string) {
+	for _, modifier := range orderItem.Modifiers {
+		if _, offered := item.Modifier(modifier.Name); offered {
+			names = append(names, modifier.Name)
+		}
+	}
+
+	return names
+}
+
+// variantAvailable tells if the variant of the line can still be ordered. Lines of items that got
+// variants after the order have none to repeat.
+func (orderItem OrderItem) variantAvailable(item Item) bool {
+	if orderItem.VariantID == nil {
+		return !item.HasVariants()
+	}
+
+	variant, found := item.Variant(*orderItem.VariantID)
+
+	return found && variant.Available
+}
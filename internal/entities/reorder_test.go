@@ -0,0 +1,144 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/8soat-grupo35/fastfood-order/internal/adapters/dto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewReorderDtoKeepsCustomerAndAvailableItems(t *testing.T) {
+	customerID := uint32(1)
+	order := Order{
+		CustomerID:   &customerID,
+		CustomerName: "Maria",
+		Items: []OrderItem{
+			{ItemID: 1, ItemName: "X-Burger", Quantity: 2},
+			{ItemID: 2, ItemName: "Milkshake", Quantity: 1},
+		},
+	}
+
+	orderDto, droppedItems := NewReorderDto(order, []Item{{ID: 1}}, nil)
+
+	assert.Equal(t, &customerID, orderDto.CustomerID)
+	assert.Equal(t, "Maria", orderDto.CustomerName)
+	assert.Equal(t, []dto.OrderItemDto{{Id: 1, Quantity: 2}}, orderDto.Items)
+	assert.Equal(t, []DroppedOrderItem{
+		{ItemID: 2, ItemName: "Milkshake", Quantity: 1, Reason: ITEM_UNAVAILABLE_REASON},
+	}, droppedItems)
+}
+
+func TestNewReorderDtoLeavesOutModifiersNoLongerOffered(t *testing.T) {
+	order := Order{
+		CustomerName: "Maria",
+		Items: []OrderItem{
+			{ItemID: 1, Quantity: 1, Notes: "sem cebola", Modifiers: []OrderItemModifier{{Name: "Bacon extra"}, {Name: "Cheddar"}}},
+		},
+	}
+
+	orderDto, droppedItems := NewReorderDto(order, []Item{{ID: 1, Modifiers: []ItemModifier{{Name: "Bacon extra"}}}}, nil)
+
+	assert.Empty(t, droppedItems)
+	assert.Equal(t, []dto.OrderItemDto{{Id: 1, Quantity: 1, Notes: "sem cebola", Modifiers: []string{"Bacon extra"}}}, orderDto.Items)
+}
+
+func TestNewReorderDtoDropsLinesWhoseVariantIsUnavailable(t *testing.T) {
+	largeID := uint32(3)
+	order := Order{
+		CustomerName: "Maria",
+		Items: []OrderItem{
+			{ItemID: 1, ItemName: "Refrigerante", VariantID: &largeID, VariantLabel: "G", Quantity: 1},
+			{ItemID: 2, ItemName: "Batata", Quantity: 1},
+		},
+	}
+	items := []Item{
+		{ID: 1, Variants: []ItemVariant{{ID: 3, Label: "G", Available: false}}},
+		{ID: 2, Variants: []ItemVariant{{ID: 4, Label: "M", Available: true}}},
+	}
+
+	orderDto, droppedItems := NewReorderDto(order, items, nil)
+
+	assert.Empty(t, orderDto.Items)
+	assert.Equal(t, []DroppedOrderItem{
+		{ItemID: 1, ItemName: "Refrigerante", VariantLabel: "G", Quantity: 1, Reason: ITEM_VARIANT_UNAVAILABLE_REASON},
+		{ItemID: 2, ItemName: "Batata", Quantity: 1, Reason: ITEM_VARIANT_UNAVAILABLE_REASON},
+	}, droppedItems)
+}
+
+func TestNewReorderDtoDropsEveryItemWhenNoneIsAvailable(t *testing.T) {
+	order := Order{CustomerName: "Maria", Items: []OrderItem{{ItemID: 1, Quantity: 1}}}
+
+	orderDto, droppedItems := NewReorderDto(order, []Item{}, nil)
+
+	assert.Empty(t, orderDto.Items)
+	assert.Len(t, droppedItems, 1)
+}
+
+func TestNewReorderDtoDropsWholeCombosThatCanNoLongerBeFilled(t *testing.T) {
+	comboID := uint32(7)
+	order := Order{
+		CustomerName: "Maria",
+		Items: []OrderItem{
+			{ItemID: 1, Quantity: 1, ComboID: &comboID, ComboName: "Combo Classico", ComboGroup: 1},
+			{ItemID: 2, Quantity: 1, ComboID: &comboID, ComboName: "Combo Classico", ComboGroup: 1},
+			{ItemID: 3, Quantity: 1, ComboID: &comboID, ComboName: "Combo Classico", ComboGroup: 1},
+			{ItemID: 4, Quantity: 2, ComboID: &comboID, ComboName: "Combo Classico", ComboGroup: 2},
+			{ItemID: 2, Quantity: 2, ComboID: &comboID, ComboName: "Combo Classico", ComboGroup: 2},
+			{ItemID: 3, Quantity: 2, ComboID: &comboID, ComboName: "Combo Classico", ComboGroup: 2},
+		},
+	}
+	items := []Item{{ID: 1}, {ID: 2}, {ID: 3}}
+
+	orderDto, droppedItems := NewReorderDto(order, items, []Combo{comboForTest()})
+
+	assert.Equal(t, []dto.OrderComboDto{
+		{Id: 7, Quantity: 1, Items: []dto.OrderComboItemDto{{Id: 1}, {Id: 2}, {Id: 3}}},
+	}, orderDto.Combos)
+	assert.Len(t, droppedItems, 3)
+	assert.Equal(t, COMBO_UNAVAILABLE_REASON, droppedItems[0].Reason)
+	assert.Equal(t, "Combo Classico", droppedItems[0].ComboName)
+}
+
+func TestNewReorderDtoDropsLinesOutOfStock(t *testing.T) {
+	left := uint32(3)
+	order := Order{
+		CustomerName: "Maria",
+		Items: []OrderItem{
+			{ItemID: 1, ItemName: "X-Burger", Quantity: 2},
+			{ItemID: 1, ItemName: "X-Burger", Quantity: 2, Notes: "sem cebola"},
+			{ItemID: 2, ItemName: "Torta", Quantity: 1},
+		},
+	}
+	items := []Item{
+		{ID: 1, Stock: &left},
+		{ID: 2, Recipe: []RecipeIngredient{{IngredientID: 5, Quantity: 200000, Ingredient: &Ingredient{ID: 5, Stock: 100000}}}},
+	}
+
+	orderDto, droppedItems := NewReorderDto(order, items, nil)
+
+	assert.Equal(t, []dto.OrderItemDto{{Id: 1, Quantity: 2}}, orderDto.Items)
+	assert.Equal(t, []DroppedOrderItem{
+		{ItemID: 1, ItemName: "X-Burger", Quantity: 2, Reason: ITEM_OUT_OF_STOCK_REASON},
+		{ItemID: 2, ItemName: "Torta", Quantity: 1, Reason: ITEM_OUT_OF_STOCK_REASON},
+	}, droppedItems)
+}
+
+func TestNewReorderDtoDropsWholeCombosWithAnItemOutOfStock(t *testing.T) {
+	comboID := uint32(7)
+	left := uint32(1)
+	order := Order{
+		CustomerName: "Maria",
+		Items: []OrderItem{
+			{ItemID: 1, Quantity: 2, ComboID: &comboID, ComboName: "Combo Classico", ComboGroup: 1},
+			{ItemID: 2, Quantity: 2, ComboID: &comboID, ComboName: "Combo Classico", ComboGroup: 1},
+			{ItemID: 3, Quantity: 2, ComboID: &comboID, ComboName: "Combo Classico", ComboGroup: 1},
+		},
+	}
+	items := []Item{{ID: 1}, {ID: 2, Stock: &left}, {ID: 3}}
+
+	orderDto, droppedItems := NewReorderDto(order, items, []Combo{comboForTest()})
+
+	assert.Empty(t, orderDto.Combos)
+	assert.Len(t, droppedItems, 3)
+	assert.Equal(t, ITEM_OUT_OF_STOCK_REASON, droppedItems[0].Reason)
+}
@@ -0,0 +1,131 @@
+package entities
+
+import (
+	"fmt"
+	"sort"
+)
+
+// OutOfStockError is returned when the order takes more units of an item than its stock has left.
+type OutOfStockError struct {
+	ItemID   uint32
+	ItemName string
+}
+
+func (err *OutOfStockError) Error() string {
+	return fmt.Sprintf("item %d (%s) is out of stock", err.ItemID, err.ItemName)
+}
+
+// StoreItemStock is the number of units of an item left at a store.
+type StoreItemStock struct {
+	StoreID uint32 `gorm:"primaryKey;autoIncrement:false"`
+	ItemID  uint32 `gorm:"primaryKey;autoIncrement:false"`
+	Stock   uint32 `gorm:"not null;"`
+}
+
+// StoreIngredientStock is how much of an ingredient is left at a store.
+type StoreIngredientStock struct {
+	StoreID      uint32   `gorm:"primaryKey;autoIncrement:false"`
+	IngredientID uint32   `gorm:"primaryKey;autoIncrement:false"`
+	Stock        Quantity `gorm:"type:numeric(12,3);not null;default:0"`
+}
+
+// ItemQuantity is how many units of an item the order takes, adding up every line of the item.
+type ItemQuantity struct {
+	ItemID   uint32
+	ItemName string
+	Quantity uint32
+}
+
+// ItemQuantities lists the units of each item the lines not made from a recipe take, sorted by item id.
+func (order Order) ItemQuantities() (quantities []ItemQuantity) {
+	positions := make(map[uint32]int)
+	for _, orderItem := range order.Items {
+		if orderItem.FromRecipe {
+			continue
+		}
+
+		position, found := positions[orderItem.ItemID]
+		if !found {
+			position = len(quantities)
+			positions[orderItem.ItemID] = position
+			quantities = append(quantities, ItemQuantity{ItemID: orderItem.ItemID, ItemName: orderItem.ItemName})
+		}
+		quantities[position].Quantity += orderItem.Quantity
+	}
+
+	sort.Slice(quantities, func(i, j int) bool { return quantities[i].ItemID < quantities[j].ItemID })
+
+	return quantities
+}
+
+// CheckStock tells which item of the order, if any, does not have enough units or ingredients left.
+func (order Order) CheckStock(items []Item) error {
+	catalog := make(map[uint32]Item, len(items))
+	ingredients := make(map[uint32]Ingredient)
+	for _, item := range items {
+		catalog[item.ID] = item
+		for _, recipeIngredient := range item.Recipe {
+			if recipeIngredient.Ingredient != nil {
+				ingredients[recipeIngredient.IngredientID] = *recipeIngredient.Ingredient
+			}
+		}
+	}
+
+	for _, quantity := range order.ItemQuantities() {
+		if item, found := catalog[quantity.ItemID]; found && !item.InStock(quantity.Quantity) {
+			return &OutOfStockError{ItemID: quantity.ItemID, ItemName: quantity.ItemName}
+		}
+	}
+
+	for _, orderIngredient := range order.Ingredients {
+		if ingredient, found := ingredients[orderIngredient.IngredientID]; found && ingredient.Stock < orderIngredient.Quantity {
+			return &OutOfStockError{ItemID: orderIngredient.ItemID, ItemName: orderIngredient.ItemName}
+		}
+	}
+
+	return nil
+}
+
+// OrderIngredient is how much of an ingredient the order took, with the first line that uses it.
+type OrderIngredient struct {
+	ID           uint32   `gorm:"primarykey;autoIncrement"`
+	OrderID      uint32   `gorm:"not null;"`
+	IngredientID uint32   `gorm:"not null;"`
+	Quantity     Quantity `gorm:"type:numeric(12,3);not null;"`
+	ItemID       uint32   `gorm:"-"`
+	ItemName     string   `gorm:"-"`
+}
+
+// UseIngredients records the ingredients the recipes of the lines take, marking the lines made from a recipe.
+func (order *Order) UseIngredients(items []Item) {
+	catalog := make(map[uint32]Item, len(items))
+	for _, item := range items {
+		catalog[item.ID] = item
+	}
+
+	order.Ingredients = nil
+	positions := make(map[uint32]int)
+	for i, orderItem := range order.Items {
+		recipe := catalog[orderItem.ItemID].Recipe
+		order.Items[i].FromRecipe = len(recipe) > 0
+
+		for _, recipeIngredient := range recipe {
+			position, found := positions[recipeIngredient.IngredientID]
+			if !found {
+				position = len(order.Ingredients)
+				positions[recipeIngredient.IngredientID] = position
+				order.Ingredients = append(order.Ingredients, OrderIngredient{
+					IngredientID: recipeIngredient.IngredientID,
+					ItemID:       orderItem.ItemID,
+					ItemName:     orderItem.ItemName,
+				})
+			}
+
+			order.Ingredients[position].Quantity += recipeIngredient.Quantity.Times(orderItem.Quantity)
+		}
+	}
+
+	sort.Slice(order.Ingredients, func(i, j int) bool {
+		return order.Ingredients[i].IngredientID < order.Ingredients[j].IngredientID
+	})
+}
@@ -0,0 +1,158 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrderItemQuantitiesAddsUpLinesOfTheSameItem(t *testing.T) {
+	comboID := uint32(7)
+	order := Order{Items: []OrderItem{
+		{ItemID: 3, ItemName: "Refrigerante", Quantity: 1},
+		{ItemID: 1, ItemName: "X-Burguer", Quantity: 2},
+		{ItemID: 3, ItemName: "Refrigerante", Quantity: 2, ComboID: &comboID},
+	}}
+
+	assert.Equal(t, []ItemQuantity{
+		{ItemID: 1, ItemName: "X-Burguer", Quantity: 2},
+		{ItemID: 3, ItemName: "Refrigerante", Quantity: 3},
+	}, order.ItemQuantities())
+}
+
+func TestOrderItemQuantitiesLeavesOutLinesMadeFromARecipe(t *testing.T) {
+	order := Order{Items: []OrderItem{
+		{ItemID: 1, ItemName: "X-Burguer", Quantity: 2, FromRecipe: true},
+		{ItemID: 3, ItemName: "Refrigerante", Quantity: 1},
+	}}
+
+	assert.Equal(t, []ItemQuantity{{ItemID: 3, ItemName: "Refrigerante", Quantity: 1}}, order.ItemQuantities())
+}
+
+func TestOrderCheckStock(t *testing.T) {
+	two, three := uint32(2), uint32(3)
+	order := Order{Items: []OrderItem{
+		{ItemID: 1, ItemName: "X-Burguer", Quantity: 2},
+		{ItemID: 3, ItemName: "Refrigerante", Quantity: 3},
+	}}
+
+	tests := []struct {
+		name     string
+		items    []Item
+		expected error
+	}{
+		{"not tracked", []Item{{ID: 1}, {ID: 3}}, nil},
+		{"enough units", []Item{{ID: 1, Stock: &two}, {ID: 3, Stock: &three}}, nil},
+		{"not enough units", []Item{{ID: 1, Stock: &two}, {ID: 3, Stock: &two}}, &OutOfStockError{ItemID: 3, ItemName: "Refrigerante"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, order.CheckStock(test.items))
+		})
+	}
+}
+
+func TestOrderUseIngredientsAddsUpTheRecipesOfTheLines(t *testing.T) {
+	order := Order{Items: []OrderItem{
+		{ItemID: 1, ItemName: "X-Burguer", Quantity: 2},
+		{ItemID: 2, ItemName: "X-Salada", Quantity: 1},
+		{ItemID: 3, ItemName: "Refrigerante", Quantity: 1},
+	}}
+	items := []Item{
+		{ID: 1, Recipe: []RecipeIngredient{{IngredientID: 5, Quantity: 150}, {IngredientID: 2, Quantity: 1000}}},
+		{ID: 2, Recipe: []RecipeIngredient{{IngredientID: 2, Quantity: 1000}, {IngredientID: 9, Quantity: 30}}},
+		{ID: 3},
+	}
+
+	order.UseIngredients(items)
+
+	assert.True(t, order.Items[0].FromRecipe)
+	assert.True(t, order.Items[1].FromRecipe)
+	assert.False(t, order.Items[2].FromRecipe)
+	assert.Equal(t, []OrderIngredient{
+		{IngredientID: 2, Quantity: 3000, ItemID: 1, ItemName: "X-Burguer"},
+		{IngredientID: 5, Quantity: 300, ItemID: 1, ItemName: "X-Burguer"},
+		{IngredientID: 9, Quantity: 30, ItemID: 2, ItemName: "X-Salada"},
+	}, order.Ingredients)
+}
+
+func TestOrderCheckStockOfIngredients(t *testing.T) {
+	bread := Ingredient{ID: 2, Name: "Pão", Unit: INGREDIENT_UNIT, Stock: 2000}
+	items := []Item{
+		{ID: 1, Recipe: []RecipeIngredient{{IngredientID: 2, Quantity: 1000, Ingredient: &bread}}},
+		{ID: 2, Recipe: []RecipeIngredient{{IngredientID: 2, Quantity: 1000, Ingredient: &bread}}},
+	}
+
+	enough := Order{Items: []OrderItem{{ItemID: 1, ItemName: "X-Burguer", Quantity: 2}}}
+	enough.UseIngredients(items)
+	assert.NoError(t, enough.CheckStock(items))
+
+	// each item alone fits the stock, the order as a whole does not
+	notEnough := Order{Items: []OrderItem{
+		{ItemID: 1, ItemName: "X-Burguer", Quantity: 2},
+		{ItemID: 2, ItemName: "X-Salada", Quantity: 1},
+	}}
+	notEnough.UseIngredients(items)
+	assert.Equal(t, &OutOfStockError{ItemID: 1, ItemName: "X-Burguer"}, notEnough.CheckStock(items))
+}
+
+func TestItemInStockChecksTheIngredientsOfTheRecipe(t *testing.T) {
+	cheese := Ingredient{ID: 4, Name: "Queijo", Unit: INGREDIENT_GRAM, Stock: 50000}
+	item := Item{ID: 1, Recipe: []RecipeIngredient{{IngredientID: 4, Quantity: 30000, Ingredient: &cheese}}}
+
+	assert.True(t, item.InStock(1))
+	assert.False(t, item.InStock(2))
+
+	cheese.Stock = 0
+	assert.False(t, item.InStock(1))
+}
+
+func TestItemInStockLeavesOutTheStockOfItemsWithARecipe(t *testing.T) {
+	none := uint32(0)
+	cheese := Ingredient{ID: 4, Name: "Queijo", Unit: INGREDIENT_GRAM, Stock: 50000}
+	item := Item{ID: 1, Stock: &none, Recipe: []RecipeIngredient{{IngredientID: 4, Quantity: 30000, Ingredient: &cheese}}}
+
+	assert.True(t, item.InStock(1))
+}
+
+func TestItemApplyStockTakesTheStockOfTheStore(t *testing.T) {
+	bread := Ingredient{ID: 5, Stocks: []StoreIngredientStock{{StoreID: 2, IngredientID: 5, Stock: 30000}}}
+	item := Item{
+		ID:     1,
+		Stocks: []StoreItemStock{{StoreID: 1, ItemID: 1, Stock: 0}, {StoreID: 2, ItemID: 1, Stock: 8}},
+		Recipe: []RecipeIngredient{{IngredientID: 5, Quantity: 1000, Ingredient: &bread}},
+	}
+
+	item.ApplyStock(2)
+	assert.Equal(t, uint32(8), *item.Stock)
+	assert.Equal(t, Quantity(30000), bread.Stock)
+
+	item.ApplyStock(1)
+	assert.Equal(t, uint32(0), *item.Stock)
+	assert.Equal(t, Quantity(0), bread.Stock)
+
+	item.ApplyStock(3)
+	assert.Nil(t, item.Stock)
+}
+
+func TestKeepStockAtKeepsTheStockAsTheOneOfTheStore(t *testing.T) {
+	stock := uint32(12)
+	item := Item{Stock: &stock}
+	item.KeepStockAt(2)
+	assert.Equal(t, []StoreItemStock{{StoreID: 2, Stock: 12}}, item.Stocks)
+
+	untracked := Item{}
+	untracked.KeepStockAt(2)
+	assert.Empty(t, untracked.Stocks)
+
+	ingredient := Ingredient{Stock: 400000}
+	ingredient.KeepStockAt(2)
+	assert.Equal(t, []StoreIngredientStock{{StoreID: 2, Stock: 400000}}, ingredient.Stocks)
+}
+
+func TestOutOfStockErrorMessage(t *testing.T) {
+	err := &OutOfStockError{ItemID: 3, ItemName: "Refrigerante"}
+
+	assert.EqualError(t, err, "item 3 (Refrigerante) is out of stock")
+}
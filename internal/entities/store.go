@@ -0,0 +1,320 @@
+package entities
+
+import (
+	"errors"
+	"github.com/8soat-grupo35/fastfood-order/internal/adapters/dto"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"gorm.io/gorm"
+)
+
+// storeSearchDays is how far ahead the next opening of a closed store is looked for.
+const storeSearchDays = 366
+
+var pickupCodePrefixPattern = regexp.MustCompile(`^[A-Z0-9]{1,3}$`)
+
+// Store is a unit of the franchise. Orders, menus, opening hours and the calendar belong to a store, while
+// the customers and the catalog of items are shared by every store. Inactive stores take no requests.
+type Store struct {
+	ID               uint32         `gorm:"primary_key;auto_increment" json:"id"`
+	Name             string         `gorm:"size:100;not null;" json:"name"`
+	TimeZone         string         `gorm:"size:64;not null;" json:"time_zone"`
+	PickupCodePrefix string         `gorm:"size:3;not null;" json:"pickup_code_prefix"`
+	Active           bool           `gorm:"not null;" json:"active"`
+	CreatedAt        time.Time      `json:"created_at"`
+	UpdatedAt        time.Time      `json:"updated_at"`
+	DeletedAt        gorm.DeletedAt `gorm:"index" json:"-"`
+} //@name domain.Store
+
+// StoreHours is the opening window of the store on a day of the week, in the store time zone. Hours
+// closing before they open go past midnight, and the same open and close time keeps the store open the
+// whole day.
+type StoreHours struct {
+	ID        uint32       `gorm:"primary_key;auto_increment" json:"-"`
+	StoreID   uint32       `gorm:"not null;" json:"-"`
+	Weekday   time.Weekday `gorm:"not null;" json:"weekday"`
+	OpenTime  string       `gorm:"size:5;not null;" json:"open_time"`
+	CloseTime string       `gorm:"size:5;not null;" json:"close_time"`
+} //@name domain.StoreHours
+
+// StoreCalendarDay is an exception to the weekly hours on a date, such as a holiday. The store is either
+// closed the whole day or open on the hours of the day instead of the weekly ones.
+type StoreCalendarDay struct {
+	ID          uint32    `gorm:"primary_key;auto_increment" json:"id"`
+	StoreID     uint32    `gorm:"not null;" json:"-"`
+	Date        time.Time `gorm:"type:date;not null;" json:"date"`
+	Closed      bool      `gorm:"not null;" json:"closed"`
+	OpenTime    string    `gorm:"size:5;not null;default:''" json:"open_time,omitempty"`
+	CloseTime   string    `gorm:"size:5;not null;default:''" json:"close_time,omitempty"`
+	Description string    `gorm:"size:100;not null;default:''" json:"description,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+} //@name domain.StoreCalendarDay
+
+// StoreSchedule is the weekly hours and the calendar days that tell when the store takes orders. A store
+// without weekly hours is open all day, every day, but on its calendar days.
+type StoreSchedule struct {
+	Hours    []StoreHours
+	Calendar []StoreCalendarDay
+	Location *time.Location
+}
+
+// StoreStatus tells if the store takes orders now and, while closed, when it opens again.
+type StoreStatus struct {
+	Open        bool       `json:"open"`
+	NextOpening *time.Time `json:"next_opening,omitempty"`
+} //@name domain.StoreStatus
+
+// NewStore normalizes the prefix of the pickup codes as they are called out. Stores are active unless
+// told otherwise.
+func NewStore(storeDto dto.StoreDto) (*Store, error) {
+	newStore := Store{
+		Name:             strings.TrimSpace(storeDto.Name),
+		TimeZone:         strings.TrimSpace(storeDto.TimeZone),
+		PickupCodePrefix: strings.ToUpper(strings.TrimSpace(storeDto.PickupCodePrefix)),
+		Active:           storeDto.Active == nil || *storeDto.Active,
+	}
+
+	err := newStore.Validate()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &newStore, nil
+}
+
+func (store Store) Validate() error {
+	return validation.ValidateStruct(
+		&store,
+		validation.Field(
+			&store.Name,
+			validation.Required,
+			validation.Length(3, 100),
+		),
+		validation.Field(
+			&store.TimeZone,
+			validation.Required,
+			validation.By(func(value interface{}) error {
+				if _, err := time.LoadLocation(value.(string)); err != nil {
+					return errors.New("must be a IANA time zone, such as America/Sao_Paulo")
+				}
+				return nil
+			}),
+		),
+		validation.Field(
+			&store.PickupCodePrefix,
+			validation.Required,
+			validation.Match(pickupCodePrefixPattern).Error("must be up to 3 letters or digits"),
+		),
+	)
+}
+
+// Location is the time zone of the store, which its hours, menus and business days are in. A time zone
+// that cannot be loaded falls back to UTC.
+func (store Store) Location() *time.Location {
+	location, err := time.LoadLocation(store.TimeZone)
+	if err != nil {
+		return time.UTC
+	}
+
+	return location
+}
+
+// Now is the current time in the time zone of the store.
+func (store Store) Now() time.Time {
+	return time.Now().In(store.Location())
+}
+
+func (store Store) PickupCodeFormat() PickupCodeFormat {
+	return PickupCodeFormat{
+		Prefix:   store.PickupCodePrefix,
+		Location: store.Location(),
+	}
+}
+
+// NewStoreHours builds the hours of the whole week. Errors are reported by the position of the day.
+func NewStoreHours(hoursDto dto.StoreHoursDto) ([]StoreHours, error) {
+	hours := make([]StoreHours, 0, len(hoursDto.Days))
+	dayErrors := validation.Errors{}
+	weekdays := make(map[time.Weekday]bool, len(hoursDto.Days))
+
+	for i, dayDto := range hoursDto.Days {
+		dayHours := StoreHours{
+			Weekday:   time.Weekday(dayDto.Weekday),
+			OpenTime:  strings.TrimSpace(dayDto.OpenTime),
+			CloseTime: strings.TrimSpace(dayDto.CloseTime),
+		}
+
+		if err := dayHours.Validate(); err != nil {
+			dayErrors[strconv.Itoa(i)] = err
+			continue
+		}
+
+		if weekdays[dayHours.Weekday] {
+			dayErrors[strconv.Itoa(i)] = validation.Errors{
+				"weekday": errors.New("must not repeat a day of the week"),
+			}
+			continue
+		}
+
+		weekdays[dayHours.Weekday] = true
+		hours = append(hours, dayHours)
+	}
+
+	if len(dayErrors) > 0 {
+		return nil, validation.Errors{"days": dayErrors}
+	}
+
+	return hours, nil
+}
+
+func (hours StoreHours) Validate() error {
+	return validation.ValidateStruct(
+		&hours,
+		validation.Field(
+			&hours.Weekday,
+			validation.Min(0),
+			validation.Max(6),
+		),
+		validation.Field(
+			&hours.OpenTime,
+			validation.Required,
+			validation.Match(timeOfDayPattern).Error("must be a time of the day as HH:MM"),
+		),
+		validation.Field(
+			&hours.CloseTime,
+			validation.Required,
+			validation.Match(timeOfDayPattern).Error("must be a time of the day as HH:MM"),
+		),
+	)
+}
+
+// NewStoreCalendarDay leaves the hours out of the days the store is closed.
+func NewStoreCalendarDay(dayDto dto.StoreCalendarDayDto) (*StoreCalendarDay, error) {
+	date, _ := time.Parse(time.DateOnly, strings.TrimSpace(dayDto.Date))
+
+	newDay := StoreCalendarDay{
+		Date:        date,
+		Closed:      dayDto.Closed,
+		Description: strings.TrimSpace(dayDto.Description),
+	}
+
+	if !newDay.Closed {
+		newDay.OpenTime = strings.TrimSpace(dayDto.OpenTime)
+		newDay.CloseTime = strings.TrimSpace(dayDto.CloseTime)
+	}
+
+	err := newDay.Validate()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &newDay, nil
+}
+
+func (day StoreCalendarDay) Validate() error {
+	return validation.ValidateStruct(
+		&day,
+		validation.Field(
+			&day.Date,
+			validation.Required.Error("must be a date as YYYY-MM-DD"),
+		),
+		validation.Field(
+			&day.OpenTime,
+			validation.When(!day.Closed, validation.Required),
+			validation.Match(timeOfDayPattern).Error("must be a time of the day as HH:MM"),
+		),
+		validation.Field(
+			&day.CloseTime,
+			validation.When(!day.Closed, validation.Required),
+			validation.Match(timeOfDayPattern).Error("must be a time of the day as HH:MM"),
+		),
+		validation.Field(
+			&day.Description,
+			validation.Length(0, 100),
+		),
+	)
+}
+
+// DateKey is the date of the calendar day as YYYY-MM-DD, the way the dates of the schedule are compared.
+func (day StoreCalendarDay) DateKey() string {
+	return day.Date.Format(time.DateOnly)
+}
+
+// StatusAt tells if the store is open at now. The hours of the day before are looked at too, as they may
+// go past midnight. A store that stays closed for longer than a year has no next opening.
+func (schedule StoreSchedule) StatusAt(now time.Time) StoreStatus {
+	location := schedule.location()
+	now = now.In(location)
+	year, month, day := now.Date()
+
+	for offset := -1; offset <= 0; offset++ {
+		opens, closes, ok := schedule.hoursOn(time.Date(year, month, day+offset, 0, 0, 0, 0, location))
+		if ok && !now.Before(opens) && now.Before(closes) {
+			return StoreStatus{Open: true}
+		}
+	}
+
+	for offset := 0; offset <= storeSearchDays; offset++ {
+		opens, _, ok := schedule.hoursOn(time.Date(year, month, day+offset, 0, 0, 0, 0, location))
+		if ok && opens.After(now) {
+			return StoreStatus{NextOpening: &opens}
+		}
+	}
+
+	return StoreStatus{}
+}
+
+// hoursOn is when the store opens and closes on the date, a calendar day taking the place of the weekly
+// hours.
+func (schedule StoreSchedule) hoursOn(date time.Time) (opens time.Time, closes time.Time, ok bool) {
+	openTime, closeTime, ok := schedule.timesOn(date)
+	if !ok {
+		return opens, closes, false
+	}
+
+	year, month, day := date.Date()
+	opens = time.Date(year, month, day, 0, minuteOfDay(openTime), 0, 0, date.Location())
+	closes = time.Date(year, month, day, 0, minuteOfDay(closeTime), 0, 0, date.Location())
+
+	if !closes.After(opens) {
+		closes = time.Date(year, month, day+1, 0, minuteOfDay(closeTime), 0, 0, date.Location())
+	}
+
+	return opens, closes, true
+}
+
+func (schedule StoreSchedule) timesOn(date time.Time) (openTime string, closeTime string, ok bool) {
+	dateKey := date.Format(time.DateOnly)
+	for _, day := range schedule.Calendar {
+		if day.DateKey() == dateKey {
+			return day.OpenTime, day.CloseTime, !day.Closed
+		}
+	}
+
+	if len(schedule.Hours) == 0 {
+		return "00:00", "00:00", true
+	}
+
+	for _, hours := range schedule.Hours {
+		if hours.Weekday == date.Weekday() {
+			return hours.OpenTime, hours.CloseTime, true
+		}
+	}
+
+	return "", "", false
+}
+
+func (schedule StoreSchedule) location() *time.Location {
+	if schedule.Location == nil {
+		return time.UTC
+	}
+
+	return schedule.Location
+}
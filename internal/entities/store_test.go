@@ -0,0 +1,98 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/8soat-grupo35/fastfood-order/internal/adapters/dto"
+	"github.com/stretchr/testify/assert"
+)
+
+var saoPaulo = time.FixedZone("America/Sao_Paulo", -3*60*60)
+
+// weekdaySchedule opens the store from 10:00 to 22:00 on weekdays and from 18:00 to 02:00 on Saturdays.
+func weekdaySchedule(calendar ...StoreCalendarDay) StoreSchedule {
+	hours := []StoreHours{{Weekday: time.Saturday, OpenTime: "18:00", CloseTime: "02:00"}}
+	for weekday := time.Monday; weekday <= time.Friday; weekday++ {
+		hours = append(hours, StoreHours{Weekday: weekday, OpenTime: "10:00", CloseTime: "22:00"})
+	}
+
+	return StoreSchedule{Hours: hours, Calendar: calendar, Location: saoPaulo}
+}
+
+func TestNewStoreHoursReturnsErrorsByDay(t *testing.T) {
+	_, err := NewStoreHours(dto.StoreHoursDto{Days: []dto.StoreDayHoursDto{
+		{Weekday: 1, OpenTime: " 10:00 ", CloseTime: "22:00"},
+		{Weekday: 7, OpenTime: "10h", CloseTime: "22:00"},
+		{Weekday: 1, OpenTime: "11:00", CloseTime: "23:00"},
+	}})
+
+	assert.EqualError(t, err, "days: (1: (open_time: must be a time of the day as HH:MM; weekday: must be no greater than 6.); 2: (weekday: must not repeat a day of the week.).).")
+}
+
+func TestNewStoreCalendarDayLeavesTheHoursOutOfClosedDays(t *testing.T) {
+	day, err := NewStoreCalendarDay(dto.StoreCalendarDayDto{Date: "2026-12-25", Closed: true, OpenTime: "10:00", Description: " Natal "})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "2026-12-25", day.DateKey())
+	assert.Empty(t, day.OpenTime)
+	assert.Equal(t, "Natal", day.Description)
+}
+
+func TestNewStoreCalendarDayReturnsErrorForInvalidDay(t *testing.T) {
+	_, err := NewStoreCalendarDay(dto.StoreCalendarDayDto{Date: "25/12/2026", OpenTime: "12:00"})
+
+	assert.EqualError(t, err, "close_time: cannot be blank; date: must be a date as YYYY-MM-DD.")
+}
+
+func TestStoreScheduleIsOpenWithinTheHours(t *testing.T) {
+	wednesdayNoon := time.Date(2026, time.October, 14, 12, 0, 0, 0, saoPaulo)
+
+	assert.Equal(t, StoreStatus{Open: true}, weekdaySchedule().StatusAt(wednesdayNoon))
+}
+
+func TestStoreScheduleTellsTheNextOpeningAtNight(t *testing.T) {
+	wednesdayNight := time.Date(2026, time.October, 14, 23, 30, 0, 0, saoPaulo)
+
+	status := weekdaySchedule().StatusAt(wednesdayNight.UTC())
+
+	assert.False(t, status.Open)
+	assert.Equal(t, time.Date(2026, time.October, 15, 10, 0, 0, 0, saoPaulo), status.NextOpening.In(saoPaulo))
+}
+
+func TestStoreScheduleStaysOpenPastMidnight(t *testing.T) {
+	sundayEarly := time.Date(2026, time.October, 18, 1, 30, 0, 0, saoPaulo)
+	sundayLate := time.Date(2026, time.October, 18, 2, 0, 0, 0, saoPaulo)
+
+	assert.True(t, weekdaySchedule().StatusAt(sundayEarly).Open)
+	assert.Equal(t, time.Date(2026, time.October, 19, 10, 0, 0, 0, saoPaulo), *weekdaySchedule().StatusAt(sundayLate).NextOpening)
+}
+
+func TestStoreScheduleClosesOnHolidays(t *testing.T) {
+	christmas := StoreCalendarDay{Date: time.Date(2026, time.December, 25, 0, 0, 0, 0, time.UTC), Closed: true}
+	christmasNoon := time.Date(2026, time.December, 25, 12, 0, 0, 0, saoPaulo)
+
+	status := weekdaySchedule(christmas).StatusAt(christmasNoon)
+
+	assert.False(t, status.Open)
+	assert.Equal(t, time.Date(2026, time.December, 26, 18, 0, 0, 0, saoPaulo), *status.NextOpening)
+}
+
+func TestStoreScheduleTakesTheHoursOfTheCalendarDay(t *testing.T) {
+	christmasEve := StoreCalendarDay{Date: time.Date(2026, time.December, 24, 0, 0, 0, 0, time.UTC), OpenTime: "10:00", CloseTime: "16:00"}
+
+	assert.True(t, weekdaySchedule(christmasEve).StatusAt(time.Date(2026, time.December, 24, 15, 0, 0, 0, saoPaulo)).Open)
+	assert.False(t, weekdaySchedule(christmasEve).StatusAt(time.Date(2026, time.December, 24, 17, 0, 0, 0, saoPaulo)).Open)
+}
+
+func TestStoreScheduleWithoutHoursIsAlwaysOpen(t *testing.T) {
+	schedule := StoreSchedule{Location: saoPaulo}
+
+	assert.True(t, schedule.StatusAt(time.Date(2026, time.October, 14, 3, 0, 0, 0, saoPaulo)).Open)
+}
+
+func TestStoreScheduleWithoutAnyOpeningHasNoNextOpening(t *testing.T) {
+	schedule := StoreSchedule{Hours: []StoreHours{{Weekday: 9, OpenTime: "10:00", CloseTime: "22:00"}}}
+
+	assert.Equal(t, StoreStatus{}, schedule.StatusAt(time.Now()))
+}
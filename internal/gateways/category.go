@@ -0,0 +1,116 @@
+package gateways
+
+import (
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+	"github.com/8soat-grupo35/fastfood-order/internal/interfaces/repository"
+	"log"
+
+	"gorm.io/gorm"
+)
+
+type categoryGateway struct {
+	orm *gorm.DB
+}
+
+func NewCategoryGateway(orm *gorm.DB) repository.CategoryRepository {
+	return &categoryGateway{orm: orm}
+}
+
+// GetAll lists the categories in the order the menu shows them.
+func (c *categoryGateway) GetAll(filter entities.Category) (categories []entities.Category, err error) {
+	result := c.orm.Where(filter).Order("display_order ASC").Order("name ASC").Find(&categories)
+
+	if result.Error != nil {
+		log.Println(result.Error)
+		return categories, result.Error
+	}
+
+	return categories, err
+}
+
+func (c *categoryGateway) GetOne(categoryFilter entities.Category) (category *entities.Category, err error) {
+	result := c.orm.Where(categoryFilter).First(&category)
+
+	if result.Error != nil {
+		log.Println(result.Error)
+		return nil, result.Error
+	}
+
+	return category, nil
+}
+
+func (c *categoryGateway) CountItems(categoryId uint32) (count int64, err error) {
+	result := c.orm.Model(&entities.Item{}).Where("category_id = ?", categoryId).Count(&count)
+
+	if result.Error != nil {
+		log.Println(result.Error)
+		return 0, result.Error
+	}
+
+	return count, nil
+}
+
+func (c *categoryGateway) Create(category entities.Category) (*entities.Category, error) {
+	result := c.orm.Create(&category)
+
+	if result.Error != nil {
+		log.Println(result.Error)
+		return nil, result.Error
+	}
+
+	return &category, nil
+}
+
+// Update renames the category on the items, combo slots and promotions too, as they refer to it by
+// name. The display order and the active flag are always written, so they can be set back to zero.
+func (c *categoryGateway) Update(categoryId uint32, category entities.Category) (*entities.Category, error) {
+	categoryModel := entities.Category{ID: categoryId}
+	err := c.orm.Transaction(func(tx *gorm.DB) error {
+		current := entities.Category{}
+		if err := tx.First(&current, categoryId).Error; err != nil {
+			return err
+		}
+
+		if current.Name != category.Name {
+			if err := renameCategory(tx, categoryId, current.Name, category.Name); err != nil {
+				return err
+			}
+		}
+
+		return tx.Model(&categoryModel).
+			Select("name", "display_order", "active", "image_url").
+			Updates(&category).Error
+	})
+
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	category.ID = categoryId
+
+	return &category, nil
+}
+
+func renameCategory(tx *gorm.DB, categoryId uint32, oldName string, newName string) error {
+	if err := tx.Model(&entities.Item{}).Where("category_id = ?", categoryId).Update("category", newName).Error; err != nil {
+		return err
+	}
+
+	if err := tx.Model(&entities.ComboSlot{}).Where("category = ?", oldName).Update("category", newName).Error; err != nil {
+		return err
+	}
+
+	return tx.Model(&entities.Promotion{}).Where("category = ?", oldName).Update("category", newName).Error
+}
+
+func (c *categoryGateway) Delete(categoryId uint32) error {
+	result := c.orm.Delete(&entities.Category{}, categoryId)
+
+	if result.Error != nil {
+		log.Println(result.Error)
+		return result.Error
+	}
+
+	return nil
+}
@@ -0,0 +1,152 @@
+package gateways
+
+import (
+	"database/sql"
+	"errors"
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"testing"
+)
+
+type CategoryRepositorySuite struct {
+	suite.Suite
+	conn *sql.DB
+	DB   *gorm.DB
+	mock sqlmock.Sqlmock
+
+	repo     *categoryGateway
+	category entities.Category
+}
+
+func (rs *CategoryRepositorySuite) SetupSuite() {
+	var (
+		err error
+	)
+
+	rs.conn, rs.mock, err = sqlmock.New()
+	assert.NoError(rs.T(), err)
+
+	dialector := postgres.New(postgres.Config{
+		DriverName: "postgres",
+		Conn:       rs.conn,
+	})
+
+	rs.DB, err = gorm.Open(dialector, &gorm.Config{})
+	assert.NoError(rs.T(), err)
+
+	rs.repo = &categoryGateway{rs.DB}
+
+	rs.category = entities.Category{
+		ID:           5,
+		Name:         "CAFÉ DA MANHÃ",
+		DisplayOrder: 5,
+		Active:       true,
+	}
+}
+
+func (rs *CategoryRepositorySuite) TestGetAllInMenuOrder() {
+	expectedSQL := "SELECT (.+) FROM \"categories\" WHERE \"categories\".\"active\" = \\$1 AND \"categories\".\"deleted_at\" IS NULL ORDER BY display_order ASC,name ASC"
+	rows := sqlmock.NewRows([]string{"id", "name", "display_order", "active"}).
+		AddRow(1, "LANCHE", 1, true).
+		AddRow(2, "BEBIDA", 3, true)
+	rs.mock.ExpectQuery(expectedSQL).WithArgs(true).WillReturnRows(rows)
+
+	categories, err := rs.repo.GetAll(entities.Category{Active: true})
+	assert.NoError(rs.T(), err)
+	assert.Len(rs.T(), categories, 2)
+	assert.Equal(rs.T(), "LANCHE", categories[0].Name)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *CategoryRepositorySuite) TestGetOne_shouldNotFound() {
+	expectedSQL := "SELECT (.+) FROM \"categories\" WHERE \"categories\".\"name\" = \\$1 (.+) LIMIT (.+)"
+	rs.mock.ExpectQuery(expectedSQL).WithArgs("BRUNCH", 1).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	category, err := rs.repo.GetOne(entities.Category{Name: "BRUNCH"})
+	assert.Nil(rs.T(), category)
+	assert.ErrorIs(rs.T(), err, gorm.ErrRecordNotFound)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *CategoryRepositorySuite) TestCountItems() {
+	expectedSQL := "SELECT count\\(\\*\\) FROM \"items\" WHERE category_id = \\$1 AND \"items\".\"deleted_at\" IS NULL"
+	rs.mock.ExpectQuery(expectedSQL).WithArgs(rs.category.ID).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+
+	count, err := rs.repo.CountItems(rs.category.ID)
+	assert.NoError(rs.T(), err)
+	assert.Equal(rs.T(), int64(3), count)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *CategoryRepositorySuite) TestCreate() {
+	rs.mock.ExpectBegin()
+	rs.mock.ExpectQuery("INSERT INTO \"categories\" (.+) VALUES (.+)").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(5))
+	rs.mock.ExpectCommit()
+
+	_, err := rs.repo.Create(rs.category)
+	assert.NoError(rs.T(), err)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *CategoryRepositorySuite) TestUpdateRenamesTheCategoryWhereItIsReferenced() {
+	current := sqlmock.NewRows([]string{"id", "name"}).AddRow(5, "CAFE")
+	expectedSQL := "UPDATE \"categories\" SET \"name\"=\\$1,\"display_order\"=\\$2,\"active\"=\\$3,\"image_url\"=\\$4,\"updated_at\"=\\$5 WHERE (.+)"
+	rs.mock.ExpectBegin()
+	rs.mock.ExpectQuery("SELECT (.+) FROM \"categories\" WHERE \"categories\".\"id\" = \\$1 (.+)").WithArgs(rs.category.ID, 1).WillReturnRows(current)
+	rs.mock.ExpectExec("UPDATE \"items\" SET \"category\"=\\$1,\"updated_at\"=\\$2 WHERE category_id = \\$3 (.+)").WithArgs(rs.category.Name, sqlmock.AnyArg(), rs.category.ID).WillReturnResult(sqlmock.NewResult(0, 4))
+	rs.mock.ExpectExec("UPDATE \"combo_slots\" SET \"category\"=\\$1 WHERE category = \\$2").WithArgs(rs.category.Name, "CAFE").WillReturnResult(sqlmock.NewResult(0, 1))
+	rs.mock.ExpectExec("UPDATE \"promotions\" SET \"category\"=\\$1,\"updated_at\"=\\$2 WHERE category = \\$3 (.+)").WithArgs(rs.category.Name, sqlmock.AnyArg(), "CAFE").WillReturnResult(sqlmock.NewResult(0, 0))
+	rs.mock.ExpectExec(expectedSQL).WithArgs(rs.category.Name, rs.category.DisplayOrder, rs.category.Active, rs.category.ImageUrl, sqlmock.AnyArg(), rs.category.ID).WillReturnResult(sqlmock.NewResult(0, 1))
+	rs.mock.ExpectCommit()
+
+	updatedCategory, err := rs.repo.Update(rs.category.ID, rs.category)
+	assert.NoError(rs.T(), err)
+	assert.Equal(rs.T(), rs.category.ID, updatedCategory.ID)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *CategoryRepositorySuite) TestUpdateWritesInactiveFlag() {
+	category := rs.category
+	category.Active = false
+
+	current := sqlmock.NewRows([]string{"id", "name"}).AddRow(5, category.Name)
+	rs.mock.ExpectBegin()
+	rs.mock.ExpectQuery("SELECT (.+) FROM \"categories\" (.+)").WillReturnRows(current)
+	rs.mock.ExpectExec("UPDATE \"categories\" SET (.+)").WithArgs(category.Name, category.DisplayOrder, false, category.ImageUrl, sqlmock.AnyArg(), category.ID).WillReturnResult(sqlmock.NewResult(0, 1))
+	rs.mock.ExpectCommit()
+
+	_, err := rs.repo.Update(category.ID, category)
+	assert.NoError(rs.T(), err)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *CategoryRepositorySuite) TestUpdateReturnsErrorOnRenameFailure() {
+	current := sqlmock.NewRows([]string{"id", "name"}).AddRow(5, "CAFE")
+	rs.mock.ExpectBegin()
+	rs.mock.ExpectQuery("SELECT (.+) FROM \"categories\" (.+)").WillReturnRows(current)
+	rs.mock.ExpectExec("UPDATE \"items\" SET (.+)").WillReturnError(errors.New("update error"))
+	rs.mock.ExpectRollback()
+
+	_, err := rs.repo.Update(rs.category.ID, rs.category)
+	assert.EqualError(rs.T(), err, "update error")
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *CategoryRepositorySuite) TestDelete() {
+	expectedSQL := "UPDATE \"categories\" SET \"deleted_at\"=.+ WHERE \"categories\".\"id\" =.+ AND \"categories\".\"deleted_at\" IS NULL"
+	rs.mock.ExpectBegin()
+	rs.mock.ExpectExec(expectedSQL).WillReturnResult(sqlmock.NewResult(1, 1))
+	rs.mock.ExpectCommit()
+
+	err := rs.repo.Delete(rs.category.ID)
+	assert.NoError(rs.T(), err)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func TestCategoryRepositorySuite(t *testing.T) {
+	suite.Run(t, new(CategoryRepositorySuite))
+}
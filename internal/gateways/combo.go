@@ -0,0 +1,110 @@
+package gateways
+
+import (
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+	"github.com/8soat-grupo35/fastfood-order/internal/interfaces/repository"
+	"log"
+
+	"gorm.io/gorm"
+)
+
+type comboGateway struct {
+	orm *gorm.DB
+}
+
+func NewComboGateway(orm *gorm.DB) repository.ComboRepository {
+	return &comboGateway{orm: orm}
+}
+
+func (c *comboGateway) GetAll() (combos []entities.Combo, err error) {
+	result := c.orm.Preload("Slots.Items").Order("id ASC").Find(&combos)
+
+	if result.Error != nil {
+		log.Println(result.Error)
+		return combos, result.Error
+	}
+
+	return combos, err
+}
+
+func (c *comboGateway) GetOne(comboFilter entities.Combo) (combo *entities.Combo, err error) {
+	result := c.orm.Preload("Slots.Items").Where(comboFilter).First(&combo)
+
+	if result.Error != nil {
+		log.Println(result.Error)
+		return nil, result.Error
+	}
+
+	return combo, nil
+}
+
+func (c *comboGateway) GetByIds(ids []uint32) (combos []entities.Combo, err error) {
+	result := c.orm.Preload("Slots.Items").Where("id IN ?", ids).Find(&combos)
+
+	if result.Error != nil {
+		log.Println(result.Error)
+		return combos, result.Error
+	}
+
+	return combos, err
+}
+
+func (c *comboGateway) Create(combo entities.Combo) (*entities.Combo, error) {
+	result := c.orm.Create(&combo)
+
+	if result.Error != nil {
+		log.Println(result.Error)
+		return nil, result.Error
+	}
+
+	return &combo, nil
+}
+
+// Update replaces the slots of the combo. Order lines keep the combo but not its slots, so the slots
+// are recreated instead of matched. The pricing columns are always written, since switching the
+// pricing rule zeroes the one no longer used.
+func (c *comboGateway) Update(comboId uint32, combo entities.Combo) (*entities.Combo, error) {
+	comboModel := entities.Combo{ID: comboId}
+	err := c.orm.Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&comboModel).
+			Select("name", "image_url", "pricing_rule", "price", "percentage_off").
+			Updates(&combo).Error
+		if err != nil {
+			return err
+		}
+
+		if err := tx.Where("combo_id = ?", comboId).Delete(&entities.ComboSlot{}).Error; err != nil {
+			return err
+		}
+
+		if len(combo.Slots) == 0 {
+			return nil
+		}
+
+		for i := range combo.Slots {
+			combo.Slots[i].ComboID = comboId
+		}
+
+		return tx.Create(&combo.Slots).Error
+	})
+
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	combo.ID = comboId
+
+	return &combo, nil
+}
+
+func (c *comboGateway) Delete(comboId uint32) error {
+	result := c.orm.Delete(&entities.Combo{}, comboId)
+
+	if result.Error != nil {
+		log.Println(result.Error)
+		return result.Error
+	}
+
+	return nil
+}
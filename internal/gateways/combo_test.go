@@ -0,0 +1,143 @@
+package gateways
+
+import (
+	"database/sql"
+	"errors"
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"testing"
+)
+
+type ComboRepositorySuite struct {
+	suite.Suite
+	conn *sql.DB
+	DB   *gorm.DB
+	mock sqlmock.Sqlmock
+
+	repo  *comboGateway
+	combo entities.Combo
+}
+
+func (rs *ComboRepositorySuite) SetupSuite() {
+	var (
+		err error
+	)
+
+	rs.conn, rs.mock, err = sqlmock.New()
+	assert.NoError(rs.T(), err)
+
+	dialector := postgres.New(postgres.Config{
+		DriverName: "postgres",
+		Conn:       rs.conn,
+	})
+
+	rs.DB, err = gorm.Open(dialector, &gorm.Config{})
+	assert.NoError(rs.T(), err)
+
+	rs.repo = &comboGateway{rs.DB}
+
+	rs.combo = entities.Combo{
+		ID:          7,
+		Name:        "Combo Classico",
+		PricingRule: entities.COMBO_FIXED_PRICE,
+		Price:       35,
+		Slots: []entities.ComboSlot{
+			{Category: "LANCHE", Items: []entities.ComboSlotItem{{ItemID: 1}}},
+			{Category: "BEBIDA", Items: []entities.ComboSlotItem{{ItemID: 3}}},
+		},
+	}
+}
+
+func (rs *ComboRepositorySuite) TestGetAll() {
+	expectedSQL := "SELECT (.+) FROM \"combos\" WHERE \"combos\".\"deleted_at\" IS NULL ORDER BY id ASC"
+	rs.mock.ExpectQuery(expectedSQL).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(7))
+
+	expectedSlotsSQL := "SELECT (.+) FROM \"combo_slots\" WHERE \"combo_slots\".\"combo_id\" = (.+)"
+	rs.mock.ExpectQuery(expectedSlotsSQL).WillReturnRows(sqlmock.NewRows([]string{"id", "combo_id", "category"}).AddRow(1, 7, "LANCHE"))
+
+	expectedSlotItemsSQL := "SELECT (.+) FROM \"combo_slot_items\" WHERE \"combo_slot_items\".\"combo_slot_id\" = (.+)"
+	rs.mock.ExpectQuery(expectedSlotItemsSQL).WillReturnRows(sqlmock.NewRows([]string{"id", "combo_slot_id", "item_id"}).AddRow(1, 1, 1))
+
+	combos, err := rs.repo.GetAll()
+	assert.NoError(rs.T(), err)
+	assert.Equal(rs.T(), uint32(1), combos[0].Slots[0].Items[0].ItemID)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *ComboRepositorySuite) TestGetByIdsReturnsErrorOnQueryFailure() {
+	expectedSQL := "SELECT (.+) FROM \"combos\" WHERE id IN (.+)"
+	rs.mock.ExpectQuery(expectedSQL).WillReturnError(errors.New("query error"))
+
+	_, err := rs.repo.GetByIds([]uint32{7})
+	assert.Error(rs.T(), err)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *ComboRepositorySuite) TestGetOne_shouldNotFound() {
+	expectedSQL := "SELECT (.+) FROM \"combos\" WHERE (.+) LIMIT (.+)"
+	rs.mock.ExpectQuery(expectedSQL).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	combo, err := rs.repo.GetOne(entities.Combo{ID: 9})
+	assert.Nil(rs.T(), combo)
+	assert.ErrorIs(rs.T(), err, gorm.ErrRecordNotFound)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *ComboRepositorySuite) TestCreateStoresSlotsAndTheirItems() {
+	rs.mock.ExpectBegin()
+	rs.mock.ExpectQuery("INSERT INTO \"combos\" (.+)").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(7))
+	rs.mock.ExpectQuery("INSERT INTO \"combo_slots\" (.+) ON CONFLICT (.+)").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1).AddRow(2))
+	rs.mock.ExpectQuery("INSERT INTO \"combo_slot_items\" (.+) ON CONFLICT (.+)").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1).AddRow(2))
+	rs.mock.ExpectCommit()
+
+	_, err := rs.repo.Create(rs.combo)
+	assert.NoError(rs.T(), err)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *ComboRepositorySuite) TestUpdateWritesPricingAndRecreatesSlots() {
+	combo := rs.combo
+	combo.Slots = []entities.ComboSlot{{Category: "LANCHE", Items: []entities.ComboSlotItem{{ItemID: 1}}}}
+
+	expectedSQL := "UPDATE \"combos\" SET \"name\"=\\$1,\"image_url\"=\\$2,\"pricing_rule\"=\\$3,\"price\"=\\$4,\"percentage_off\"=\\$5,\"updated_at\"=\\$6 WHERE (.+)"
+	rs.mock.ExpectBegin()
+	rs.mock.ExpectExec(expectedSQL).WillReturnResult(sqlmock.NewResult(0, 1))
+	rs.mock.ExpectExec("DELETE FROM \"combo_slots\" WHERE combo_id = \\$1").WithArgs(combo.ID).WillReturnResult(sqlmock.NewResult(0, 2))
+	rs.mock.ExpectQuery("INSERT INTO \"combo_slots\" (.+)").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3))
+	rs.mock.ExpectQuery("INSERT INTO \"combo_slot_items\" (.+)").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3))
+	rs.mock.ExpectCommit()
+
+	updatedCombo, err := rs.repo.Update(combo.ID, combo)
+	assert.NoError(rs.T(), err)
+	assert.Equal(rs.T(), combo.ID, updatedCombo.Slots[0].ComboID)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *ComboRepositorySuite) TestUpdateReturnsErrorOnUpdateFailure() {
+	rs.mock.ExpectBegin()
+	rs.mock.ExpectExec("UPDATE \"combos\" SET .+").WillReturnError(errors.New("update error"))
+	rs.mock.ExpectRollback()
+
+	_, err := rs.repo.Update(rs.combo.ID, rs.combo)
+	assert.Error(rs.T(), err)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *ComboRepositorySuite) TestDelete() {
+	expectedSQL := "UPDATE \"combos\" SET \"deleted_at\"=.+ WHERE \"combos\".\"id\" =.+ AND \"combos\".\"deleted_at\" IS NULL"
+	rs.mock.ExpectBegin()
+	rs.mock.ExpectExec(expectedSQL).WillReturnResult(sqlmock.NewResult(1, 1))
+	rs.mock.ExpectCommit()
+
+	err := rs.repo.Delete(rs.combo.ID)
+	assert.NoError(rs.T(), err)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func TestComboRepositorySuite(t *testing.T) {
+	suite.Run(t, new(ComboRepositorySuite))
+}
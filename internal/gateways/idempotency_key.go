@@ -0,0 +1,101 @@
+package gateways
+
+import (
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+	"github.com/8soat-grupo35/fastfood-order/internal/interfaces/repository"
+	"log"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type idempotencyKeyGateway struct {
+	orm *gorm.DB
+}
+
+func NewIdempotencyKeyGateway(orm *gorm.DB) repository.IdempotencyKeyRepository {
+	return &idempotencyKeyGateway{orm: orm}
+}
+
+// Reserve inserts the key when it is new, or takes it over when it expired or the request holding it let
+// its lease run out without finishing. When another request still holds it, or its response is still
+// replayed, the stored key is returned and the second value is false.
+func (c *idempotencyKeyGateway) Reserve(idempotencyKey entities.IdempotencyKey) (*entities.IdempotencyKey, bool, error) {
+	now := time.Now()
+
+	result := c.orm.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "idempotency_key"}, {Name: "scope"}},
+		DoUpdates: clause.AssignmentColumns([]string{
+			"request_hash", "response_status", "response_body", "locked_until", "expires_at", "created_at", "updated_at",
+		}),
+		Where: clause.Where{Exprs: []clause.Expression{
+			clause.Expr{
+				SQL:  "idempotency_keys.expires_at <= ? OR (idempotency_keys.response_status = 0 AND idempotency_keys.locked_until <= ?)",
+				Vars: []interface{}{now, now},
+			},
+		}},
+	}).Create(&idempotencyKey)
+
+	if result.Error != nil {
+		log.Println(result.Error)
+		return nil, false, result.Error
+	}
+
+	if result.RowsAffected == 1 {
+		return &idempotencyKey, true, nil
+	}
+
+	storedKey := entities.IdempotencyKey{}
+	result = c.orm.
+		Where("idempotency_key = ? AND scope = ?", idempotencyKey.Key, idempotencyKey.Scope).
+		First(&storedKey)
+
+	if result.Error != nil {
+		log.Println(result.Error)
+		return nil, false, result.Error
+	}
+
+	return &storedKey, false, nil
+}
+
+func (c *idempotencyKeyGateway) Complete(idempotencyKey entities.IdempotencyKey) error {
+	result := c.orm.Model(&idempotencyKey).Updates(map[string]interface{}{
+		"response_status": idempotencyKey.ResponseStatus,
+		"response_body":   idempotencyKey.ResponseBody,
+		"expires_at":      idempotencyKey.ExpiresAt,
+	})
+
+	if result.Error != nil {
+		log.Println(result.Error)
+		return result.Error
+	}
+
+	return nil
+}
+
+func (c *idempotencyKeyGateway) Release(idempotencyKey entities.IdempotencyKey) error {
+	result := c.orm.Delete(&entities.IdempotencyKey{}, idempotencyKey.ID)
+
+	if result.Error != nil {
+		log.Println(result.Error)
+		return result.Error
+	}
+
+	return nil
+}
+
+// DeleteExpired deletes the keys whose responses are no longer replayed and the ones whose request let
+// its lease run out before now.
+func (c *idempotencyKeyGateway) DeleteExpired(now time.Time) error {
+	result := c.orm.
+		Where("expires_at <= ? OR (response_status = 0 AND locked_until <= ?)", now, now).
+		Delete(&entities.IdempotencyKey{})
+
+	if result.Error != nil {
+		log.Println(result.Error)
+		return result.Error
+	}
+
+	return nil
+}
@@ -0,0 +1,161 @@
+package gateways
+
+import (
+	"database/sql"
+	"errors"
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"testing"
+	"time"
+)
+
+type IdempotencyKeyRepositorySuite struct {
+	suite.Suite
+	conn *sql.DB
+	DB   *gorm.DB
+	mock sqlmock.Sqlmock
+
+	repo           *idempotencyKeyGateway
+	idempotencyKey entities.IdempotencyKey
+}
+
+func (rs *IdempotencyKeyRepositorySuite) SetupSuite() {
+	var (
+		err error
+	)
+
+	rs.conn, rs.mock, err = sqlmock.New()
+	assert.NoError(rs.T(), err)
+
+	dialector := postgres.New(postgres.Config{
+		DriverName: "postgres",
+		Conn:       rs.conn,
+	})
+
+	rs.DB, err = gorm.Open(dialector, &gorm.Config{})
+	assert.NoError(rs.T(), err)
+
+	rs.repo = &idempotencyKeyGateway{rs.DB}
+	assert.IsType(rs.T(), &idempotencyKeyGateway{}, rs.repo)
+
+	rs.idempotencyKey = entities.IdempotencyKey{
+		Key:         "totem-1-abc",
+		Scope:       "POST /v1/orders/checkout",
+		RequestHash: entities.HashRequest([]byte(`{}`)),
+	}
+}
+
+func (rs *IdempotencyKeyRepositorySuite) TestReserveNewKey() {
+	expectedSQL := "INSERT INTO \"idempotency_keys\" (.+) VALUES (.+) ON CONFLICT \\(\"idempotency_key\",\"scope\"\\) DO UPDATE SET (.+) WHERE (.+) RETURNING \"id\""
+	addRow := sqlmock.NewRows([]string{"id"}).AddRow("1")
+	rs.mock.ExpectBegin()
+	rs.mock.ExpectQuery(expectedSQL).WillReturnRows(addRow)
+	rs.mock.ExpectCommit()
+
+	idempotencyKey, created, err := rs.repo.Reserve(rs.idempotencyKey)
+	assert.NoError(rs.T(), err)
+	assert.True(rs.T(), created)
+	assert.Equal(rs.T(), uint32(1), idempotencyKey.ID)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *IdempotencyKeyRepositorySuite) TestReserveTakesOverExpiredKeyAndAbandonedLease() {
+	expectedSQL := "INSERT INTO \"idempotency_keys\" (.+) ON CONFLICT (.+) DO UPDATE SET (.+) WHERE idempotency_keys.expires_at <= \\$10 OR \\(idempotency_keys.response_status = 0 AND idempotency_keys.locked_until <= \\$11\\)"
+	rs.mock.ExpectBegin()
+	rs.mock.ExpectQuery(expectedSQL).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("3"))
+	rs.mock.ExpectCommit()
+
+	idempotencyKey, created, err := rs.repo.Reserve(rs.idempotencyKey)
+	assert.NoError(rs.T(), err)
+	assert.True(rs.T(), created)
+	assert.Equal(rs.T(), uint32(3), idempotencyKey.ID)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *IdempotencyKeyRepositorySuite) TestReserveExistingKey() {
+	expectedInsertSQL := "INSERT INTO \"idempotency_keys\" (.+) VALUES (.+) ON CONFLICT (.+) DO UPDATE SET (.+)"
+	expectedSelectSQL := "SELECT (.+) FROM \"idempotency_keys\" WHERE (.+) LIMIT (.+)"
+	storedRow := sqlmock.NewRows([]string{"id", "idempotency_key", "response_status"}).AddRow("1", "totem-1-abc", 200)
+	rs.mock.ExpectBegin()
+	rs.mock.ExpectQuery(expectedInsertSQL).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	rs.mock.ExpectCommit()
+	rs.mock.ExpectQuery(expectedSelectSQL).WithArgs("totem-1-abc", "POST /v1/orders/checkout", 1).WillReturnRows(storedRow)
+
+	idempotencyKey, created, err := rs.repo.Reserve(rs.idempotencyKey)
+	assert.NoError(rs.T(), err)
+	assert.False(rs.T(), created)
+	assert.Equal(rs.T(), 200, idempotencyKey.ResponseStatus)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *IdempotencyKeyRepositorySuite) TestReserveReturnsErrorOnInsertFailure() {
+	expectedSQL := "INSERT INTO \"idempotency_keys\" (.+) VALUES (.+)"
+	rs.mock.ExpectBegin()
+	rs.mock.ExpectQuery(expectedSQL).WillReturnError(errors.New("insert error"))
+	rs.mock.ExpectRollback()
+
+	_, _, err := rs.repo.Reserve(rs.idempotencyKey)
+	assert.Error(rs.T(), err)
+	assert.Equal(rs.T(), "insert error", err.Error())
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *IdempotencyKeyRepositorySuite) TestComplete() {
+	expectedSQL := "UPDATE \"idempotency_keys\" SET (.+) WHERE \"id\" = (.+)"
+	rs.mock.ExpectBegin()
+	rs.mock.ExpectExec(expectedSQL).WillReturnResult(sqlmock.NewResult(1, 1))
+	rs.mock.ExpectCommit()
+
+	idempotencyKey := rs.idempotencyKey
+	idempotencyKey.ID = 1
+	idempotencyKey.Complete(200, []byte(`{"id":1}`))
+
+	err := rs.repo.Complete(idempotencyKey)
+	assert.NoError(rs.T(), err)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *IdempotencyKeyRepositorySuite) TestRelease() {
+	expectedSQL := "DELETE FROM \"idempotency_keys\" WHERE (.+)"
+	rs.mock.ExpectBegin()
+	rs.mock.ExpectExec(expectedSQL).WillReturnResult(sqlmock.NewResult(1, 1))
+	rs.mock.ExpectCommit()
+
+	idempotencyKey := rs.idempotencyKey
+	idempotencyKey.ID = 1
+
+	err := rs.repo.Release(idempotencyKey)
+	assert.NoError(rs.T(), err)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *IdempotencyKeyRepositorySuite) TestDeleteExpired() {
+	now := time.Now()
+	expectedSQL := "DELETE FROM \"idempotency_keys\" WHERE expires_at <= \\$1 OR \\(response_status = 0 AND locked_until <= \\$2\\)"
+	rs.mock.ExpectBegin()
+	rs.mock.ExpectExec(expectedSQL).WithArgs(now, now).WillReturnResult(sqlmock.NewResult(0, 4))
+	rs.mock.ExpectCommit()
+
+	err := rs.repo.DeleteExpired(now)
+	assert.NoError(rs.T(), err)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *IdempotencyKeyRepositorySuite) TestDeleteExpiredReturnsErrorOnDeleteFailure() {
+	rs.mock.ExpectBegin()
+	rs.mock.ExpectExec("DELETE FROM \"idempotency_keys\" WHERE (.+)").WillReturnError(errors.New("delete error"))
+	rs.mock.ExpectRollback()
+
+	err := rs.repo.DeleteExpired(time.Now())
+	assert.Error(rs.T(), err)
+	assert.Equal(rs.T(), "delete error", err.Error())
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func TestIdempotencyKeySuite(t *testing.T) {
+	suite.Run(t, new(IdempotencyKeyRepositorySuite))
+}
@@ -0,0 +1,127 @@
+package gateways
+
+import (
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+	"github.com/8soat-grupo35/fastfood-order/internal/interfaces/repository"
+	"log"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ingredientGateway struct {
+	orm *gorm.DB
+}
+
+func NewIngredientGateway(orm *gorm.DB) repository.IngredientRepository {
+	return &ingredientGateway{orm: orm}
+}
+
+func (c *ingredientGateway) GetAll() (ingredients []entities.Ingredient, err error) {
+	result := c.orm.Preload("Stocks").Order("name ASC").Find(&ingredients)
+
+	if result.Error != nil {
+		log.Println(result.Error)
+		return ingredients, result.Error
+	}
+
+	return ingredients, err
+}
+
+func (c *ingredientGateway) GetOne(ingredientFilter entities.Ingredient) (ingredient *entities.Ingredient, err error) {
+	result := c.orm.Where(ingredientFilter).First(&ingredient)
+
+	if result.Error != nil {
+		log.Println(result.Error)
+		return nil, result.Error
+	}
+
+	return ingredient, nil
+}
+
+func (c *ingredientGateway) GetByIds(ids []uint32) (ingredients []entities.Ingredient, err error) {
+	result := c.orm.Where("id IN ?", ids).Find(&ingredients)
+
+	if result.Error != nil {
+		log.Println(result.Error)
+		return ingredients, result.Error
+	}
+
+	return ingredients, err
+}
+
+// GetLowStock lists the ingredients below their low stock threshold at the store, the closest to running
+// out first. Ingredients without a stock at the store have none left there.
+func (c *ingredientGateway) GetLowStock(storeId uint32) (ingredients []entities.Ingredient, err error) {
+	result := c.orm.
+		Preload("Stocks", "store_id = ?", storeId).
+		Joins("LEFT JOIN store_ingredient_stocks ON store_ingredient_stocks.ingredient_id = ingredients.id AND store_ingredient_stocks.store_id = ?", storeId).
+		Where("COALESCE(store_ingredient_stocks.stock, 0) < ingredients.low_stock_threshold").
+		Order("COALESCE(store_ingredient_stocks.stock, 0) / ingredients.low_stock_threshold ASC").
+		Order("ingredients.name ASC").
+		Find(&ingredients)
+
+	if result.Error != nil {
+		log.Println(result.Error)
+		return ingredients, result.Error
+	}
+
+	return ingredients, err
+}
+
+func (c *ingredientGateway) Create(ingredient entities.Ingredient) (*entities.Ingredient, error) {
+	result := c.orm.Create(&ingredient)
+
+	if result.Error != nil {
+		log.Println(result.Error)
+		return nil, result.Error
+	}
+
+	return &ingredient, nil
+}
+
+// Update writes the stock at the store and the threshold even when they are zero, so a count of the
+// stock that found nothing left is stored. The stock at other stores is left as it is.
+func (c *ingredientGateway) Update(storeId uint32, ingredientId uint32, ingredient entities.Ingredient) (*entities.Ingredient, error) {
+	ingredientModel := entities.Ingredient{ID: ingredientId}
+	err := c.orm.Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&ingredientModel).
+			Select("name", "unit", "low_stock_threshold").
+			Updates(&ingredient).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "store_id"}, {Name: "ingredient_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"stock"}),
+		}).Create(&entities.StoreIngredientStock{StoreID: storeId, IngredientID: ingredientId, Stock: ingredient.Stock}).Error
+	})
+
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	ingredient.ID = ingredientId
+
+	return &ingredient, nil
+}
+
+// Delete takes the ingredient out of the recipes too, so the items that used it do not run out of it.
+func (c *ingredientGateway) Delete(ingredientId uint32) error {
+	err := c.orm.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("ingredient_id = ?", ingredientId).Delete(&entities.RecipeIngredient{}).Error; err != nil {
+			return err
+		}
+
+		return tx.Delete(&entities.Ingredient{}, ingredientId).Error
+	})
+
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+
+	return nil
+}
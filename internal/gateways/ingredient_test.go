@@ -0,0 +1,174 @@
+package gateways
+
+import (
+	"database/sql"
+	"errors"
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"testing"
+)
+
+type IngredientRepositorySuite struct {
+	suite.Suite
+	conn *sql.DB
+	DB   *gorm.DB
+	mock sqlmock.Sqlmock
+
+	repo       *ingredientGateway
+	ingredient entities.Ingredient
+}
+
+func (rs *IngredientRepositorySuite) SetupSuite() {
+	var (
+		err error
+	)
+
+	rs.conn, rs.mock, err = sqlmock.New()
+	assert.NoError(rs.T(), err)
+
+	dialector := postgres.New(postgres.Config{
+		DriverName: "postgres",
+		Conn:       rs.conn,
+	})
+
+	rs.DB, err = gorm.Open(dialector, &gorm.Config{})
+	assert.NoError(rs.T(), err)
+
+	rs.repo = &ingredientGateway{rs.DB}
+
+	rs.ingredient = entities.Ingredient{
+		ID:                5,
+		Name:              "Queijo",
+		Unit:              entities.INGREDIENT_GRAM,
+		Stock:             2000000,
+		LowStockThreshold: 500000,
+	}
+}
+
+func (rs *IngredientRepositorySuite) TestGetAll() {
+	expectedSQL := "SELECT (.+) FROM \"ingredients\" WHERE \"ingredients\".\"deleted_at\" IS NULL ORDER BY name ASC"
+	rs.mock.ExpectQuery(expectedSQL).WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(5, "Queijo"))
+	rs.mock.ExpectQuery("SELECT (.+) FROM \"store_ingredient_stocks\" WHERE \"store_ingredient_stocks\".\"ingredient_id\" = \\$1").
+		WithArgs(5).
+		WillReturnRows(sqlmock.NewRows([]string{"store_id", "ingredient_id", "stock"}).AddRow(1, 5, 2000).AddRow(2, 5, 300))
+
+	ingredients, err := rs.repo.GetAll()
+	assert.NoError(rs.T(), err)
+	assert.Equal(rs.T(), "Queijo", ingredients[0].Name)
+	assert.Len(rs.T(), ingredients[0].Stocks, 2)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *IngredientRepositorySuite) TestGetLowStockListsTheScarcestFirst() {
+	expectedSQL := "SELECT (.+) FROM \"ingredients\" LEFT JOIN store_ingredient_stocks ON store_ingredient_stocks.ingredient_id = ingredients.id AND store_ingredient_stocks.store_id = \\$1 " +
+		"WHERE COALESCE\\(store_ingredient_stocks.stock, 0\\) < ingredients.low_stock_threshold AND \"ingredients\".\"deleted_at\" IS NULL " +
+		"ORDER BY COALESCE\\(store_ingredient_stocks.stock, 0\\) / ingredients.low_stock_threshold ASC,ingredients.name ASC"
+	rows := sqlmock.NewRows([]string{"id", "name", "low_stock_threshold"}).
+		AddRow(7, "Pão", 40).
+		AddRow(5, "Queijo", 500)
+	rs.mock.ExpectQuery(expectedSQL).WithArgs(2).WillReturnRows(rows)
+	rs.mock.ExpectQuery("SELECT (.+) FROM \"store_ingredient_stocks\" WHERE \"store_ingredient_stocks\".\"ingredient_id\" IN \\(\\$1,\\$2\\) AND store_id = \\$3").
+		WithArgs(7, 5, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"store_id", "ingredient_id", "stock"}).AddRow(2, 5, 400))
+
+	ingredients, err := rs.repo.GetLowStock(2)
+	assert.NoError(rs.T(), err)
+	assert.Len(rs.T(), ingredients, 2)
+	assert.Equal(rs.T(), "Pão", ingredients[0].Name)
+	assert.Empty(rs.T(), ingredients[0].Stocks)
+	assert.Equal(rs.T(), []entities.StoreIngredientStock{{StoreID: 2, IngredientID: 5, Stock: 400000}}, ingredients[1].Stocks)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *IngredientRepositorySuite) TestGetLowStockReturnsErrorOnQueryFailure() {
+	rs.mock.ExpectQuery("SELECT (.+) FROM \"ingredients\" (.+)").WillReturnError(errors.New("query error"))
+
+	_, err := rs.repo.GetLowStock(2)
+	assert.Error(rs.T(), err)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *IngredientRepositorySuite) TestGetOne_shouldNotFound() {
+	expectedSQL := "SELECT (.+) FROM \"ingredients\" WHERE (.+) LIMIT (.+)"
+	rs.mock.ExpectQuery(expectedSQL).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	ingredient, err := rs.repo.GetOne(entities.Ingredient{ID: 9})
+	assert.Nil(rs.T(), ingredient)
+	assert.ErrorIs(rs.T(), err, gorm.ErrRecordNotFound)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *IngredientRepositorySuite) TestCreate() {
+	rs.mock.ExpectBegin()
+	rs.mock.ExpectQuery("INSERT INTO \"ingredients\" (.+) VALUES (.+)").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(5))
+	rs.mock.ExpectCommit()
+
+	_, err := rs.repo.Create(rs.ingredient)
+	assert.NoError(rs.T(), err)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *IngredientRepositorySuite) TestCreateStoresTheStockAtTheStore() {
+	ingredient := rs.ingredient
+	ingredient.ID = 0
+	ingredient.KeepStockAt(2)
+
+	rs.mock.ExpectBegin()
+	rs.mock.ExpectQuery("INSERT INTO \"ingredients\" (.+) VALUES (.+)").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(5))
+	rs.mock.ExpectExec("INSERT INTO \"store_ingredient_stocks\" \\(\"store_id\",\"ingredient_id\",\"stock\"\\) VALUES \\(\\$1,\\$2,\\$3\\)").
+		WithArgs(2, 5, ingredient.Stock).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	rs.mock.ExpectCommit()
+
+	_, err := rs.repo.Create(ingredient)
+	assert.NoError(rs.T(), err)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *IngredientRepositorySuite) TestUpdateWritesEmptyStock() {
+	ingredient := rs.ingredient
+	ingredient.Stock = 0
+
+	expectedSQL := "UPDATE \"ingredients\" SET \"name\"=\\$1,\"unit\"=\\$2,\"low_stock_threshold\"=\\$3,\"updated_at\"=\\$4 WHERE (.+)"
+	expectedStockSQL := "INSERT INTO \"store_ingredient_stocks\" \\(\"store_id\",\"ingredient_id\",\"stock\"\\) VALUES \\(\\$1,\\$2,\\$3\\) " +
+		"ON CONFLICT \\(\"store_id\",\"ingredient_id\"\\) DO UPDATE SET \"stock\"=\"excluded\".\"stock\""
+	rs.mock.ExpectBegin()
+	rs.mock.ExpectExec(expectedSQL).WithArgs(ingredient.Name, ingredient.Unit, ingredient.LowStockThreshold, sqlmock.AnyArg(), ingredient.ID).WillReturnResult(sqlmock.NewResult(0, 1))
+	rs.mock.ExpectExec(expectedStockSQL).WithArgs(2, ingredient.ID, "0").WillReturnResult(sqlmock.NewResult(0, 1))
+	rs.mock.ExpectCommit()
+
+	updatedIngredient, err := rs.repo.Update(2, ingredient.ID, ingredient)
+	assert.NoError(rs.T(), err)
+	assert.Equal(rs.T(), ingredient.ID, updatedIngredient.ID)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *IngredientRepositorySuite) TestDeleteTakesItOutOfTheRecipes() {
+	expectedSQL := "UPDATE \"ingredients\" SET \"deleted_at\"=.+ WHERE \"ingredients\".\"id\" =.+ AND \"ingredients\".\"deleted_at\" IS NULL"
+	rs.mock.ExpectBegin()
+	rs.mock.ExpectExec("DELETE FROM \"recipe_ingredients\" WHERE ingredient_id = \\$1").WithArgs(rs.ingredient.ID).WillReturnResult(sqlmock.NewResult(0, 2))
+	rs.mock.ExpectExec(expectedSQL).WillReturnResult(sqlmock.NewResult(1, 1))
+	rs.mock.ExpectCommit()
+
+	err := rs.repo.Delete(rs.ingredient.ID)
+	assert.NoError(rs.T(), err)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *IngredientRepositorySuite) TestDeleteReturnsErrorOnDeleteFailure() {
+	rs.mock.ExpectBegin()
+	rs.mock.ExpectExec("DELETE FROM \"recipe_ingredients\" (.+)").WillReturnError(errors.New("delete error"))
+	rs.mock.ExpectRollback()
+
+	err := rs.repo.Delete(rs.ingredient.ID)
+	assert.EqualError(rs.T(), err, "delete error")
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func TestIngredientRepositorySuite(t *testing.T) {
+	suite.Run(t, new(IngredientRepositorySuite))
+}
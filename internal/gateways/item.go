@@ -6,6 +6,7 @@ import (
 	"log"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type itemGateway struct {
@@ -18,7 +19,7 @@ func NewItemGateway(orm *gorm.DB) repository.ItemRepository {
 }
 
 func (c *itemGateway) GetAll(filter entities.Item) (items []entities.Item, err error) {
-	result := c.orm.Where(filter).Find(&items)
+	result := c.orm.Preload("Variants").Preload("Modifiers").Preload("Stocks").Preload("Recipe.Ingredient.Stocks").Preload("MenuItems.Menu").Where(filter).Find(&items)
 
 	if result.Error != nil {
 		log.Println(result.Error)
@@ -29,7 +30,7 @@ func (c *itemGateway) GetAll(filter entities.Item) (items []entities.Item, err e
 }
 
 func (c *itemGateway) GetOne(itemFilter entities.Item) (item *entities.Item, err error) {
-	result := c.orm.Where(itemFilter).First(&item)
+	result := c.orm.Preload("Variants").Preload("Modifiers").Preload("Stocks").Preload("Recipe.Ingredient.Stocks").Preload("MenuItems.Menu").Where(itemFilter).First(&item)
 
 	if result.Error != nil {
 		log.Println(result.Error)
@@ -39,6 +40,17 @@ func (c *itemGateway) GetOne(itemFilter entities.Item) (item *entities.Item, err
 	return item, nil
 }
 
+func (c *itemGateway) GetByIds(ids []uint32) (items []entities.Item, err error) {
+	result := c.orm.Preload("Variants").Preload("Modifiers").Preload("Stocks").Preload("Recipe.Ingredient.Stocks").Preload("MenuItems.Menu").Where("id IN ?", ids).Find(&items)
+
+	if result.Error != nil {
+		log.Println(result.Error)
+		return items, result.Error
+	}
+
+	return items, err
+}
+
 func (c *itemGateway) Create(item entities.Item) (*entities.Item, error) {
 	result := c.orm.Create(&item)
 
@@ -50,16 +62,139 @@ func (c *itemGateway) Create(item entities.Item) (*entities.Item, error) {
 	return &item, nil
 }
 
-func (c *itemGateway) Update(itemId uint32, item entities.Item) (*entities.Item, error) {
+// Update replaces the variants, modifiers and recipe of the item too. They are matched by label, name
+// and ingredient, so the ones kept keep their id, which order lines refer to. The stock at the store is
+// always written, so it can stop being tracked there, while the stock at other stores is left as it is.
+func (c *itemGateway) Update(storeId uint32, itemId uint32, item entities.Item) (*entities.Item, error) {
 	itemModel := entities.Item{ID: itemId}
-	result := c.orm.Model(&itemModel).Updates(&item)
+	err := c.orm.Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&itemModel).
+			Select("name", "category", "category_id", "price", "image_url").
+			Updates(&item).Error
+		if err != nil {
+			return err
+		}
+
+		if err := replaceItemStock(tx, storeId, itemId, item.Stock); err != nil {
+			return err
+		}
+
+		if err := replaceItemVariants(tx, itemId, item.Variants); err != nil {
+			return err
+		}
+
+		if err := replaceItemModifiers(tx, itemId, item.Modifiers); err != nil {
+			return err
+		}
+
+		return replaceItemRecipe(tx, itemId, item.Recipe)
+	})
+
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	itemModel.Variants = item.Variants
+	itemModel.Modifiers = item.Modifiers
+	itemModel.Recipe = item.Recipe
+	itemModel.Stock = item.Stock
+
+	return &itemModel, nil
+}
+
+func replaceItemStock(tx *gorm.DB, storeId uint32, itemId uint32, stock *uint32) error {
+	if stock == nil {
+		return tx.Where("store_id = ? AND item_id = ?", storeId, itemId).Delete(&entities.StoreItemStock{}).Error
+	}
+
+	return tx.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "store_id"}, {Name: "item_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"stock"}),
+	}).Create(&entities.StoreItemStock{StoreID: storeId, ItemID: itemId, Stock: *stock}).Error
+}
+
+func replaceItemVariants(tx *gorm.DB, itemId uint32, variants []entities.ItemVariant) error {
+	removed := tx.Where("item_id = ?", itemId)
+	if len(variants) > 0 {
+		removed = removed.Where("label NOT IN ?", entities.Item{Variants: variants}.VariantLabels())
+	}
+
+	if err := removed.Delete(&entities.ItemVariant{}).Error; err != nil {
+		return err
+	}
+
+	if len(variants) == 0 {
+		return nil
+	}
+
+	for i := range variants {
+		variants[i].ItemID = itemId
+	}
+
+	return tx.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "item_id"}, {Name: "label"}},
+		DoUpdates: clause.AssignmentColumns([]string{"price_delta", "available"}),
+	}).Create(&variants).Error
+}
+
+func replaceItemModifiers(tx *gorm.DB, itemId uint32, modifiers []entities.ItemModifier) error {
+	removed := tx.Where("item_id = ?", itemId)
+	if len(modifiers) > 0 {
+		removed = removed.Where("name NOT IN ?", entities.Item{Modifiers: modifiers}.ModifierNames())
+	}
+
+	if err := removed.Delete(&entities.ItemModifier{}).Error; err != nil {
+		return err
+	}
+
+	if len(modifiers) == 0 {
+		return nil
+	}
+
+	for i := range modifiers {
+		modifiers[i].ItemID = itemId
+	}
+
+	return tx.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "item_id"}, {Name: "name"}},
+		DoUpdates: clause.AssignmentColumns([]string{"price"}),
+	}).Create(&modifiers).Error
+}
+
+func replaceItemRecipe(tx *gorm.DB, itemId uint32, recipe []entities.RecipeIngredient) error {
+	removed := tx.Where("item_id = ?", itemId)
+	if len(recipe) > 0 {
+		removed = removed.Where("ingredient_id NOT IN ?", entities.Item{Recipe: recipe}.IngredientIDs())
+	}
+
+	if err := removed.Delete(&entities.RecipeIngredient{}).Error; err != nil {
+		return err
+	}
+
+	if len(recipe) == 0 {
+		return nil
+	}
+
+	for i := range recipe {
+		recipe[i].ItemID = itemId
+	}
+
+	return tx.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "item_id"}, {Name: "ingredient_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"quantity"}),
+	}).Create(&recipe).Error
+}
+
+func (c *itemGateway) SetPaused(itemId uint32, paused bool) error {
+	result := c.orm.Model(&entities.Item{ID: itemId}).Update("paused", paused)
 
 	if result.Error != nil {
 		log.Println(result.Error)
-		return nil, result.Error
+		return result.Error
 	}
 
-	return &itemModel, nil
+	return nil
 }
 
 func (c *itemGateway) Delete(itemId uint32) error {
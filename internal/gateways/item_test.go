@@ -52,6 +52,21 @@ func (rs *ItemRepositorySuite) TestGetAll() {
 	items := sqlmock.NewRows([]string{"id"}).AddRow("1")
 	rs.mock.ExpectQuery(expectedSQL).WillReturnRows(items) // evaluate the result
 
+	expectedMenuItemsSQL := "SELECT (.+) FROM \"menu_items\" WHERE \"menu_items\".\"item_id\" = (.+)"
+	rs.mock.ExpectQuery(expectedMenuItemsSQL).WillReturnRows(sqlmock.NewRows([]string{"id", "item_id"}))
+
+	expectedModifiersSQL := "SELECT (.+) FROM \"item_modifiers\" WHERE \"item_modifiers\".\"item_id\" = (.+)"
+	rs.mock.ExpectQuery(expectedModifiersSQL).WillReturnRows(sqlmock.NewRows([]string{"id", "item_id"}))
+
+	expectedRecipeSQL := "SELECT (.+) FROM \"recipe_ingredients\" WHERE \"recipe_ingredients\".\"item_id\" = (.+)"
+	rs.mock.ExpectQuery(expectedRecipeSQL).WillReturnRows(sqlmock.NewRows([]string{"id", "item_id"}))
+
+	expectedStocksSQL := "SELECT (.+) FROM \"store_item_stocks\" WHERE \"store_item_stocks\".\"item_id\" = (.+)"
+	rs.mock.ExpectQuery(expectedStocksSQL).WillReturnRows(sqlmock.NewRows([]string{"store_id", "item_id", "stock"}))
+
+	expectedVariantsSQL := "SELECT (.+) FROM \"item_variants\" WHERE \"item_variants\".\"item_id\" = (.+)"
+	rs.mock.ExpectQuery(expectedVariantsSQL).WillReturnRows(sqlmock.NewRows([]string{"id", "item_id"}))
+
 	_, err := rs.repo.GetAll(rs.item) // call the GetAll method of the repository
 	assert.NoError(rs.T(), err)       // evaluate if there was no error in execution
 	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
@@ -72,6 +87,21 @@ func (rs *ItemRepositorySuite) TestGetOne_shouldFound() {
 	items := sqlmock.NewRows([]string{"id"}).AddRow("1")
 	rs.mock.ExpectQuery(expectedSQL).WillReturnRows(items) // evaluate the result
 
+	expectedMenuItemsSQL := "SELECT (.+) FROM \"menu_items\" WHERE \"menu_items\".\"item_id\" = (.+)"
+	rs.mock.ExpectQuery(expectedMenuItemsSQL).WillReturnRows(sqlmock.NewRows([]string{"id", "item_id"}))
+
+	expectedModifiersSQL := "SELECT (.+) FROM \"item_modifiers\" WHERE \"item_modifiers\".\"item_id\" = (.+)"
+	rs.mock.ExpectQuery(expectedModifiersSQL).WillReturnRows(sqlmock.NewRows([]string{"id", "item_id"}))
+
+	expectedRecipeSQL := "SELECT (.+) FROM \"recipe_ingredients\" WHERE \"recipe_ingredients\".\"item_id\" = (.+)"
+	rs.mock.ExpectQuery(expectedRecipeSQL).WillReturnRows(sqlmock.NewRows([]string{"id", "item_id"}))
+
+	expectedStocksSQL := "SELECT (.+) FROM \"store_item_stocks\" WHERE \"store_item_stocks\".\"item_id\" = (.+)"
+	rs.mock.ExpectQuery(expectedStocksSQL).WillReturnRows(sqlmock.NewRows([]string{"store_id", "item_id", "stock"}))
+
+	expectedVariantsSQL := "SELECT (.+) FROM \"item_variants\" WHERE \"item_variants\".\"item_id\" = (.+)"
+	rs.mock.ExpectQuery(expectedVariantsSQL).WillReturnRows(sqlmock.NewRows([]string{"id", "item_id"}))
+
 	_, err := rs.repo.GetOne(rs.item)
 	assert.NoError(rs.T(), err) // evaluate if there was no error in execution
 	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
@@ -88,6 +118,65 @@ func (rs *ItemRepositorySuite) TestGetOne_shouldNotFound() {
 	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
 }
 
+func (rs *ItemRepositorySuite) TestGetByIds() {
+	expectedSQL := "SELECT (.+) FROM \"items\" WHERE id IN (.+)"
+	items := sqlmock.NewRows([]string{"id"}).AddRow("1").AddRow("2")
+	rs.mock.ExpectQuery(expectedSQL).WithArgs(1, 2).WillReturnRows(items)
+
+	expectedMenuItemsSQL := "SELECT (.+) FROM \"menu_items\" WHERE \"menu_items\".\"item_id\" IN (.+)"
+	menuItems := sqlmock.NewRows([]string{"id", "menu_id", "item_id", "price"}).AddRow(1, 3, 2, 19.9)
+	rs.mock.ExpectQuery(expectedMenuItemsSQL).WillReturnRows(menuItems)
+
+	expectedMenusSQL := "SELECT (.+) FROM \"menus\" WHERE \"menus\".\"id\" = (.+) AND \"menus\".\"deleted_at\" IS NULL"
+	menus := sqlmock.NewRows([]string{"id", "name", "start_time", "end_time", "exclusive"}).AddRow(3, "Happy hour", "17:00", "19:00", false)
+	rs.mock.ExpectQuery(expectedMenusSQL).WillReturnRows(menus)
+
+	expectedModifiersSQL := "SELECT (.+) FROM \"item_modifiers\" WHERE \"item_modifiers\".\"item_id\" IN (.+)"
+	modifiers := sqlmock.NewRows([]string{"id", "item_id", "name", "price"}).AddRow(1, 1, "Bacon extra", 4.5)
+	rs.mock.ExpectQuery(expectedModifiersSQL).WillReturnRows(modifiers)
+
+	expectedRecipeSQL := "SELECT (.+) FROM \"recipe_ingredients\" WHERE \"recipe_ingredients\".\"item_id\" IN (.+)"
+	recipe := sqlmock.NewRows([]string{"id", "item_id", "ingredient_id", "quantity"}).AddRow(1, 1, 7, 150)
+	rs.mock.ExpectQuery(expectedRecipeSQL).WillReturnRows(recipe)
+
+	expectedIngredientsSQL := "SELECT (.+) FROM \"ingredients\" WHERE \"ingredients\".\"id\" = (.+) AND \"ingredients\".\"deleted_at\" IS NULL"
+	ingredients := sqlmock.NewRows([]string{"id", "name", "unit"}).AddRow(7, "Carne", "G")
+	rs.mock.ExpectQuery(expectedIngredientsSQL).WillReturnRows(ingredients)
+
+	expectedIngredientStocksSQL := "SELECT (.+) FROM \"store_ingredient_stocks\" WHERE \"store_ingredient_stocks\".\"ingredient_id\" = (.+)"
+	ingredientStocks := sqlmock.NewRows([]string{"store_id", "ingredient_id", "stock"}).AddRow(1, 7, 1000)
+	rs.mock.ExpectQuery(expectedIngredientStocksSQL).WillReturnRows(ingredientStocks)
+
+	expectedStocksSQL := "SELECT (.+) FROM \"store_item_stocks\" WHERE \"store_item_stocks\".\"item_id\" IN (.+)"
+	stocks := sqlmock.NewRows([]string{"store_id", "item_id", "stock"}).AddRow(1, 2, 12).AddRow(2, 2, 3)
+	rs.mock.ExpectQuery(expectedStocksSQL).WillReturnRows(stocks)
+
+	expectedVariantsSQL := "SELECT (.+) FROM \"item_variants\" WHERE \"item_variants\".\"item_id\" IN (.+)"
+	variants := sqlmock.NewRows([]string{"id", "item_id", "label", "price_delta", "available"}).AddRow(1, 2, "G", 3, true)
+	rs.mock.ExpectQuery(expectedVariantsSQL).WillReturnRows(variants)
+
+	result, err := rs.repo.GetByIds([]uint32{1, 2})
+	assert.NoError(rs.T(), err)
+	assert.Len(rs.T(), result, 2)
+	assert.Equal(rs.T(), "Bacon extra", result[0].Modifiers[0].Name)
+	assert.Equal(rs.T(), "G", result[1].Variants[0].Label)
+	assert.Equal(rs.T(), "Carne", result[0].Recipe[0].Ingredient.Name)
+	assert.Len(rs.T(), result[0].Recipe[0].Ingredient.Stocks, 1)
+	assert.Len(rs.T(), result[1].Stocks, 2)
+	assert.Equal(rs.T(), "Happy hour", result[1].MenuItems[0].Menu.Name)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *ItemRepositorySuite) TestGetByIdsReturnsErrorOnQueryFailure() {
+	expectedSQL := "SELECT (.+) FROM \"items\" WHERE id IN (.+)"
+	rs.mock.ExpectQuery(expectedSQL).WillReturnError(errors.New("query error"))
+
+	_, err := rs.repo.GetByIds([]uint32{1})
+	assert.Error(rs.T(), err)
+	assert.Equal(rs.T(), "query error", err.Error())
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
 func (rs *ItemRepositorySuite) TestCreate() {
 	expectedSQL := "INSERT INTO \"items\" (.+) VALUES (.+)"
 	addRow := sqlmock.NewRows([]string{"id"}).AddRow("1")
@@ -100,6 +189,23 @@ func (rs *ItemRepositorySuite) TestCreate() {
 	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
 }
 
+func (rs *ItemRepositorySuite) TestCreateStoresTheStockAtTheStore() {
+	stock := uint32(12)
+	item := entities.Item{Name: "Burger", Stock: &stock}
+	item.KeepStockAt(2)
+
+	rs.mock.ExpectBegin()
+	rs.mock.ExpectQuery("INSERT INTO \"items\" (.+) VALUES (.+)").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	rs.mock.ExpectExec("INSERT INTO \"store_item_stocks\" \\(\"store_id\",\"item_id\",\"stock\"\\) VALUES \\(\\$1,\\$2,\\$3\\)").
+		WithArgs(2, 1, stock).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	rs.mock.ExpectCommit()
+
+	_, err := rs.repo.Create(item)
+	assert.NoError(rs.T(), err)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
 func (rs *ItemRepositorySuite) TestCreateReturnsErrorOnInsertFailure() {
 	expectedSQL := "INSERT INTO \"items\" (.+) VALUES (.+)"
 	rs.mock.ExpectBegin()
@@ -114,12 +220,106 @@ func (rs *ItemRepositorySuite) TestCreateReturnsErrorOnInsertFailure() {
 
 func (rs *ItemRepositorySuite) TestUpdate() {
 	expectedSQL := "UPDATE \"items\" SET .+"
-	rs.mock.ExpectBegin()                                                     // start the transaction
-	rs.mock.ExpectExec(expectedSQL).WillReturnResult(sqlmock.NewResult(1, 1)) // evaluate the result
-	rs.mock.ExpectCommit()                                                    // commit the transaction
+	expectedVariantsSQL := "DELETE FROM \"item_variants\" WHERE item_id = (.+)"
+	expectedModifiersSQL := "DELETE FROM \"item_modifiers\" WHERE item_id = (.+)"
+	expectedRecipeSQL := "DELETE FROM \"recipe_ingredients\" WHERE item_id = (.+)"
+	expectedStockSQL := "DELETE FROM \"store_item_stocks\" WHERE store_id = (.+) AND item_id = (.+)"
+	rs.mock.ExpectBegin()                                                              // start the transaction
+	rs.mock.ExpectExec(expectedSQL).WillReturnResult(sqlmock.NewResult(1, 1))          // evaluate the result
+	rs.mock.ExpectExec(expectedStockSQL).WillReturnResult(sqlmock.NewResult(0, 0))     // item without stock
+	rs.mock.ExpectExec(expectedVariantsSQL).WillReturnResult(sqlmock.NewResult(0, 0))  // item without variants
+	rs.mock.ExpectExec(expectedModifiersSQL).WillReturnResult(sqlmock.NewResult(0, 0)) // item without modifiers
+	rs.mock.ExpectExec(expectedRecipeSQL).WillReturnResult(sqlmock.NewResult(0, 0))    // item without recipe
+	rs.mock.ExpectCommit()                                                             // commit the transaction
+
+	_, err := rs.repo.Update(1, rs.item.ID, rs.item) // call the Update method of the repository
+	assert.NoError(rs.T(), err)                      // evaluate if there was no error in execution
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
 
-	_, err := rs.repo.Update(rs.item.ID, rs.item) // call the Update method of the repository
-	assert.NoError(rs.T(), err)                   // evaluate if there was no error in execution
+func (rs *ItemRepositorySuite) TestUpdateClearsStockNoLongerTrackedAtTheStore() {
+	expectedSQL := "UPDATE \"items\" SET \"name\"=\\$1,\"category\"=\\$2,\"category_id\"=\\$3,\"price\"=\\$4,\"image_url\"=\\$5,\"updated_at\"=\\$6 WHERE (.+)"
+	expectedStockSQL := "DELETE FROM \"store_item_stocks\" WHERE store_id = \\$1 AND item_id = \\$2"
+	rs.mock.ExpectBegin()
+	rs.mock.ExpectExec(expectedSQL).WithArgs(rs.item.Name, rs.item.Category, rs.item.CategoryID, rs.item.Price, rs.item.ImageUrl, sqlmock.AnyArg(), rs.item.ID).WillReturnResult(sqlmock.NewResult(0, 1))
+	rs.mock.ExpectExec(expectedStockSQL).WithArgs(2, rs.item.ID).WillReturnResult(sqlmock.NewResult(0, 1))
+	rs.mock.ExpectExec("DELETE FROM \"item_variants\" WHERE item_id = (.+)").WillReturnResult(sqlmock.NewResult(0, 0))
+	rs.mock.ExpectExec("DELETE FROM \"item_modifiers\" WHERE item_id = (.+)").WillReturnResult(sqlmock.NewResult(0, 0))
+	rs.mock.ExpectExec("DELETE FROM \"recipe_ingredients\" WHERE item_id = (.+)").WillReturnResult(sqlmock.NewResult(0, 0))
+	rs.mock.ExpectCommit()
+
+	_, err := rs.repo.Update(2, rs.item.ID, rs.item)
+	assert.NoError(rs.T(), err)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *ItemRepositorySuite) TestUpdateWritesStockAtTheStore() {
+	stock := uint32(0)
+	item := rs.item
+	item.Stock = &stock
+
+	expectedStockSQL := "INSERT INTO \"store_item_stocks\" \\(\"store_id\",\"item_id\",\"stock\"\\) VALUES \\(\\$1,\\$2,\\$3\\) " +
+		"ON CONFLICT \\(\"store_id\",\"item_id\"\\) DO UPDATE SET \"stock\"=\"excluded\".\"stock\""
+	rs.mock.ExpectBegin()
+	rs.mock.ExpectExec("UPDATE \"items\" SET .+").WillReturnResult(sqlmock.NewResult(0, 1))
+	rs.mock.ExpectExec(expectedStockSQL).WithArgs(2, item.ID, stock).WillReturnResult(sqlmock.NewResult(0, 1))
+	rs.mock.ExpectExec("DELETE FROM \"item_variants\" WHERE item_id = (.+)").WillReturnResult(sqlmock.NewResult(0, 0))
+	rs.mock.ExpectExec("DELETE FROM \"item_modifiers\" WHERE item_id = (.+)").WillReturnResult(sqlmock.NewResult(0, 0))
+	rs.mock.ExpectExec("DELETE FROM \"recipe_ingredients\" WHERE item_id = (.+)").WillReturnResult(sqlmock.NewResult(0, 0))
+	rs.mock.ExpectCommit()
+
+	updatedItem, err := rs.repo.Update(2, item.ID, item)
+	assert.NoError(rs.T(), err)
+	assert.Equal(rs.T(), &stock, updatedItem.Stock)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *ItemRepositorySuite) TestUpdateReplacesVariantsAndModifiers() {
+	item := rs.item
+	item.Variants = []entities.ItemVariant{{Label: "G", PriceDelta: 300, Available: true}}
+	item.Modifiers = []entities.ItemModifier{{Name: "Bacon extra", Price: 450}}
+
+	expectedSQL := "UPDATE \"items\" SET .+"
+	expectedRemovedVariantsSQL := "DELETE FROM \"item_variants\" WHERE item_id = \\$1 AND label NOT IN \\(\\$2\\)"
+	expectedUpsertVariantsSQL := "INSERT INTO \"item_variants\" (.+) VALUES (.+) ON CONFLICT \\(\"item_id\",\"label\"\\) DO UPDATE SET \"price_delta\"=\"excluded\".\"price_delta\",\"available\"=\"excluded\".\"available\""
+	expectedRemovedSQL := "DELETE FROM \"item_modifiers\" WHERE item_id = \\$1 AND name NOT IN \\(\\$2\\)"
+	expectedUpsertSQL := "INSERT INTO \"item_modifiers\" (.+) VALUES (.+) ON CONFLICT \\(\"item_id\",\"name\"\\) DO UPDATE SET \"price\"=\"excluded\".\"price\""
+	rs.mock.ExpectBegin()
+	rs.mock.ExpectExec(expectedSQL).WillReturnResult(sqlmock.NewResult(1, 1))
+	rs.mock.ExpectExec("DELETE FROM \"store_item_stocks\" (.+)").WillReturnResult(sqlmock.NewResult(0, 0))
+	rs.mock.ExpectExec(expectedRemovedVariantsSQL).WithArgs(item.ID, "G").WillReturnResult(sqlmock.NewResult(0, 0))
+	rs.mock.ExpectQuery(expectedUpsertVariantsSQL).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	rs.mock.ExpectExec(expectedRemovedSQL).WithArgs(item.ID, "Bacon extra").WillReturnResult(sqlmock.NewResult(0, 1))
+	rs.mock.ExpectQuery(expectedUpsertSQL).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	rs.mock.ExpectExec("DELETE FROM \"recipe_ingredients\" WHERE item_id = (.+)").WillReturnResult(sqlmock.NewResult(0, 0))
+	rs.mock.ExpectCommit()
+
+	updatedItem, err := rs.repo.Update(1, item.ID, item)
+	assert.NoError(rs.T(), err)
+	assert.Equal(rs.T(), item.ID, updatedItem.Variants[0].ItemID)
+	assert.Equal(rs.T(), item.ID, updatedItem.Modifiers[0].ItemID)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *ItemRepositorySuite) TestUpdateReplacesRecipe() {
+	item := rs.item
+	item.Recipe = []entities.RecipeIngredient{{IngredientID: 7, Quantity: 150}}
+
+	expectedSQL := "UPDATE \"items\" SET .+"
+	expectedRemovedSQL := "DELETE FROM \"recipe_ingredients\" WHERE item_id = \\$1 AND ingredient_id NOT IN \\(\\$2\\)"
+	expectedUpsertSQL := "INSERT INTO \"recipe_ingredients\" (.+) VALUES (.+) ON CONFLICT \\(\"item_id\",\"ingredient_id\"\\) DO UPDATE SET \"quantity\"=\"excluded\".\"quantity\""
+	rs.mock.ExpectBegin()
+	rs.mock.ExpectExec(expectedSQL).WillReturnResult(sqlmock.NewResult(1, 1))
+	rs.mock.ExpectExec("DELETE FROM \"store_item_stocks\" (.+)").WillReturnResult(sqlmock.NewResult(0, 0))
+	rs.mock.ExpectExec("DELETE FROM \"item_variants\" WHERE item_id = (.+)").WillReturnResult(sqlmock.NewResult(0, 0))
+	rs.mock.ExpectExec("DELETE FROM \"item_modifiers\" WHERE item_id = (.+)").WillReturnResult(sqlmock.NewResult(0, 0))
+	rs.mock.ExpectExec(expectedRemovedSQL).WithArgs(item.ID, 7).WillReturnResult(sqlmock.NewResult(0, 1))
+	rs.mock.ExpectQuery(expectedUpsertSQL).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	rs.mock.ExpectCommit()
+
+	updatedItem, err := rs.repo.Update(1, item.ID, item)
+	assert.NoError(rs.T(), err)
+	assert.Equal(rs.T(), item.ID, updatedItem.Recipe[0].ItemID)
 	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
 }
 
@@ -129,12 +329,23 @@ func (rs *ItemRepositorySuite) TestUpdateReturnsErrorOnUpdateFailure() {
 	rs.mock.ExpectExec(expectedSQL).WillReturnError(errors.New("update error"))
 	rs.mock.ExpectRollback()
 
-	_, err := rs.repo.Update(rs.item.ID, rs.item)
+	_, err := rs.repo.Update(1, rs.item.ID, rs.item)
 	assert.Error(rs.T(), err)
 	assert.Equal(rs.T(), "update error", err.Error())
 	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
 }
 
+func (rs *ItemRepositorySuite) TestSetPaused() {
+	expectedSQL := "UPDATE \"items\" SET \"paused\"=\\$1,\"updated_at\"=\\$2 WHERE \"items\".\"deleted_at\" IS NULL AND \"id\" = \\$3"
+	rs.mock.ExpectBegin()
+	rs.mock.ExpectExec(expectedSQL).WithArgs(true, sqlmock.AnyArg(), 1).WillReturnResult(sqlmock.NewResult(0, 1))
+	rs.mock.ExpectCommit()
+
+	err := rs.repo.SetPaused(1, true)
+	assert.NoError(rs.T(), err)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
 func (rs *ItemRepositorySuite) TestDelete() {
 	expectedSQL := "UPDATE \"items\" SET \"deleted_at\"=.+ WHERE \"items\".\"id\" =.+ AND \"items\".\"deleted_at\" IS NULL"
 	rs.mock.ExpectBegin()                                                     // start the transaction
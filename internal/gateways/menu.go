@@ -0,0 +1,109 @@
+package gateways
+
+import (
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+	"github.com/8soat-grupo35/fastfood-order/internal/interfaces/repository"
+	"log"
+
+	"gorm.io/gorm"
+)
+
+type menuGateway struct {
+	orm *gorm.DB
+}
+
+func NewMenuGateway(orm *gorm.DB) repository.MenuRepository {
+	return &menuGateway{orm: orm}
+}
+
+func (c *menuGateway) GetAll(storeId uint32) (menus []entities.Menu, err error) {
+	result := c.orm.Preload("Items").Where("store_id = ?", storeId).Order("id ASC").Find(&menus)
+
+	if result.Error != nil {
+		log.Println(result.Error)
+		return menus, result.Error
+	}
+
+	return menus, err
+}
+
+func (c *menuGateway) GetOne(menuFilter entities.Menu) (menu *entities.Menu, err error) {
+	result := c.orm.Preload("Items").Where(menuFilter).First(&menu)
+
+	if result.Error != nil {
+		log.Println(result.Error)
+		return nil, result.Error
+	}
+
+	return menu, nil
+}
+
+func (c *menuGateway) Create(menu entities.Menu) (*entities.Menu, error) {
+	result := c.orm.Create(&menu)
+
+	if result.Error != nil {
+		log.Println(result.Error)
+		return nil, result.Error
+	}
+
+	return &menu, nil
+}
+
+// Update replaces the items of the menu. The exclusive flag is always written, so it can be turned off.
+// Menus of other stores are left as they are, returning gorm.ErrRecordNotFound.
+func (c *menuGateway) Update(storeId uint32, menuId uint32, menu entities.Menu) (*entities.Menu, error) {
+	menuModel := entities.Menu{ID: menuId}
+	err := c.orm.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&menuModel).
+			Where("store_id = ?", storeId).
+			Select("name", "start_time", "end_time", "exclusive").
+			Updates(&menu)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		if err := tx.Where("menu_id = ?", menuId).Delete(&entities.MenuItem{}).Error; err != nil {
+			return err
+		}
+
+		if len(menu.Items) == 0 {
+			return nil
+		}
+
+		for i := range menu.Items {
+			menu.Items[i].MenuID = menuId
+		}
+
+		return tx.Create(&menu.Items).Error
+	})
+
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	menu.ID = menuId
+
+	return &menu, nil
+}
+
+// Delete removes the menu of the store. Menus of other stores are left as they are, returning
+// gorm.ErrRecordNotFound.
+func (c *menuGateway) Delete(storeId uint32, menuId uint32) error {
+	result := c.orm.Where("store_id = ?", storeId).Delete(&entities.Menu{}, menuId)
+
+	if result.Error != nil {
+		log.Println(result.Error)
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
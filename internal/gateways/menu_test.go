@@ -0,0 +1,151 @@
+package gateways
+
+import (
+	"database/sql"
+	"errors"
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"testing"
+)
+
+type MenuRepositorySuite struct {
+	suite.Suite
+	conn *sql.DB
+	DB   *gorm.DB
+	mock sqlmock.Sqlmock
+
+	repo *menuGateway
+	menu entities.Menu
+}
+
+func (rs *MenuRepositorySuite) SetupSuite() {
+	var (
+		err error
+	)
+
+	rs.conn, rs.mock, err = sqlmock.New()
+	assert.NoError(rs.T(), err)
+
+	dialector := postgres.New(postgres.Config{
+		DriverName: "postgres",
+		Conn:       rs.conn,
+	})
+
+	rs.DB, err = gorm.Open(dialector, &gorm.Config{})
+	assert.NoError(rs.T(), err)
+
+	rs.repo = &menuGateway{rs.DB}
+
+	happyHourPrice := entities.Money(1990)
+	rs.menu = entities.Menu{
+		ID:        3,
+		Name:      "Happy hour",
+		StartTime: "17:00",
+		EndTime:   "19:00",
+		Items: []entities.MenuItem{
+			{ItemID: 1, Price: &happyHourPrice},
+			{ItemID: 2},
+		},
+	}
+}
+
+func (rs *MenuRepositorySuite) TestGetAll() {
+	expectedSQL := "SELECT (.+) FROM \"menus\" WHERE store_id = \\$1 AND \"menus\".\"deleted_at\" IS NULL ORDER BY id ASC"
+	rs.mock.ExpectQuery(expectedSQL).WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"id", "name", "start_time", "end_time"}).AddRow(3, "Happy hour", "17:00", "19:00"))
+
+	expectedItemsSQL := "SELECT (.+) FROM \"menu_items\" WHERE \"menu_items\".\"menu_id\" = (.+)"
+	rs.mock.ExpectQuery(expectedItemsSQL).WillReturnRows(sqlmock.NewRows([]string{"id", "menu_id", "item_id", "price"}).AddRow(1, 3, 1, 19.9))
+
+	menus, err := rs.repo.GetAll(1)
+	assert.NoError(rs.T(), err)
+	assert.Equal(rs.T(), uint32(1), menus[0].Items[0].ItemID)
+	assert.Equal(rs.T(), entities.Money(1990), *menus[0].Items[0].Price)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *MenuRepositorySuite) TestGetOne_shouldNotFound() {
+	expectedSQL := "SELECT (.+) FROM \"menus\" WHERE (.+) LIMIT (.+)"
+	rs.mock.ExpectQuery(expectedSQL).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	menu, err := rs.repo.GetOne(entities.Menu{ID: 9})
+	assert.Nil(rs.T(), menu)
+	assert.ErrorIs(rs.T(), err, gorm.ErrRecordNotFound)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *MenuRepositorySuite) TestCreateStoresTheItems() {
+	rs.mock.ExpectBegin()
+	rs.mock.ExpectQuery("INSERT INTO \"menus\" (.+)").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3))
+	rs.mock.ExpectQuery("INSERT INTO \"menu_items\" (.+) ON CONFLICT (.+)").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1).AddRow(2))
+	rs.mock.ExpectCommit()
+
+	_, err := rs.repo.Create(rs.menu)
+	assert.NoError(rs.T(), err)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *MenuRepositorySuite) TestUpdateWritesTheWindowAndRecreatesItems() {
+	menu := rs.menu
+	menu.Items = []entities.MenuItem{{ItemID: 2}}
+
+	expectedSQL := "UPDATE \"menus\" SET \"name\"=\\$1,\"start_time\"=\\$2,\"end_time\"=\\$3,\"exclusive\"=\\$4,\"updated_at\"=\\$5 WHERE (.+)"
+	rs.mock.ExpectBegin()
+	rs.mock.ExpectExec(expectedSQL).WithArgs(menu.Name, menu.StartTime, menu.EndTime, false, sqlmock.AnyArg(), uint32(1), menu.ID).WillReturnResult(sqlmock.NewResult(0, 1))
+	rs.mock.ExpectExec("DELETE FROM \"menu_items\" WHERE menu_id = \\$1").WithArgs(menu.ID).WillReturnResult(sqlmock.NewResult(0, 2))
+	rs.mock.ExpectQuery("INSERT INTO \"menu_items\" (.+)").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3))
+	rs.mock.ExpectCommit()
+
+	updatedMenu, err := rs.repo.Update(1, menu.ID, menu)
+	assert.NoError(rs.T(), err)
+	assert.Equal(rs.T(), menu.ID, updatedMenu.Items[0].MenuID)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *MenuRepositorySuite) TestUpdateReturnsErrorOnUpdateFailure() {
+	rs.mock.ExpectBegin()
+	rs.mock.ExpectExec("UPDATE \"menus\" SET .+").WillReturnError(errors.New("update error"))
+	rs.mock.ExpectRollback()
+
+	_, err := rs.repo.Update(1, rs.menu.ID, rs.menu)
+	assert.Error(rs.T(), err)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *MenuRepositorySuite) TestUpdateReturnsNotFoundOnMenuOfAnotherStore() {
+	rs.mock.ExpectBegin()
+	rs.mock.ExpectExec("UPDATE \"menus\" SET (.+) WHERE store_id = \\$6 (.+)").WillReturnResult(sqlmock.NewResult(0, 0))
+	rs.mock.ExpectRollback()
+
+	_, err := rs.repo.Update(2, rs.menu.ID, rs.menu)
+	assert.ErrorIs(rs.T(), err, gorm.ErrRecordNotFound)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *MenuRepositorySuite) TestDelete() {
+	expectedSQL := "UPDATE \"menus\" SET \"deleted_at\"=\\$1 WHERE store_id = \\$2 AND \"menus\".\"id\" = \\$3 AND \"menus\".\"deleted_at\" IS NULL"
+	rs.mock.ExpectBegin()
+	rs.mock.ExpectExec(expectedSQL).WithArgs(sqlmock.AnyArg(), 1, rs.menu.ID).WillReturnResult(sqlmock.NewResult(1, 1))
+	rs.mock.ExpectCommit()
+
+	err := rs.repo.Delete(1, rs.menu.ID)
+	assert.NoError(rs.T(), err)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *MenuRepositorySuite) TestDeleteReturnsNotFoundOnMenuOfAnotherStore() {
+	rs.mock.ExpectBegin()
+	rs.mock.ExpectExec("UPDATE \"menus\" SET \"deleted_at\"=.+").WillReturnResult(sqlmock.NewResult(0, 0))
+	rs.mock.ExpectCommit()
+
+	err := rs.repo.Delete(2, rs.menu.ID)
+	assert.ErrorIs(rs.T(), err, gorm.ErrRecordNotFound)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func TestMenuRepositorySuite(t *testing.T) {
+	suite.Run(t, new(MenuRepositorySuite))
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"github.com/8soat-grupo35/fastfood-order/internal/interfaces/repository"
 	"log"
+	"time"
 
 	"github.com/8soat-grupo35/fastfood-order/internal/entities"
 	"gorm.io/gorm"
@@ -18,7 +19,8 @@ func NewOrderGateway(orm *gorm.DB) repository.OrderRepository {
 	return &orderGateway{orm: orm}
 }
 
-func (c *orderGateway) GetAll() (orders []entities.Order, err error) {
+// GetAll lists the orders in the kitchen board ordering, which the cursor of the pages follows.
+func (c *orderGateway) GetAll(filter entities.OrderFilter) (orders []entities.Order, err error) {
 	expressionOrderBy := fmt.Sprintf(
 		"CASE status WHEN '%s' THEN 1 WHEN '%s' THEN 2 WHEN '%s' THEN 3 ELSE 4 END",
 		entities.DONE_STATUS,
@@ -26,11 +28,50 @@ func (c *orderGateway) GetAll() (orders []entities.Order, err error) {
 		entities.RECEIVED_STATUS,
 	)
 
-	result := c.orm.Preload(clause.Associations).
-		Preload("Items.Item").
-		Where("status != ?", entities.FINISHED_STATUS).
+	query := c.orm.Preload(clause.Associations).Preload("Items.Item").Preload("Items.Modifiers").
+		Where("store_id = ?", filter.StoreID)
+
+	if filter.IsKitchenBoard() {
+		query = query.
+			Where("status NOT IN ?", []string{entities.FINISHED_STATUS, entities.CANCELED_STATUS}).
+			Where("payment_status = ?", entities.PAYMENT_APPROVED_STATUS)
+	} else {
+		query = query.Where("status IN ?", filter.Statuses)
+	}
+
+	if filter.CustomerID != nil {
+		query = query.Where("customer_id = ?", *filter.CustomerID)
+	}
+
+	if filter.CreatedFrom != nil {
+		query = query.Where("created_at >= ?", *filter.CreatedFrom)
+	}
+
+	if filter.CreatedTo != nil {
+		query = query.Where("created_at < ?", *filter.CreatedTo)
+	}
+
+	if filter.PickupCode != "" {
+		query = query.Where("pickup_code = ?", filter.PickupCode)
+	}
+
+	if filter.After != nil {
+		query = query.Where(
+			fmt.Sprintf("(%s, created_at, id) > (?, ?, ?)", expressionOrderBy),
+			filter.After.Priority,
+			filter.After.CreatedAt,
+			filter.After.ID,
+		)
+	}
+
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit)
+	}
+
+	result := query.
 		Order(expressionOrderBy).
 		Order("created_at ASC").
+		Order("id ASC").
 		Find(&orders)
 
 	if result.Error != nil {
@@ -41,11 +82,26 @@ func (c *orderGateway) GetAll() (orders []entities.Order, err error) {
 	return orders, err
 }
 
+// GetById finds the order in any store, for the callbacks of the payment service.
 func (c *orderGateway) GetById(id uint32) (*entities.Order, error) {
 	order := entities.Order{
 		ID: id,
 	}
-	result := c.orm.Preload(clause.Associations).Preload("Items.Item").First(&order)
+	result := c.orm.Preload(clause.Associations).Preload("Items.Item").Preload("Items.Modifiers").First(&order)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return &order, nil
+}
+
+func (c *orderGateway) GetInStore(storeId uint32, id uint32) (*entities.Order, error) {
+	order := entities.Order{
+		ID: id,
+	}
+	result := c.orm.Preload(clause.Associations).Preload("Items.Item").Preload("Items.Modifiers").
+		Where("store_id = ?", storeId).
+		First(&order)
 	if result.Error != nil {
 		return nil, result.Error
 	}
@@ -53,24 +109,344 @@ func (c *orderGateway) GetById(id uint32) (*entities.Order, error) {
 	return &order, nil
 }
 
-func (c *orderGateway) Create(order entities.Order) (*entities.Order, error) {
-	result := c.orm.Create(&order)
+func (c *orderGateway) GetByCustomer(storeId uint32, customerId uint32) (orders []entities.Order, err error) {
+	result := c.orm.Preload("Discounts").Preload("Items.Modifiers").
+		Where("store_id = ? AND customer_id = ?", storeId, customerId).
+		Order("created_at DESC").
+		Order("id DESC").
+		Find(&orders)
 
 	if result.Error != nil {
 		log.Println(result.Error)
+		return orders, result.Error
+	}
+
+	return orders, err
+}
+
+func (c *orderGateway) GetByTrackingCode(storeId uint32, trackingCode string) (*entities.Order, error) {
+	order := entities.Order{}
+	result := c.orm.Where("store_id = ? AND tracking_code = ?", storeId, trackingCode).First(&order)
+	if result.Error != nil {
 		return nil, result.Error
 	}
 
 	return &order, nil
 }
 
-func (c *orderGateway) Update(id uint32, order entities.Order) (*entities.Order, error) {
-	result := c.orm.Session(&gorm.Session{FullSaveAssociations: false}).Updates(&order)
+// AveragePreparationTime averages how long the kitchen of the store took to prepare its latest ready orders.
+func (c *orderGateway) AveragePreparationTime(storeId uint32, sampleSize int) (time.Duration, error) {
+	var averageSeconds float64
+
+	result := c.orm.Raw(
+		`SELECT COALESCE(AVG(EXTRACT(EPOCH FROM (ready_at - preparation_started_at))), 0) FROM (
+			SELECT ready_at, preparation_started_at FROM orders
+			WHERE store_id = ? AND ready_at IS NOT NULL AND preparation_started_at IS NOT NULL AND deleted_at IS NULL
+			ORDER BY ready_at DESC LIMIT ?
+		) AS recent_orders`,
+		storeId,
+		sampleSize,
+	).Scan(&averageSeconds)
 
 	if result.Error != nil {
 		log.Println(result.Error)
-		return nil, result.Error
+		return 0, result.Error
+	}
+
+	return time.Duration(averageSeconds * float64(time.Second)), nil
+}
+
+// nextPickupNumber hands out the next number of the business day of the store. The upsert locks the day
+// row until the order is committed, so concurrent checkouts on any replica never get the same number and
+// checkouts that fail give theirs back.
+func nextPickupNumber(tx *gorm.DB, storeId uint32, businessDate string) (int, error) {
+	var number int
+
+	result := tx.Raw(
+		`INSERT INTO pickup_code_sequences (store_id, business_date, last_number) VALUES (?, ?, 1)
+		ON CONFLICT (store_id, business_date) DO UPDATE SET last_number = pickup_code_sequences.last_number + 1
+		RETURNING last_number`,
+		storeId,
+		businessDate,
+	).Scan(&number)
+
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	return number, nil
+}
+
+// Create stores the order, its pickup code, promotion uses and stock in the same transaction as its payment request.
+func (c *orderGateway) Create(order entities.Order, pickupCodeFormat entities.PickupCodeFormat) (*entities.Order, error) {
+	err := c.orm.Transaction(func(tx *gorm.DB) error {
+		if err := checkCustomerLimits(tx, order); err != nil {
+			return err
+		}
+
+		pickupNumber, err := nextPickupNumber(tx, order.StoreID, pickupCodeFormat.BusinessDate(time.Now()))
+		if err != nil {
+			return err
+		}
+		order.PickupCode = pickupCodeFormat.Code(pickupNumber)
+
+		if err := tx.Create(&order).Error; err != nil {
+			return err
+		}
+
+		if err := redeemPromotions(tx, order.Discounts); err != nil {
+			return err
+		}
+
+		if err := takeStock(tx, order); err != nil {
+			return err
+		}
+
+		if err := createStatusChanges(tx, &order); err != nil {
+			return err
+		}
+
+		paymentMessage, err := entities.NewPaymentRequestedMessage(order)
+		if err != nil {
+			return err
+		}
+
+		return tx.Create(paymentMessage).Error
+	})
+
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	return &order, nil
+}
+
+// Update stores the order with its status changes, or returns entities.ErrOrderChanged if it changed meanwhile.
+func (c *orderGateway) Update(id uint32, order entities.Order) (*entities.Order, error) {
+	err := c.orm.Transaction(func(tx *gorm.DB) error {
+		if err := updateUnchanged(tx, &order); err != nil {
+			return err
+		}
+
+		return createStatusChanges(tx, &order)
+	})
+
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	return &order, nil
+}
+
+// Cancel stores the canceled order, giving back its stock and promotion uses and, unless it failed, its payment.
+func (c *orderGateway) Cancel(id uint32, order entities.Order) (*entities.Order, error) {
+	err := c.orm.Transaction(func(tx *gorm.DB) error {
+		if err := updateUnchanged(tx, &order); err != nil {
+			return err
+		}
+
+		if err := createStatusChanges(tx, &order); err != nil {
+			return err
+		}
+
+		if err := restoreStock(tx, order); err != nil {
+			return err
+		}
+
+		if err := releasePromotions(tx, order.Discounts); err != nil {
+			return err
+		}
+
+		if order.IsPaymentFailed() {
+			return nil
+		}
+
+		reversalMessage, err := entities.NewPaymentReversalMessage(order)
+		if err != nil {
+			return err
+		}
+
+		return tx.Create(reversalMessage).Error
+	})
+
+	if err != nil {
+		log.Println(err)
+		return nil, err
 	}
 
 	return &order, nil
 }
+
+// updateUnchanged stores the order only while it still has the statuses it was loaded with. The update
+// itself checks them, so a transition racing another one is never stored on top of it.
+func updateUnchanged(tx *gorm.DB, order *entities.Order) error {
+	result := tx.Session(&gorm.Session{FullSaveAssociations: false}).
+		Where("status = ? AND payment_status = ?", order.PreviousStatus(), order.PreviousPaymentStatus()).
+		Updates(order)
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return entities.ErrOrderChanged
+	}
+
+	return nil
+}
+
+func (c *orderGateway) GetStatusHistory(orderId uint32) (history []entities.OrderStatusHistory, err error) {
+	result := c.orm.Where("order_id = ?", orderId).Order("changed_at ASC").Order("id ASC").Find(&history)
+
+	if result.Error != nil {
+		log.Println(result.Error)
+		return history, result.Error
+	}
+
+	return history, err
+}
+
+// checkCustomerLimits checks the promotions limited per customer again, now that the customer is locked.
+func checkCustomerLimits(tx *gorm.DB, order entities.Order) error {
+	if order.IsGuest() || !order.HasDiscountsLimitedPerCustomer() {
+		return nil
+	}
+
+	result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").First(&entities.Customer{}, *order.CustomerID)
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	history, err := customerPromotionHistory(tx, *order.CustomerID)
+
+	if err != nil {
+		return err
+	}
+
+	return order.CheckCustomerLimits(*history)
+}
+
+// redeemPromotions counts a use of every promotion of the order, with the update itself checking the limit.
+func redeemPromotions(tx *gorm.DB, discounts []entities.OrderDiscount) error {
+	for _, discount := range discounts {
+		result := tx.Model(&entities.Promotion{}).
+			Where("id = ? AND (usage_limit = 0 OR times_used < usage_limit)", discount.PromotionID).
+			UpdateColumn("times_used", gorm.Expr("times_used + 1"))
+
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return entities.ErrPromotionRunOut
+		}
+	}
+
+	return nil
+}
+
+// releasePromotions gives back the uses the promotions of a canceled order took.
+func releasePromotions(tx *gorm.DB, discounts []entities.OrderDiscount) error {
+	for _, discount := range discounts {
+		result := tx.Model(&entities.Promotion{}).
+			Where("id = ? AND times_used > 0", discount.PromotionID).
+			UpdateColumn("times_used", gorm.Expr("times_used - 1"))
+
+		if result.Error != nil {
+			return result.Error
+		}
+	}
+
+	return nil
+}
+
+// takeStock takes the units and ingredients of the order from its store, with the update itself checking the stock.
+func takeStock(tx *gorm.DB, order entities.Order) error {
+	for _, quantity := range order.ItemQuantities() {
+		result := tx.Model(&entities.StoreItemStock{}).
+			Where("store_id = ? AND item_id = ? AND stock >= ?", order.StoreID, quantity.ItemID, quantity.Quantity).
+			UpdateColumn("stock", gorm.Expr("stock - ?", quantity.Quantity))
+
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected > 0 {
+			continue
+		}
+
+		var tracked int64
+		err := tx.Model(&entities.StoreItemStock{}).
+			Where("store_id = ? AND item_id = ?", order.StoreID, quantity.ItemID).
+			Count(&tracked).Error
+
+		if err != nil {
+			return err
+		}
+
+		if tracked > 0 {
+			return &entities.OutOfStockError{ItemID: quantity.ItemID, ItemName: quantity.ItemName}
+		}
+	}
+
+	for _, orderIngredient := range order.Ingredients {
+		result := tx.Model(&entities.StoreIngredientStock{}).
+			Where("store_id = ? AND ingredient_id = ? AND stock >= ?", order.StoreID, orderIngredient.IngredientID, orderIngredient.Quantity).
+			UpdateColumn("stock", gorm.Expr("stock - ?", orderIngredient.Quantity))
+
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return &entities.OutOfStockError{ItemID: orderIngredient.ItemID, ItemName: orderIngredient.ItemName}
+		}
+	}
+
+	return nil
+}
+
+func restoreStock(tx *gorm.DB, order entities.Order) error {
+	for _, quantity := range order.ItemQuantities() {
+		result := tx.Model(&entities.StoreItemStock{}).
+			Where("store_id = ? AND item_id = ?", order.StoreID, quantity.ItemID).
+			UpdateColumn("stock", gorm.Expr("stock + ?", quantity.Quantity))
+
+		if result.Error != nil {
+			return result.Error
+		}
+	}
+
+	for _, orderIngredient := range order.Ingredients {
+		result := tx.Model(&entities.StoreIngredientStock{}).
+			Where("store_id = ? AND ingredient_id = ?", order.StoreID, orderIngredient.IngredientID).
+			UpdateColumn("stock", gorm.Expr("stock + ?", orderIngredient.Quantity))
+
+		if result.Error != nil {
+			return result.Error
+		}
+	}
+
+	return nil
+}
+
+func createStatusChanges(tx *gorm.DB, order *entities.Order) error {
+	if len(order.StatusChanges) == 0 {
+		return nil
+	}
+
+	for i := range order.StatusChanges {
+		order.StatusChanges[i].OrderID = order.ID
+	}
+
+	if err := tx.Create(&order.StatusChanges).Error; err != nil {
+		return err
+	}
+
+	order.StatusChanges = nil
+
+	return nil
+}
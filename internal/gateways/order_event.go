@@ -0,0 +1,90 @@
+package gateways
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+	"github.com/8soat-grupo35/fastfood-order/internal/interfaces/repository"
+	"log"
+
+	"github.com/jackc/pgx/v5/stdlib"
+	"gorm.io/gorm"
+)
+
+// orderEventsChannel is the Postgres NOTIFY channel shared by every replica of the service.
+const orderEventsChannel = "order_events"
+
+type orderEventGateway struct {
+	orm *gorm.DB
+}
+
+func NewOrderEventGateway(orm *gorm.DB) repository.OrderEventRepository {
+	return &orderEventGateway{orm: orm}
+}
+
+func (c *orderEventGateway) Publish(event entities.OrderEvent) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+
+	result := c.orm.Exec("SELECT pg_notify(?, ?)", orderEventsChannel, string(payload))
+
+	if result.Error != nil {
+		log.Println(result.Error)
+		return result.Error
+	}
+
+	return nil
+}
+
+// Listen holds a dedicated connection subscribed to the order events channel and calls handle for
+// every notification, until the context is done or the connection fails.
+func (c *orderEventGateway) Listen(ctx context.Context, handle func(entities.OrderEvent)) error {
+	sqlDB, err := c.orm.DB()
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+
+	conn, err := sqlDB.Conn(ctx)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+	defer conn.Close()
+
+	err = conn.Raw(func(driverConn any) error {
+		pgxConn := driverConn.(*stdlib.Conn).Conn()
+
+		_, err := pgxConn.Exec(ctx, "LISTEN "+orderEventsChannel)
+		if err != nil {
+			return err
+		}
+		defer pgxConn.Exec(context.Background(), "UNLISTEN "+orderEventsChannel)
+
+		for {
+			notification, err := pgxConn.WaitForNotification(ctx)
+			if err != nil {
+				return err
+			}
+
+			event := entities.OrderEvent{}
+			err = json.Unmarshal([]byte(notification.Payload), &event)
+			if err != nil {
+				log.Println(err)
+				continue
+			}
+
+			handle(event)
+		}
+	})
+
+	if err != nil && ctx.Err() == nil {
+		log.Println(err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package gateways
+
+import (
+	"database/sql"
+	"errors"
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"testing"
+)
+
+type OrderEventRepositorySuite struct {
+	suite.Suite
+	conn *sql.DB
+	DB   *gorm.DB
+	mock sqlmock.Sqlmock
+
+	repo  *orderEventGateway
+	event entities.OrderEvent
+}
+
+func (rs *OrderEventRepositorySuite) SetupSuite() {
+	var (
+		err error
+	)
+
+	rs.conn, rs.mock, err = sqlmock.New()
+	assert.NoError(rs.T(), err)
+
+	dialector := postgres.New(postgres.Config{
+		DriverName: "postgres",
+		Conn:       rs.conn,
+	})
+
+	rs.DB, err = gorm.Open(dialector, &gorm.Config{})
+	assert.NoError(rs.T(), err)
+
+	rs.repo = &orderEventGateway{rs.DB}
+	assert.IsType(rs.T(), &orderEventGateway{}, rs.repo)
+
+	rs.event = entities.OrderEvent{
+		Type:    entities.ORDER_CREATED_EVENT,
+		OrderID: 1,
+		Status:  entities.RECEIVED_STATUS,
+	}
+}
+
+func (rs *OrderEventRepositorySuite) TestPublish() {
+	expectedSQL := "SELECT pg_notify\\(\\$1, \\$2\\)"
+	rs.mock.ExpectExec(expectedSQL).
+		WithArgs(orderEventsChannel, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := rs.repo.Publish(rs.event)
+	assert.NoError(rs.T(), err)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *OrderEventRepositorySuite) TestPublishReturnsErrorOnNotifyFailure() {
+	expectedSQL := "SELECT pg_notify\\(\\$1, \\$2\\)"
+	rs.mock.ExpectExec(expectedSQL).WillReturnError(errors.New("notify error"))
+
+	err := rs.repo.Publish(rs.event)
+	assert.Error(rs.T(), err)
+	assert.Equal(rs.T(), "notify error", err.Error())
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func TestOrderEventSuite(t *testing.T) {
+	suite.Run(t, new(OrderEventRepositorySuite))
+}
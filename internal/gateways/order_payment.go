@@ -28,3 +28,15 @@ func (o orderPaymentGateway) Create(orderPayment dto.OrderPaymentDto) error {
 	}
 	return nil
 }
+
+func (o orderPaymentGateway) Reverse(orderPayment dto.OrderPaymentDto) error {
+	orderData, err := json.Marshal(orderPayment)
+	if err != nil {
+		return err
+	}
+	_, err = o.client.Post("/v1/payments/reverse", bytes.NewReader(orderData))
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -45,6 +45,23 @@ func (suite *OrderPaymentRepositorySuite) TestCreateReturnsErrorOnClientFailure(
 	assert.Equal(suite.T(), "client error", err.Error())
 }
 
+func (suite *OrderPaymentRepositorySuite) TestReverse() {
+	orderPaymentDto := dto.OrderPaymentDto{OrderID: 1}
+	suite.client.EXPECT().Post("/v1/payments/reverse", gomock.Any()).Return(nil, nil)
+
+	err := suite.repo.Reverse(orderPaymentDto)
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *OrderPaymentRepositorySuite) TestReverseReturnsErrorOnClientFailure() {
+	orderPaymentDto := dto.OrderPaymentDto{OrderID: 1}
+	suite.client.EXPECT().Post("/v1/payments/reverse", gomock.Any()).Return(nil, errors.New("client error"))
+
+	err := suite.repo.Reverse(orderPaymentDto)
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), "client error", err.Error())
+}
+
 func TestOrderPaymentRepositorySuite(t *testing.T) {
 	suite.Run(t, new(OrderPaymentRepositorySuite))
 }
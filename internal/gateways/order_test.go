@@ -10,6 +10,7 @@ import (
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 	"testing"
+	"time"
 )
 
 type OrderRepositorySuite struct {
@@ -18,8 +19,9 @@ type OrderRepositorySuite struct {
 	DB   *gorm.DB
 	mock sqlmock.Sqlmock
 
-	repo  *orderGateway
-	order entities.Order
+	repo             *orderGateway
+	order            entities.Order
+	pickupCodeFormat entities.PickupCodeFormat
 }
 
 func (rs *OrderRepositorySuite) SetupSuite() {
@@ -44,6 +46,13 @@ func (rs *OrderRepositorySuite) SetupSuite() {
 	rs.order = entities.Order{
 		ID: 1,
 	}
+	rs.pickupCodeFormat = entities.PickupCodeFormat{Prefix: "A", Location: time.UTC}
+}
+
+// expectPickupNumber expects the pickup number of the order to be drawn in its transaction.
+func (rs *OrderRepositorySuite) expectPickupNumber() {
+	expectedSQL := "INSERT INTO pickup_code_sequences (.+) ON CONFLICT \\(store_id, business_date\\) DO UPDATE SET last_number = pickup_code_sequences.last_number \\+ 1 RETURNING last_number"
+	rs.mock.ExpectQuery(expectedSQL).WillReturnRows(sqlmock.NewRows([]string{"last_number"}).AddRow(42))
 }
 
 func (rs *OrderRepositorySuite) TestGetAll() {
@@ -51,12 +60,45 @@ func (rs *OrderRepositorySuite) TestGetAll() {
 	orders := sqlmock.NewRows([]string{"id"}).AddRow("1")
 	rs.mock.ExpectQuery(expectedSQL).WillReturnRows(orders) // evaluate the result
 
+	expectedDiscountsSQL := "SELECT (.+) FROM \"order_discounts\" WHERE \"order_discounts\".\"order_id\" = (.+)"
+	rs.mock.ExpectQuery(expectedDiscountsSQL).WithArgs(rs.order.ID).WillReturnRows(sqlmock.NewRows([]string{"id", "order_id"}))
+
+	expectedIngredientsSQL := "SELECT (.+) FROM \"order_ingredients\" WHERE \"order_ingredients\".\"order_id\" = (.+)"
+	rs.mock.ExpectQuery(expectedIngredientsSQL).WithArgs(rs.order.ID).WillReturnRows(sqlmock.NewRows([]string{"id", "order_id"}))
+
 	expectedOrderItemsSQL := "SELECT (.+) FROM \"order_items\" WHERE \"order_items\".\"order_id\" = (.+)"
 	orderItems := sqlmock.NewRows([]string{"order_id"}).AddRow("1")
 	rs.mock.ExpectQuery(expectedOrderItemsSQL).WithArgs(rs.order.ID).WillReturnRows(orderItems) // evaluate the result
 
-	_, err := rs.repo.GetAll()  // call the GetAll method of the repository
-	assert.NoError(rs.T(), err) // evaluate if there was no error in execution
+	_, err := rs.repo.GetAll(entities.OrderFilter{}) // call the GetAll method of the repository
+	assert.NoError(rs.T(), err)                      // evaluate if there was no error in execution
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *OrderRepositorySuite) TestGetAllWithFilter() {
+	customerID := uint32(1)
+	createdFrom := time.Date(2024, 5, 10, 0, 0, 0, 0, time.UTC)
+	createdTo := createdFrom.AddDate(0, 0, 1)
+	cursor := entities.OrderCursor{Priority: 4, CreatedAt: createdFrom, ID: 7}
+	filter := entities.OrderFilter{
+		StoreID:     2,
+		Statuses:    []string{entities.FINISHED_STATUS},
+		CustomerID:  &customerID,
+		CreatedFrom: &createdFrom,
+		CreatedTo:   &createdTo,
+		PickupCode:  "A-042",
+		After:       &cursor,
+		Limit:       21,
+	}
+
+	expectedSQL := "SELECT (.+) FROM \"orders\" WHERE store_id = \\$1 AND status IN \\(\\$2\\) AND customer_id = \\$3 AND created_at >= \\$4 AND created_at < \\$5 AND pickup_code = \\$6 AND \\(CASE status (.+) END, created_at, id\\) > \\(\\$7, \\$8, \\$9\\) ORDER BY (.+),created_at ASC,id ASC LIMIT \\$10"
+	rs.mock.ExpectQuery(expectedSQL).
+		WithArgs(uint32(2), entities.FINISHED_STATUS, customerID, createdFrom, createdTo, "A-042", 4, createdFrom, uint32(7), 21).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	orders, err := rs.repo.GetAll(filter)
+	assert.NoError(rs.T(), err)
+	assert.Empty(rs.T(), orders)
 	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
 }
 
@@ -64,7 +106,7 @@ func (rs *OrderRepositorySuite) TestGetAllReturnsErrorOnQueryFailure() {
 	expectedSQL := "SELECT (.+) FROM \"orders\" WHERE (.+) ORDER BY CASE status WHEN 'PRONTO' THEN 1 WHEN 'EM_PREPARACAO' THEN 2 WHEN 'RECEBIDO' THEN 3 ELSE 4 END,created_at ASC"
 	rs.mock.ExpectQuery(expectedSQL).WillReturnError(errors.New("query error"))
 
-	_, err := rs.repo.GetAll()
+	_, err := rs.repo.GetAll(entities.OrderFilter{})
 	assert.Error(rs.T(), err)
 	assert.Equal(rs.T(), "query error", err.Error())
 	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
@@ -75,6 +117,12 @@ func (rs *OrderRepositorySuite) TestGetById_shouldFound() {
 	orders := sqlmock.NewRows([]string{"id"}).AddRow("1")
 	rs.mock.ExpectQuery(expectedOrderSQL).WillReturnRows(orders) // evaluate the result
 
+	expectedDiscountsSQL := "SELECT (.+) FROM \"order_discounts\" WHERE \"order_discounts\".\"order_id\" = (.+)"
+	rs.mock.ExpectQuery(expectedDiscountsSQL).WithArgs(rs.order.ID).WillReturnRows(sqlmock.NewRows([]string{"id", "order_id"}))
+
+	expectedIngredientsSQL := "SELECT (.+) FROM \"order_ingredients\" WHERE \"order_ingredients\".\"order_id\" = (.+)"
+	rs.mock.ExpectQuery(expectedIngredientsSQL).WithArgs(rs.order.ID).WillReturnRows(sqlmock.NewRows([]string{"id", "order_id"}))
+
 	expectedOrderItemsSQL := "SELECT (.+) FROM \"order_items\" WHERE \"order_items\".\"order_id\" = (.+)"
 	orderItems := sqlmock.NewRows([]string{"order_id"}).AddRow("1")
 	rs.mock.ExpectQuery(expectedOrderItemsSQL).WithArgs(rs.order.ID).WillReturnRows(orderItems) // evaluate the result
@@ -95,30 +143,391 @@ func (rs *OrderRepositorySuite) TestGetById_shouldNotFound() {
 	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
 }
 
+func (rs *OrderRepositorySuite) TestGetInStore_shouldNotFoundOrderOfAnotherStore() {
+	expectedSQL := "SELECT (.+) FROM \"orders\" WHERE store_id = \\$1 AND (.+) LIMIT (.+)"
+	rs.mock.ExpectQuery(expectedSQL).WithArgs(uint32(2), rs.order.ID, 1).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	_, err := rs.repo.GetInStore(2, rs.order.ID)
+	assert.ErrorIs(rs.T(), err, gorm.ErrRecordNotFound)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *OrderRepositorySuite) TestGetByCustomer() {
+	expectedSQL := "SELECT (.+) FROM \"orders\" WHERE store_id = \\$1 AND customer_id = \\$2 ORDER BY created_at DESC,id DESC"
+	orders := sqlmock.NewRows([]string{"id", "customer_id"}).AddRow(2, 1).AddRow(1, 1)
+	rs.mock.ExpectQuery(expectedSQL).WithArgs(uint32(1), uint32(1)).WillReturnRows(orders)
+
+	expectedDiscountsSQL := "SELECT (.+) FROM \"order_discounts\" WHERE \"order_discounts\".\"order_id\" IN (.+)"
+	rs.mock.ExpectQuery(expectedDiscountsSQL).WillReturnRows(sqlmock.NewRows([]string{"id", "order_id"}))
+
+	expectedOrderItemsSQL := "SELECT (.+) FROM \"order_items\" WHERE \"order_items\".\"order_id\" IN (.+)"
+	orderItems := sqlmock.NewRows([]string{"order_id", "item_id"}).AddRow(1, 1)
+	rs.mock.ExpectQuery(expectedOrderItemsSQL).WillReturnRows(orderItems)
+
+	result, err := rs.repo.GetByCustomer(1, 1)
+	assert.NoError(rs.T(), err)
+	assert.Len(rs.T(), result, 2)
+	assert.Len(rs.T(), result[1].Items, 1)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *OrderRepositorySuite) TestGetByTrackingCode() {
+	expectedSQL := "SELECT (.+) FROM \"orders\" WHERE store_id = (.+) AND tracking_code = (.+) LIMIT (.+)"
+	orders := sqlmock.NewRows([]string{"id", "tracking_code"}).AddRow(1, "ABCD2345")
+	rs.mock.ExpectQuery(expectedSQL).WithArgs(uint32(1), "ABCD2345", 1).WillReturnRows(orders)
+
+	order, err := rs.repo.GetByTrackingCode(1, "ABCD2345")
+	assert.NoError(rs.T(), err)
+	assert.Equal(rs.T(), uint32(1), order.ID)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *OrderRepositorySuite) TestAveragePreparationTime() {
+	expectedSQL := "SELECT COALESCE\\(AVG\\(EXTRACT\\(EPOCH FROM \\(ready_at - preparation_started_at\\)\\)\\), 0\\) FROM (.+) LIMIT (.+)"
+	average := sqlmock.NewRows([]string{"coalesce"}).AddRow(450.5)
+	rs.mock.ExpectQuery(expectedSQL).WithArgs(uint32(1), 20).WillReturnRows(average)
+
+	averagePreparationTime, err := rs.repo.AveragePreparationTime(1, 20)
+	assert.NoError(rs.T(), err)
+	assert.Equal(rs.T(), 7*time.Minute+30500*time.Millisecond, averagePreparationTime)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *OrderRepositorySuite) TestAveragePreparationTimeReturnsErrorOnQueryFailure() {
+	expectedSQL := "SELECT COALESCE(.+)"
+	rs.mock.ExpectQuery(expectedSQL).WillReturnError(errors.New("query error"))
+
+	_, err := rs.repo.AveragePreparationTime(1, 20)
+	assert.Error(rs.T(), err)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
 func (rs *OrderRepositorySuite) TestCreate() {
 	expectedSQL := "INSERT INTO \"orders\" (.+) VALUES (.+)"
+	expectedOutboxSQL := "INSERT INTO \"outbox_messages\" (.+) VALUES (.+)"
 	addRow := sqlmock.NewRows([]string{"id"}).AddRow("1")
-	rs.mock.ExpectBegin()                                   // start the transaction
-	rs.mock.ExpectQuery(expectedSQL).WillReturnRows(addRow) // evaluate the result
-	rs.mock.ExpectCommit()                                  // commit the transaction
+	addOutboxRow := sqlmock.NewRows([]string{"id"}).AddRow("1")
+	rs.mock.ExpectBegin() // start the transaction
+	rs.expectPickupNumber()
+	rs.mock.ExpectQuery(expectedSQL).WillReturnRows(addRow)             // evaluate the result
+	rs.mock.ExpectQuery(expectedOutboxSQL).WillReturnRows(addOutboxRow) // payment request written in the same transaction
+	rs.mock.ExpectCommit()                                              // commit the transaction
+
+	order, err := rs.repo.Create(rs.order, rs.pickupCodeFormat) // call the Create method of the repository
+	assert.NoError(rs.T(), err)                                 // evaluate if there was no error in execution
+	assert.Equal(rs.T(), "A-042", order.PickupCode)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *OrderRepositorySuite) TestCreateDrawsThePickupNumberOfTheStoreDay() {
+	order := rs.order
+	order.StoreID = 2
+
+	expectedSQL := "INSERT INTO pickup_code_sequences (.+) ON CONFLICT \\(store_id, business_date\\) DO UPDATE SET last_number = pickup_code_sequences.last_number \\+ 1 RETURNING last_number"
+	rs.mock.ExpectBegin()
+	rs.mock.ExpectQuery(expectedSQL).WithArgs(uint32(2), time.Now().UTC().Format(time.DateOnly)).WillReturnRows(sqlmock.NewRows([]string{"last_number"}).AddRow(7))
+	rs.mock.ExpectQuery("INSERT INTO \"orders\" (.+) VALUES (.+)").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("1"))
+	rs.mock.ExpectQuery("INSERT INTO \"outbox_messages\" (.+) VALUES (.+)").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("1"))
+	rs.mock.ExpectCommit()
+
+	created, err := rs.repo.Create(order, rs.pickupCodeFormat)
+	assert.NoError(rs.T(), err)
+	assert.Equal(rs.T(), "A-007", created.PickupCode)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *OrderRepositorySuite) TestCreateRollsBackOnPickupNumberFailure() {
+	rs.mock.ExpectBegin()
+	rs.mock.ExpectQuery("INSERT INTO pickup_code_sequences (.+)").WillReturnError(errors.New("sequence error"))
+	rs.mock.ExpectRollback()
 
-	_, err := rs.repo.Create(rs.order) // call the Create method of the repository
-	assert.NoError(rs.T(), err)        // evaluate if there was no error in execution
+	_, err := rs.repo.Create(rs.order, rs.pickupCodeFormat)
+	assert.EqualError(rs.T(), err, "sequence error")
 	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
 }
 
 func (rs *OrderRepositorySuite) TestCreateReturnsErrorOnInsertFailure() {
 	expectedSQL := "INSERT INTO \"orders\" (.+) VALUES (.+)"
 	rs.mock.ExpectBegin()
+	rs.expectPickupNumber()
 	rs.mock.ExpectQuery(expectedSQL).WillReturnError(errors.New("insert error"))
 	rs.mock.ExpectRollback()
 
-	_, err := rs.repo.Create(rs.order)
+	_, err := rs.repo.Create(rs.order, rs.pickupCodeFormat)
 	assert.Error(rs.T(), err)
 	assert.Equal(rs.T(), "insert error", err.Error())
 	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
 }
 
+func (rs *OrderRepositorySuite) TestCreateRollsBackOnOutboxFailure() {
+	expectedSQL := "INSERT INTO \"orders\" (.+) VALUES (.+)"
+	expectedOutboxSQL := "INSERT INTO \"outbox_messages\" (.+) VALUES (.+)"
+	addRow := sqlmock.NewRows([]string{"id"}).AddRow("1")
+	rs.mock.ExpectBegin()
+	rs.expectPickupNumber()
+	rs.mock.ExpectQuery(expectedSQL).WillReturnRows(addRow)
+	rs.mock.ExpectQuery(expectedOutboxSQL).WillReturnError(errors.New("outbox error"))
+	rs.mock.ExpectRollback()
+
+	_, err := rs.repo.Create(rs.order, rs.pickupCodeFormat)
+	assert.Error(rs.T(), err)
+	assert.Equal(rs.T(), "outbox error", err.Error())
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *OrderRepositorySuite) TestCreateRedeemsPromotions() {
+	order := rs.order
+	order.Discounts = []entities.OrderDiscount{{PromotionID: 3, PromotionName: "Bem-vindo", Code: "BEMVINDO", Amount: 1000}}
+
+	expectedRedeemSQL := "UPDATE \"promotions\" SET \"times_used\"=times_used \\+ 1 WHERE \\(id = \\$1 AND \\(usage_limit = 0 OR times_used < usage_limit\\)\\)"
+	rs.mock.ExpectBegin()
+	rs.expectPickupNumber()
+	rs.mock.ExpectQuery("INSERT INTO \"orders\" (.+) VALUES (.+)").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("1"))
+	rs.mock.ExpectQuery("INSERT INTO \"order_discounts\" (.+) ON CONFLICT (.+)").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("1"))
+	rs.mock.ExpectExec(expectedRedeemSQL).WithArgs(uint32(3)).WillReturnResult(sqlmock.NewResult(0, 1))
+	rs.mock.ExpectQuery("INSERT INTO \"outbox_messages\" (.+) VALUES (.+)").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("1"))
+	rs.mock.ExpectCommit()
+
+	_, err := rs.repo.Create(order, rs.pickupCodeFormat)
+	assert.NoError(rs.T(), err)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *OrderRepositorySuite) TestCreateRollsBackWhenPromotionRanOut() {
+	order := rs.order
+	order.Discounts = []entities.OrderDiscount{{PromotionID: 3, PromotionName: "Bem-vindo", Amount: 1000}}
+
+	rs.mock.ExpectBegin()
+	rs.expectPickupNumber()
+	rs.mock.ExpectQuery("INSERT INTO \"orders\" (.+) VALUES (.+)").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("1"))
+	rs.mock.ExpectQuery("INSERT INTO \"order_discounts\" (.+)").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("1"))
+	rs.mock.ExpectExec("UPDATE \"promotions\" SET \"times_used\"=.+").WillReturnResult(sqlmock.NewResult(0, 0))
+	rs.mock.ExpectRollback()
+
+	_, err := rs.repo.Create(order, rs.pickupCodeFormat)
+	assert.ErrorIs(rs.T(), err, entities.ErrPromotionRunOut)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *OrderRepositorySuite) TestCreateChecksPromotionsLimitedPerCustomerWithTheCustomerLocked() {
+	customerID := uint32(5)
+	order := rs.order
+	order.CustomerID = &customerID
+	order.Discounts = []entities.OrderDiscount{{PromotionID: 3, PromotionName: "Primeira", Amount: 1000, FirstOrderOnly: true}}
+
+	rs.mock.ExpectBegin()
+	rs.mock.ExpectQuery("SELECT \"id\" FROM \"customers\" WHERE \"customers\".\"id\" = \\$1 (.+) FOR UPDATE").WithArgs(5, 1).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(5))
+	rs.mock.ExpectQuery("SELECT count\\(\\*\\) FROM \"orders\" WHERE customer_id = \\$1 AND status <> \\$2").WithArgs(5, entities.CANCELED_STATUS).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	rs.mock.ExpectQuery("SELECT order_discounts.promotion_id, COUNT\\(\\*\\) AS uses FROM \"order_discounts\" (.+)").WillReturnRows(sqlmock.NewRows([]string{"promotion_id", "uses"}))
+	rs.expectPickupNumber()
+	rs.mock.ExpectQuery("INSERT INTO \"orders\" (.+) VALUES (.+)").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("1"))
+	rs.mock.ExpectQuery("INSERT INTO \"order_discounts\" (.+)").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("1"))
+	rs.mock.ExpectExec("UPDATE \"promotions\" SET \"times_used\"=.+").WillReturnResult(sqlmock.NewResult(0, 1))
+	rs.mock.ExpectQuery("INSERT INTO \"outbox_messages\" (.+) VALUES (.+)").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("1"))
+	rs.mock.ExpectCommit()
+
+	_, err := rs.repo.Create(order, rs.pickupCodeFormat)
+	assert.NoError(rs.T(), err)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *OrderRepositorySuite) TestCreateRollsBackWhenCustomerAlreadyUsedPromotion() {
+	customerID := uint32(5)
+	order := rs.order
+	order.CustomerID = &customerID
+	order.Discounts = []entities.OrderDiscount{{PromotionID: 3, PromotionName: "Primeira", Amount: 1000, FirstOrderOnly: true}}
+
+	rs.mock.ExpectBegin()
+	rs.mock.ExpectQuery("SELECT \"id\" FROM \"customers\" (.+) FOR UPDATE").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(5))
+	rs.mock.ExpectQuery("SELECT count\\(\\*\\) FROM \"orders\" (.+)").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	rs.mock.ExpectQuery("SELECT order_discounts.promotion_id, COUNT\\(\\*\\) AS uses FROM \"order_discounts\" (.+)").WillReturnRows(sqlmock.NewRows([]string{"promotion_id", "uses"}))
+	rs.mock.ExpectRollback()
+
+	_, err := rs.repo.Create(order, rs.pickupCodeFormat)
+	assert.ErrorIs(rs.T(), err, entities.ErrPromotionAlreadyUsed)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *OrderRepositorySuite) TestCreateTakesStockOfTheStoreInItemOrder() {
+	order := rs.order
+	order.StoreID = 2
+	order.Items = []entities.OrderItem{
+		{ItemID: 3, ItemName: "Refrigerante", Quantity: 1},
+		{ItemID: 1, ItemName: "X-Burguer", Quantity: 2},
+	}
+
+	expectedStockSQL := "UPDATE \"store_item_stocks\" SET \"stock\"=stock - \\$1 WHERE store_id = \\$2 AND item_id = \\$3 AND stock >= \\$4"
+	rs.mock.ExpectBegin()
+	rs.expectPickupNumber()
+	rs.mock.ExpectQuery("INSERT INTO \"orders\" (.+) VALUES (.+)").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("1"))
+	rs.mock.ExpectQuery("INSERT INTO \"order_items\" (.+)").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("1").AddRow("2"))
+	rs.mock.ExpectExec(expectedStockSQL).WithArgs(2, 2, 1, 2).WillReturnResult(sqlmock.NewResult(0, 1))
+	rs.mock.ExpectExec(expectedStockSQL).WithArgs(1, 2, 3, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+	rs.mock.ExpectQuery("INSERT INTO \"outbox_messages\" (.+) VALUES (.+)").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("1"))
+	rs.mock.ExpectCommit()
+
+	_, err := rs.repo.Create(order, rs.pickupCodeFormat)
+	assert.NoError(rs.T(), err)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *OrderRepositorySuite) TestCreateSkipsItemsNotTrackedAtTheStore() {
+	order := rs.order
+	order.StoreID = 2
+	order.Items = []entities.OrderItem{{ItemID: 1, ItemName: "X-Burguer", Quantity: 2}}
+
+	expectedTrackedSQL := "SELECT count\\(\\*\\) FROM \"store_item_stocks\" WHERE store_id = \\$1 AND item_id = \\$2"
+	rs.mock.ExpectBegin()
+	rs.expectPickupNumber()
+	rs.mock.ExpectQuery("INSERT INTO \"orders\" (.+) VALUES (.+)").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("1"))
+	rs.mock.ExpectQuery("INSERT INTO \"order_items\" (.+)").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("1"))
+	rs.mock.ExpectExec("UPDATE \"store_item_stocks\" SET \"stock\"=.+").WillReturnResult(sqlmock.NewResult(0, 0))
+	rs.mock.ExpectQuery(expectedTrackedSQL).WithArgs(2, 1).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	rs.mock.ExpectQuery("INSERT INTO \"outbox_messages\" (.+) VALUES (.+)").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("1"))
+	rs.mock.ExpectCommit()
+
+	_, err := rs.repo.Create(order, rs.pickupCodeFormat)
+	assert.NoError(rs.T(), err)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *OrderRepositorySuite) TestCreateRollsBackWhenItemRanOutOfStock() {
+	order := rs.order
+	order.StoreID = 2
+	order.Items = []entities.OrderItem{{ItemID: 1, ItemName: "X-Burguer", Quantity: 2}}
+
+	rs.mock.ExpectBegin()
+	rs.expectPickupNumber()
+	rs.mock.ExpectQuery("INSERT INTO \"orders\" (.+) VALUES (.+)").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("1"))
+	rs.mock.ExpectQuery("INSERT INTO \"order_items\" (.+)").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("1"))
+	rs.mock.ExpectExec("UPDATE \"store_item_stocks\" SET \"stock\"=.+").WillReturnResult(sqlmock.NewResult(0, 0))
+	rs.mock.ExpectQuery("SELECT count\\(\\*\\) FROM \"store_item_stocks\" (.+)").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	rs.mock.ExpectRollback()
+
+	_, err := rs.repo.Create(order, rs.pickupCodeFormat)
+	assert.Equal(rs.T(), &entities.OutOfStockError{ItemID: 1, ItemName: "X-Burguer"}, err)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *OrderRepositorySuite) TestCreateTakesOnlyIngredientsOfLinesMadeFromARecipe() {
+	order := rs.order
+	order.StoreID = 2
+	order.Items = []entities.OrderItem{{ItemID: 1, ItemName: "X-Burguer", Quantity: 2, FromRecipe: true}}
+	order.Ingredients = []entities.OrderIngredient{{IngredientID: 5, Quantity: 300, ItemID: 1, ItemName: "X-Burguer"}}
+
+	expectedIngredientSQL := "UPDATE \"store_ingredient_stocks\" SET \"stock\"=stock - \\$1 WHERE store_id = \\$2 AND ingredient_id = \\$3 AND stock >= \\$4"
+	rs.mock.ExpectBegin()
+	rs.expectPickupNumber()
+	rs.mock.ExpectQuery("INSERT INTO \"orders\" (.+) VALUES (.+)").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("1"))
+	rs.mock.ExpectQuery("INSERT INTO \"order_items\" (.+)").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("1"))
+	rs.mock.ExpectQuery("INSERT INTO \"order_ingredients\" (.+)").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("1"))
+	rs.mock.ExpectExec(expectedIngredientSQL).WithArgs("0.3", 2, 5, "0.3").WillReturnResult(sqlmock.NewResult(0, 1))
+	rs.mock.ExpectQuery("INSERT INTO \"outbox_messages\" (.+) VALUES (.+)").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("1"))
+	rs.mock.ExpectCommit()
+
+	_, err := rs.repo.Create(order, rs.pickupCodeFormat)
+	assert.NoError(rs.T(), err)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *OrderRepositorySuite) TestCreateRollsBackWhenIngredientRanOut() {
+	order := rs.order
+	order.Items = []entities.OrderItem{{ItemID: 1, ItemName: "X-Burguer", Quantity: 2, FromRecipe: true}}
+	order.Ingredients = []entities.OrderIngredient{{IngredientID: 5, Quantity: 300, ItemID: 1, ItemName: "X-Burguer"}}
+
+	rs.mock.ExpectBegin()
+	rs.expectPickupNumber()
+	rs.mock.ExpectQuery("INSERT INTO \"orders\" (.+) VALUES (.+)").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("1"))
+	rs.mock.ExpectQuery("INSERT INTO \"order_items\" (.+)").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("1"))
+	rs.mock.ExpectQuery("INSERT INTO \"order_ingredients\" (.+)").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("1"))
+	rs.mock.ExpectExec("UPDATE \"store_ingredient_stocks\" SET \"stock\"=.+").WillReturnResult(sqlmock.NewResult(0, 0))
+	rs.mock.ExpectRollback()
+
+	_, err := rs.repo.Create(order, rs.pickupCodeFormat)
+	assert.Equal(rs.T(), &entities.OutOfStockError{ItemID: 1, ItemName: "X-Burguer"}, err)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *OrderRepositorySuite) TestCancelRestoresOnlyIngredientsOfLinesMadeFromARecipe() {
+	order := rs.order
+	order.StoreID = 2
+	order.Items = []entities.OrderItem{{ID: 1, OrderID: 1, ItemID: 1, Quantity: 2, FromRecipe: true}}
+	order.Ingredients = []entities.OrderIngredient{{ID: 1, OrderID: 1, IngredientID: 5, Quantity: 300}}
+	order.Cancel("atendente", "cliente desistiu")
+
+	expectedIngredientSQL := "UPDATE \"store_ingredient_stocks\" SET \"stock\"=stock \\+ \\$1 WHERE store_id = \\$2 AND ingredient_id = \\$3"
+	rs.mock.ExpectBegin()
+	rs.mock.ExpectExec("UPDATE \"orders\" SET .+").WillReturnResult(sqlmock.NewResult(1, 1))
+	rs.mock.ExpectQuery("INSERT INTO \"order_items\" (.+) ON CONFLICT (.+)").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("1"))
+	rs.mock.ExpectQuery("INSERT INTO \"order_ingredients\" (.+) ON CONFLICT (.+)").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("1"))
+	rs.mock.ExpectQuery("INSERT INTO \"order_status_history\" (.+)").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("1"))
+	rs.mock.ExpectExec(expectedIngredientSQL).WithArgs("0.3", 2, 5).WillReturnResult(sqlmock.NewResult(0, 1))
+	rs.mock.ExpectQuery("INSERT INTO \"outbox_messages\" (.+) VALUES (.+)").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("1"))
+	rs.mock.ExpectCommit()
+
+	_, err := rs.repo.Cancel(order.ID, order)
+	assert.NoError(rs.T(), err)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *OrderRepositorySuite) TestCancelRestoresStockOfTheStore() {
+	order := rs.order
+	order.StoreID = 2
+	order.Items = []entities.OrderItem{{ID: 1, OrderID: 1, ItemID: 1, Quantity: 2}}
+	order.Cancel("atendente", "cliente desistiu")
+
+	expectedStockSQL := "UPDATE \"store_item_stocks\" SET \"stock\"=stock \\+ \\$1 WHERE store_id = \\$2 AND item_id = \\$3"
+	rs.mock.ExpectBegin()
+	rs.mock.ExpectExec("UPDATE \"orders\" SET .+").WillReturnResult(sqlmock.NewResult(1, 1))
+	rs.mock.ExpectQuery("INSERT INTO \"order_items\" (.+) ON CONFLICT (.+)").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("1"))
+	rs.mock.ExpectQuery("INSERT INTO \"order_status_history\" (.+)").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("1"))
+	rs.mock.ExpectExec(expectedStockSQL).WithArgs(2, 2, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+	rs.mock.ExpectQuery("INSERT INTO \"outbox_messages\" (.+) VALUES (.+)").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("1"))
+	rs.mock.ExpectCommit()
+
+	_, err := rs.repo.Cancel(order.ID, order)
+	assert.NoError(rs.T(), err)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *OrderRepositorySuite) TestCancelReleasesPromotionsWithoutReversingFailedPayment() {
+	order := rs.order
+	order.Status = entities.RECEIVED_STATUS
+	order.PaymentStatus = entities.PAYMENT_PENDING_STATUS
+	order.Discounts = []entities.OrderDiscount{{ID: 1, OrderID: 1, PromotionID: 3, PromotionName: "Bem-vindo", Amount: 1000}}
+	order.ChangePaymentStatus(entities.PAYMENT_REJECTED_STATUS)
+	order.CancelUnpaid()
+
+	expectedReleaseSQL := "UPDATE \"promotions\" SET \"times_used\"=times_used - 1 WHERE \\(id = \\$1 AND times_used > 0\\)"
+	rs.mock.ExpectBegin()
+	rs.mock.ExpectExec("UPDATE \"orders\" SET .+").WillReturnResult(sqlmock.NewResult(1, 1))
+	rs.mock.ExpectQuery("INSERT INTO \"order_discounts\" (.+) ON CONFLICT (.+)").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("1"))
+	rs.mock.ExpectQuery("INSERT INTO \"order_status_history\" (.+)").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("1"))
+	rs.mock.ExpectExec(expectedReleaseSQL).WithArgs(3).WillReturnResult(sqlmock.NewResult(0, 1))
+	rs.mock.ExpectCommit()
+
+	_, err := rs.repo.Cancel(order.ID, order)
+	assert.NoError(rs.T(), err)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *OrderRepositorySuite) TestCancelRollsBackOnOutboxFailure() {
+	order := rs.order
+	order.Cancel("atendente", "cliente desistiu")
+
+	expectedOutboxSQL := "INSERT INTO \"outbox_messages\" (.+) VALUES (.+)"
+	rs.mock.ExpectBegin()
+	rs.mock.ExpectExec("UPDATE \"orders\" SET .+").WillReturnResult(sqlmock.NewResult(1, 1))
+	rs.mock.ExpectQuery("INSERT INTO \"order_status_history\" (.+)").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("1"))
+	rs.mock.ExpectQuery(expectedOutboxSQL).WillReturnError(errors.New("outbox error"))
+	rs.mock.ExpectRollback()
+
+	_, err := rs.repo.Cancel(order.ID, order)
+	assert.Error(rs.T(), err)
+	assert.Equal(rs.T(), "outbox error", err.Error())
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
 func (rs *OrderRepositorySuite) TestUpdate() {
 	expectedSQL := "UPDATE \"orders\" SET .+"
 	rs.mock.ExpectBegin()                                                     // start the transaction
@@ -130,6 +539,33 @@ func (rs *OrderRepositorySuite) TestUpdate() {
 	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
 }
 
+func (rs *OrderRepositorySuite) TestUpdateOnlyStoresTheOrderWithItsLoadedStatuses() {
+	order := entities.Order{ID: 1, Status: entities.RECEIVED_STATUS, PaymentStatus: entities.PAYMENT_APPROVED_STATUS}
+	order.ChangeStatus(entities.IN_PREPARATION_STATUS, "cozinha")
+
+	expectedSQL := "UPDATE \"orders\" SET .+ WHERE \\(status = \\$\\d+ AND payment_status = \\$\\d+\\) AND \"id\" = \\$\\d+"
+	rs.mock.ExpectBegin()
+	rs.mock.ExpectExec(expectedSQL).WillReturnResult(sqlmock.NewResult(0, 0))
+	rs.mock.ExpectRollback()
+
+	_, err := rs.repo.Update(order.ID, order)
+	assert.ErrorIs(rs.T(), err, entities.ErrOrderChanged)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *OrderRepositorySuite) TestCancelLeavesAnOrderChangedMeanwhile() {
+	order := entities.Order{ID: 1, Status: entities.IN_PREPARATION_STATUS, PaymentStatus: entities.PAYMENT_APPROVED_STATUS}
+	order.Cancel("atendente", "cliente desistiu")
+
+	rs.mock.ExpectBegin()
+	rs.mock.ExpectExec("UPDATE \"orders\" SET .+").WillReturnResult(sqlmock.NewResult(0, 0))
+	rs.mock.ExpectRollback()
+
+	_, err := rs.repo.Cancel(order.ID, order)
+	assert.ErrorIs(rs.T(), err, entities.ErrOrderChanged)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
 func (rs *OrderRepositorySuite) TestUpdateReturnsErrorOnUpdateFailure() {
 	expectedSQL := "UPDATE \"orders\" SET .+"
 	rs.mock.ExpectBegin()
@@ -142,6 +578,53 @@ func (rs *OrderRepositorySuite) TestUpdateReturnsErrorOnUpdateFailure() {
 	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
 }
 
+func (rs *OrderRepositorySuite) TestUpdateRecordsStatusChanges() {
+	order := rs.order
+	order.ChangeStatus(entities.IN_PREPARATION_STATUS, "cozinha")
+
+	expectedSQL := "UPDATE \"orders\" SET .+"
+	expectedHistorySQL := "INSERT INTO \"order_status_history\" (.+) VALUES (.+)"
+	rs.mock.ExpectBegin()
+	rs.mock.ExpectExec(expectedSQL).WillReturnResult(sqlmock.NewResult(1, 1))
+	rs.mock.ExpectQuery(expectedHistorySQL).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("1"))
+	rs.mock.ExpectCommit()
+
+	_, err := rs.repo.Update(order.ID, order)
+	assert.NoError(rs.T(), err)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *OrderRepositorySuite) TestUpdateRollsBackOnStatusHistoryFailure() {
+	order := rs.order
+	order.ChangeStatus(entities.IN_PREPARATION_STATUS, "cozinha")
+
+	expectedSQL := "UPDATE \"orders\" SET .+"
+	expectedHistorySQL := "INSERT INTO \"order_status_history\" (.+) VALUES (.+)"
+	rs.mock.ExpectBegin()
+	rs.mock.ExpectExec(expectedSQL).WillReturnResult(sqlmock.NewResult(1, 1))
+	rs.mock.ExpectQuery(expectedHistorySQL).WillReturnError(errors.New("history error"))
+	rs.mock.ExpectRollback()
+
+	_, err := rs.repo.Update(order.ID, order)
+	assert.Error(rs.T(), err)
+	assert.Equal(rs.T(), "history error", err.Error())
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *OrderRepositorySuite) TestGetStatusHistory() {
+	expectedSQL := "SELECT (.+) FROM \"order_status_history\" WHERE order_id = (.+) ORDER BY changed_at ASC,id ASC"
+	rows := sqlmock.NewRows([]string{"id", "order_id", "status", "changed_by"}).
+		AddRow("1", "1", entities.RECEIVED_STATUS, entities.SYSTEM_ACTOR).
+		AddRow("2", "1", entities.IN_PREPARATION_STATUS, "cozinha")
+	rs.mock.ExpectQuery(expectedSQL).WithArgs(rs.order.ID).WillReturnRows(rows)
+
+	history, err := rs.repo.GetStatusHistory(rs.order.ID)
+	assert.NoError(rs.T(), err)
+	assert.Len(rs.T(), history, 2)
+	assert.Equal(rs.T(), "cozinha", history[1].ChangedBy)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
 func TestOrderSuite(t *testing.T) {
 	suite.Run(t, new(OrderRepositorySuite))
 }
@@ -0,0 +1,71 @@
+package gateways
+
+import (
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+	"github.com/8soat-grupo35/fastfood-order/internal/interfaces/repository"
+	"log"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type outboxGateway struct {
+	orm *gorm.DB
+}
+
+func NewOutboxGateway(orm *gorm.DB) repository.OutboxRepository {
+	return &outboxGateway{orm: orm}
+}
+
+// ClaimPending locks the due messages, skipping rows held by other replicas, and pushes their next attempt
+// forward by the lease so they are not picked up again while this replica is delivering them. Messages
+// wait for the earlier pending messages of the same aggregate, so a payment is never reversed before it
+// was requested.
+func (c *outboxGateway) ClaimPending(limit int, lease time.Duration) (messages []entities.OutboxMessage, err error) {
+	err = c.orm.Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+
+		result := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("status = ? AND next_attempt_at <= ?", entities.OUTBOX_PENDING_STATUS, now).
+			Where(
+				`NOT EXISTS (SELECT 1 FROM outbox_messages AS earlier
+				WHERE earlier.aggregate_id = outbox_messages.aggregate_id AND earlier.id < outbox_messages.id AND earlier.status = ?)`,
+				entities.OUTBOX_PENDING_STATUS,
+			).
+			Order("next_attempt_at ASC").
+			Limit(limit).
+			Find(&messages)
+
+		if result.Error != nil || len(messages) == 0 {
+			return result.Error
+		}
+
+		ids := make([]uint32, 0, len(messages))
+		for _, message := range messages {
+			ids = append(ids, message.ID)
+		}
+
+		return tx.Model(&entities.OutboxMessage{}).
+			Where("id IN ?", ids).
+			Update("next_attempt_at", now.Add(lease)).Error
+	})
+
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	return messages, nil
+}
+
+func (c *outboxGateway) Update(message entities.OutboxMessage) error {
+	result := c.orm.Save(&message)
+
+	if result.Error != nil {
+		log.Println(result.Error)
+		return result.Error
+	}
+
+	return nil
+}
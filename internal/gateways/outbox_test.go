@@ -0,0 +1,119 @@
+package gateways
+
+import (
+	"database/sql"
+	"errors"
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"testing"
+	"time"
+)
+
+type OutboxRepositorySuite struct {
+	suite.Suite
+	conn *sql.DB
+	DB   *gorm.DB
+	mock sqlmock.Sqlmock
+
+	repo    *outboxGateway
+	message entities.OutboxMessage
+}
+
+func (rs *OutboxRepositorySuite) SetupSuite() {
+	var (
+		err error
+	)
+
+	rs.conn, rs.mock, err = sqlmock.New()
+	assert.NoError(rs.T(), err)
+
+	dialector := postgres.New(postgres.Config{
+		DriverName: "postgres",
+		Conn:       rs.conn,
+	})
+
+	rs.DB, err = gorm.Open(dialector, &gorm.Config{})
+	assert.NoError(rs.T(), err)
+
+	rs.repo = &outboxGateway{rs.DB}
+	assert.IsType(rs.T(), &outboxGateway{}, rs.repo)
+
+	rs.message = entities.OutboxMessage{
+		ID:        1,
+		EventType: entities.PAYMENT_REQUESTED_EVENT,
+		Status:    entities.OUTBOX_SENT_STATUS,
+	}
+}
+
+func (rs *OutboxRepositorySuite) TestClaimPending() {
+	expectedSelectSQL := "SELECT (.+) FROM \"outbox_messages\" WHERE (.+) AND \\(NOT EXISTS \\(SELECT 1 FROM outbox_messages AS earlier (.+)\\)\\) ORDER BY next_attempt_at ASC LIMIT (.+) FOR UPDATE SKIP LOCKED"
+	expectedUpdateSQL := "UPDATE \"outbox_messages\" SET \"next_attempt_at\"=(.+) WHERE id IN (.+)"
+	messages := sqlmock.NewRows([]string{"id", "event_type"}).AddRow(1, entities.PAYMENT_REQUESTED_EVENT)
+
+	rs.mock.ExpectBegin()
+	rs.mock.ExpectQuery(expectedSelectSQL).WillReturnRows(messages)
+	rs.mock.ExpectExec(expectedUpdateSQL).WillReturnResult(sqlmock.NewResult(0, 1))
+	rs.mock.ExpectCommit()
+
+	result, err := rs.repo.ClaimPending(10, time.Minute)
+	assert.NoError(rs.T(), err)
+	assert.Len(rs.T(), result, 1)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *OutboxRepositorySuite) TestClaimPendingWithoutMessages() {
+	expectedSelectSQL := "SELECT (.+) FROM \"outbox_messages\" WHERE (.+) FOR UPDATE SKIP LOCKED"
+
+	rs.mock.ExpectBegin()
+	rs.mock.ExpectQuery(expectedSelectSQL).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	rs.mock.ExpectCommit()
+
+	result, err := rs.repo.ClaimPending(10, time.Minute)
+	assert.NoError(rs.T(), err)
+	assert.Empty(rs.T(), result)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *OutboxRepositorySuite) TestClaimPendingReturnsErrorOnQueryFailure() {
+	expectedSelectSQL := "SELECT (.+) FROM \"outbox_messages\" WHERE (.+) FOR UPDATE SKIP LOCKED"
+
+	rs.mock.ExpectBegin()
+	rs.mock.ExpectQuery(expectedSelectSQL).WillReturnError(errors.New("query error"))
+	rs.mock.ExpectRollback()
+
+	_, err := rs.repo.ClaimPending(10, time.Minute)
+	assert.Error(rs.T(), err)
+	assert.Equal(rs.T(), "query error", err.Error())
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *OutboxRepositorySuite) TestUpdate() {
+	expectedSQL := "UPDATE \"outbox_messages\" SET .+"
+	rs.mock.ExpectBegin()
+	rs.mock.ExpectExec(expectedSQL).WillReturnResult(sqlmock.NewResult(1, 1))
+	rs.mock.ExpectCommit()
+
+	err := rs.repo.Update(rs.message)
+	assert.NoError(rs.T(), err)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *OutboxRepositorySuite) TestUpdateReturnsErrorOnUpdateFailure() {
+	expectedSQL := "UPDATE \"outbox_messages\" SET .+"
+	rs.mock.ExpectBegin()
+	rs.mock.ExpectExec(expectedSQL).WillReturnError(errors.New("update error"))
+	rs.mock.ExpectRollback()
+
+	err := rs.repo.Update(rs.message)
+	assert.Error(rs.T(), err)
+	assert.Equal(rs.T(), "update error", err.Error())
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func TestOutboxSuite(t *testing.T) {
+	suite.Run(t, new(OutboxRepositorySuite))
+}
@@ -0,0 +1,163 @@
+package gateways
+
+import (
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+	"github.com/8soat-grupo35/fastfood-order/internal/interfaces/repository"
+	"log"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type promotionGateway struct {
+	orm *gorm.DB
+}
+
+func NewPromotionGateway(orm *gorm.DB) repository.PromotionRepository {
+	return &promotionGateway{orm: orm}
+}
+
+func (c *promotionGateway) GetAll(storeId uint32) (promotions []entities.Promotion, err error) {
+	result := c.orm.Where("store_id = ?", storeId).Order("id ASC").Find(&promotions)
+
+	if result.Error != nil {
+		log.Println(result.Error)
+		return promotions, result.Error
+	}
+
+	return promotions, err
+}
+
+func (c *promotionGateway) GetOne(promotionFilter entities.Promotion) (promotion *entities.Promotion, err error) {
+	result := c.orm.Where(promotionFilter).First(&promotion)
+
+	if result.Error != nil {
+		log.Println(result.Error)
+		return nil, result.Error
+	}
+
+	return promotion, nil
+}
+
+// GetAutomatic lists the promotions of the store without a coupon code that are valid at the given time.
+func (c *promotionGateway) GetAutomatic(storeId uint32, at time.Time) (promotions []entities.Promotion, err error) {
+	result := c.orm.
+		Where("store_id = ? AND code = ''", storeId).
+		Where("starts_at IS NULL OR starts_at <= ?", at).
+		Where("ends_at IS NULL OR ends_at > ?", at).
+		Order("id ASC").
+		Find(&promotions)
+
+	if result.Error != nil {
+		log.Println(result.Error)
+		return promotions, result.Error
+	}
+
+	return promotions, err
+}
+
+func (c *promotionGateway) GetByCode(storeId uint32, code string) (*entities.Promotion, error) {
+	promotion := entities.Promotion{}
+	result := c.orm.Where("store_id = ? AND code = ?", storeId, code).First(&promotion)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return &promotion, nil
+}
+
+// GetCustomerHistory counts the orders of the customer and the uses of each promotion in them. Canceled
+// orders are not counted.
+func (c *promotionGateway) GetCustomerHistory(customerId uint32) (*entities.PromotionHistory, error) {
+	return customerPromotionHistory(c.orm, customerId)
+}
+
+func customerPromotionHistory(db *gorm.DB, customerId uint32) (*entities.PromotionHistory, error) {
+	history := entities.PromotionHistory{Redemptions: map[uint32]int64{}}
+
+	result := db.Model(&entities.Order{}).
+		Where("customer_id = ? AND status <> ?", customerId, entities.CANCELED_STATUS).
+		Count(&history.CustomerOrders)
+
+	if result.Error != nil {
+		log.Println(result.Error)
+		return nil, result.Error
+	}
+
+	var redemptions []struct {
+		PromotionID uint32
+		Uses        int64
+	}
+
+	result = db.Table("order_discounts").
+		Select("order_discounts.promotion_id, COUNT(*) AS uses").
+		Joins("JOIN orders ON orders.id = order_discounts.order_id").
+		Where("orders.customer_id = ? AND orders.status <> ?", customerId, entities.CANCELED_STATUS).
+		Group("order_discounts.promotion_id").
+		Scan(&redemptions)
+
+	if result.Error != nil {
+		log.Println(result.Error)
+		return nil, result.Error
+	}
+
+	for _, redemption := range redemptions {
+		history.Redemptions[redemption.PromotionID] = redemption.Uses
+	}
+
+	return &history, nil
+}
+
+func (c *promotionGateway) Create(promotion entities.Promotion) (*entities.Promotion, error) {
+	result := c.orm.Create(&promotion)
+
+	if result.Error != nil {
+		log.Println(result.Error)
+		return nil, result.Error
+	}
+
+	return &promotion, nil
+}
+
+// Update writes every field marketing edits, so limits and dates can be cleared. The uses are kept.
+// Promotions of other stores are left as they are, returning gorm.ErrRecordNotFound.
+func (c *promotionGateway) Update(storeId uint32, promotionId uint32, promotion entities.Promotion) (*entities.Promotion, error) {
+	promotionModel := entities.Promotion{ID: promotionId}
+	result := c.orm.Model(&promotionModel).
+		Where("store_id = ?", storeId).
+		Select(
+			"name", "code", "kind", "category", "percentage_off", "buy_quantity", "free_quantity", "amount",
+			"minimum_total", "first_order_only", "starts_at", "ends_at", "usage_limit", "per_customer_limit",
+		).
+		Updates(&promotion)
+
+	if result.Error != nil {
+		log.Println(result.Error)
+		return nil, result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	promotion.ID = promotionId
+
+	return &promotion, nil
+}
+
+// Delete leaves the promotions of other stores as they are, returning gorm.ErrRecordNotFound.
+func (c *promotionGateway) Delete(storeId uint32, promotionId uint32) error {
+	result := c.orm.Where("store_id = ?", storeId).Delete(&entities.Promotion{}, promotionId)
+
+	if result.Error != nil {
+		log.Println(result.Error)
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,152 @@
+package gateways
+
+import (
+	"database/sql"
+	"errors"
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"testing"
+	"time"
+)
+
+type PromotionRepositorySuite struct {
+	suite.Suite
+	conn *sql.DB
+	DB   *gorm.DB
+	mock sqlmock.Sqlmock
+
+	repo      *promotionGateway
+	promotion entities.Promotion
+}
+
+func (rs *PromotionRepositorySuite) SetupSuite() {
+	var (
+		err error
+	)
+
+	rs.conn, rs.mock, err = sqlmock.New()
+	assert.NoError(rs.T(), err)
+
+	dialector := postgres.New(postgres.Config{
+		DriverName: "postgres",
+		Conn:       rs.conn,
+	})
+
+	rs.DB, err = gorm.Open(dialector, &gorm.Config{})
+	assert.NoError(rs.T(), err)
+
+	rs.repo = &promotionGateway{rs.DB}
+
+	rs.promotion = entities.Promotion{
+		ID:     4,
+		Name:   "Bem-vindo",
+		Code:   "BEMVINDO",
+		Kind:   entities.PROMOTION_FIXED_AMOUNT,
+		Amount: 1000,
+	}
+}
+
+func (rs *PromotionRepositorySuite) TestGetAutomatic() {
+	now := time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC)
+	expectedSQL := "SELECT (.+) FROM \"promotions\" WHERE \\(store_id = \\$1 AND code = ''\\) AND \\(starts_at IS NULL OR starts_at <= \\$2\\) AND \\(ends_at IS NULL OR ends_at > \\$3\\) AND \"promotions\".\"deleted_at\" IS NULL ORDER BY id ASC"
+	rs.mock.ExpectQuery(expectedSQL).WithArgs(2, now, now).WillReturnRows(sqlmock.NewRows([]string{"id", "kind"}).AddRow(1, entities.PROMOTION_CATEGORY_PERCENTAGE))
+
+	promotions, err := rs.repo.GetAutomatic(2, now)
+	assert.NoError(rs.T(), err)
+	assert.Equal(rs.T(), uint32(1), promotions[0].ID)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *PromotionRepositorySuite) TestGetByCode_shouldNotFound() {
+	expectedSQL := "SELECT (.+) FROM \"promotions\" WHERE \\(store_id = \\$1 AND code = \\$2\\) (.+) LIMIT (.+)"
+	rs.mock.ExpectQuery(expectedSQL).WithArgs(2, "NADA", 1).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	promotion, err := rs.repo.GetByCode(2, "NADA")
+	assert.Nil(rs.T(), promotion)
+	assert.ErrorIs(rs.T(), err, gorm.ErrRecordNotFound)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *PromotionRepositorySuite) TestGetCustomerHistory() {
+	expectedCountSQL := "SELECT count\\(\\*\\) FROM \"orders\" WHERE customer_id = \\$1 AND status <> \\$2"
+	rs.mock.ExpectQuery(expectedCountSQL).WithArgs(1, entities.CANCELED_STATUS).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+
+	expectedRedemptionsSQL := "SELECT order_discounts.promotion_id, COUNT\\(\\*\\) AS uses FROM \"order_discounts\" JOIN orders ON (.+) GROUP BY \"order_discounts\".\"promotion_id\""
+	rs.mock.ExpectQuery(expectedRedemptionsSQL).WillReturnRows(sqlmock.NewRows([]string{"promotion_id", "uses"}).AddRow(4, 1))
+
+	history, err := rs.repo.GetCustomerHistory(1)
+	assert.NoError(rs.T(), err)
+	assert.Equal(rs.T(), &entities.PromotionHistory{CustomerOrders: 2, Redemptions: map[uint32]int64{4: 1}}, history)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *PromotionRepositorySuite) TestGetCustomerHistoryReturnsErrorOnQueryFailure() {
+	rs.mock.ExpectQuery("SELECT count\\(\\*\\) FROM \"orders\" (.+)").WillReturnError(errors.New("query error"))
+
+	history, err := rs.repo.GetCustomerHistory(1)
+	assert.Nil(rs.T(), history)
+	assert.Error(rs.T(), err)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *PromotionRepositorySuite) TestCreate() {
+	rs.mock.ExpectBegin()
+	rs.mock.ExpectQuery("INSERT INTO \"promotions\" (.+) VALUES (.+)").WillReturnRows(sqlmock.NewRows([]string{"id", "times_used"}).AddRow(4, 0))
+	rs.mock.ExpectCommit()
+
+	_, err := rs.repo.Create(rs.promotion)
+	assert.NoError(rs.T(), err)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *PromotionRepositorySuite) TestUpdateWritesClearedLimits() {
+	expectedSQL := "UPDATE \"promotions\" SET (.+)\"usage_limit\"=\\$13,\"per_customer_limit\"=\\$14,\"updated_at\"=\\$15 WHERE store_id = \\$16 (.+)"
+	rs.mock.ExpectBegin()
+	rs.mock.ExpectExec(expectedSQL).WillReturnResult(sqlmock.NewResult(0, 1))
+	rs.mock.ExpectCommit()
+
+	updatedPromotion, err := rs.repo.Update(2, rs.promotion.ID, rs.promotion)
+	assert.NoError(rs.T(), err)
+	assert.Equal(rs.T(), rs.promotion.ID, updatedPromotion.ID)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *PromotionRepositorySuite) TestUpdateReturnsNotFoundForPromotionOfAnotherStore() {
+	rs.mock.ExpectBegin()
+	rs.mock.ExpectExec("UPDATE \"promotions\" SET (.+) WHERE store_id = (.+)").WillReturnResult(sqlmock.NewResult(0, 0))
+	rs.mock.ExpectCommit()
+
+	updatedPromotion, err := rs.repo.Update(2, rs.promotion.ID, rs.promotion)
+	assert.Nil(rs.T(), updatedPromotion)
+	assert.ErrorIs(rs.T(), err, gorm.ErrRecordNotFound)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *PromotionRepositorySuite) TestDelete() {
+	expectedSQL := "UPDATE \"promotions\" SET \"deleted_at\"=.+ WHERE store_id = .+ AND \"promotions\".\"id\" =.+ AND \"promotions\".\"deleted_at\" IS NULL"
+	rs.mock.ExpectBegin()
+	rs.mock.ExpectExec(expectedSQL).WillReturnResult(sqlmock.NewResult(1, 1))
+	rs.mock.ExpectCommit()
+
+	err := rs.repo.Delete(2, rs.promotion.ID)
+	assert.NoError(rs.T(), err)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *PromotionRepositorySuite) TestDeleteReturnsNotFoundForPromotionOfAnotherStore() {
+	rs.mock.ExpectBegin()
+	rs.mock.ExpectExec("UPDATE \"promotions\" SET \"deleted_at\"=.+ WHERE store_id = .+").WillReturnResult(sqlmock.NewResult(0, 0))
+	rs.mock.ExpectCommit()
+
+	err := rs.repo.Delete(2, rs.promotion.ID)
+	assert.ErrorIs(rs.T(), err, gorm.ErrRecordNotFound)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func TestPromotionRepositorySuite(t *testing.T) {
+	suite.Run(t, new(PromotionRepositorySuite))
+}
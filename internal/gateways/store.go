@@ -0,0 +1,179 @@
+package gateways
+
+import (
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+	"github.com/8soat-grupo35/fastfood-order/internal/interfaces/repository"
+	"log"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type storeGateway struct {
+	orm *gorm.DB
+}
+
+func NewStoreGateway(orm *gorm.DB) repository.StoreRepository {
+	return &storeGateway{orm: orm}
+}
+
+func (s *storeGateway) GetAll() (stores []entities.Store, err error) {
+	result := s.orm.Order("id ASC").Find(&stores)
+
+	if result.Error != nil {
+		log.Println(result.Error)
+		return stores, result.Error
+	}
+
+	return stores, err
+}
+
+func (s *storeGateway) GetOne(storeFilter entities.Store) (store *entities.Store, err error) {
+	result := s.orm.Where(storeFilter).First(&store)
+
+	if result.Error != nil {
+		log.Println(result.Error)
+		return nil, result.Error
+	}
+
+	return store, nil
+}
+
+func (s *storeGateway) Create(store entities.Store) (*entities.Store, error) {
+	result := s.orm.Create(&store)
+
+	if result.Error != nil {
+		log.Println(result.Error)
+		return nil, result.Error
+	}
+
+	return &store, nil
+}
+
+// Update always writes the active flag, so a store can be deactivated.
+func (s *storeGateway) Update(storeId uint32, store entities.Store) (*entities.Store, error) {
+	storeModel := entities.Store{ID: storeId}
+	result := s.orm.Model(&storeModel).
+		Select("name", "time_zone", "pickup_code_prefix", "active").
+		Updates(&store)
+
+	if result.Error != nil {
+		log.Println(result.Error)
+		return nil, result.Error
+	}
+
+	store.ID = storeId
+
+	return &store, nil
+}
+
+func (s *storeGateway) GetHours(storeId uint32) (hours []entities.StoreHours, err error) {
+	result := s.orm.Where("store_id = ?", storeId).Order("weekday ASC").Find(&hours)
+
+	if result.Error != nil {
+		log.Println(result.Error)
+		return hours, result.Error
+	}
+
+	return hours, err
+}
+
+// ReplaceHours swaps the hours of the whole week of the store, so the days left out become closed.
+func (s *storeGateway) ReplaceHours(storeId uint32, hours []entities.StoreHours) ([]entities.StoreHours, error) {
+	err := s.orm.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("store_id = ?", storeId).Delete(&entities.StoreHours{}).Error; err != nil {
+			return err
+		}
+
+		if len(hours) == 0 {
+			return nil
+		}
+
+		for i := range hours {
+			hours[i].StoreID = storeId
+		}
+
+		return tx.Create(&hours).Error
+	})
+
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	return hours, nil
+}
+
+// GetCalendar lists the calendar days of the store on or after the date, in date order.
+func (s *storeGateway) GetCalendar(storeId uint32, from time.Time) (days []entities.StoreCalendarDay, err error) {
+	result := s.orm.Where("store_id = ? AND date >= ?", storeId, from.Format(time.DateOnly)).Order("date ASC").Find(&days)
+
+	if result.Error != nil {
+		log.Println(result.Error)
+		return days, result.Error
+	}
+
+	return days, err
+}
+
+func (s *storeGateway) GetCalendarDay(storeId uint32, dayId uint32) (day *entities.StoreCalendarDay, err error) {
+	result := s.orm.Where("store_id = ?", storeId).First(&day, dayId)
+
+	if result.Error != nil {
+		log.Println(result.Error)
+		return nil, result.Error
+	}
+
+	return day, nil
+}
+
+func (s *storeGateway) GetCalendarDayByDate(storeId uint32, date time.Time) (day *entities.StoreCalendarDay, err error) {
+	result := s.orm.Where("store_id = ? AND date = ?", storeId, date.Format(time.DateOnly)).First(&day)
+
+	if result.Error != nil {
+		log.Println(result.Error)
+		return nil, result.Error
+	}
+
+	return day, nil
+}
+
+func (s *storeGateway) CreateCalendarDay(day entities.StoreCalendarDay) (*entities.StoreCalendarDay, error) {
+	result := s.orm.Create(&day)
+
+	if result.Error != nil {
+		log.Println(result.Error)
+		return nil, result.Error
+	}
+
+	return &day, nil
+}
+
+// UpdateCalendarDay always writes the closed flag and the hours, so a closed day can be opened again and
+// the other way around. The day stays in its store.
+func (s *storeGateway) UpdateCalendarDay(dayId uint32, day entities.StoreCalendarDay) (*entities.StoreCalendarDay, error) {
+	dayModel := entities.StoreCalendarDay{ID: dayId}
+	result := s.orm.Model(&dayModel).
+		Select("date", "closed", "open_time", "close_time", "description").
+		Updates(&day)
+
+	if result.Error != nil {
+		log.Println(result.Error)
+		return nil, result.Error
+	}
+
+	day.ID = dayId
+
+	return &day, nil
+}
+
+func (s *storeGateway) DeleteCalendarDay(dayId uint32) error {
+	result := s.orm.Delete(&entities.StoreCalendarDay{}, dayId)
+
+	if result.Error != nil {
+		log.Println(result.Error)
+		return result.Error
+	}
+
+	return nil
+}
@@ -0,0 +1,195 @@
+package gateways
+
+import (
+	"database/sql"
+	"errors"
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"testing"
+	"time"
+)
+
+type StoreRepositorySuite struct {
+	suite.Suite
+	conn *sql.DB
+	DB   *gorm.DB
+	mock sqlmock.Sqlmock
+
+	repo *storeGateway
+	day  entities.StoreCalendarDay
+}
+
+func (rs *StoreRepositorySuite) SetupSuite() {
+	var (
+		err error
+	)
+
+	rs.conn, rs.mock, err = sqlmock.New()
+	assert.NoError(rs.T(), err)
+
+	dialector := postgres.New(postgres.Config{
+		DriverName: "postgres",
+		Conn:       rs.conn,
+	})
+
+	rs.DB, err = gorm.Open(dialector, &gorm.Config{})
+	assert.NoError(rs.T(), err)
+
+	rs.repo = &storeGateway{rs.DB}
+	rs.day = entities.StoreCalendarDay{
+		ID:          2,
+		StoreID:     1,
+		Date:        time.Date(2026, time.December, 25, 0, 0, 0, 0, time.UTC),
+		Closed:      true,
+		Description: "Natal",
+	}
+}
+
+func (rs *StoreRepositorySuite) TestGetAll() {
+	expectedSQL := "SELECT (.+) FROM \"stores\" WHERE \"stores\".\"deleted_at\" IS NULL ORDER BY id ASC"
+	rs.mock.ExpectQuery(expectedSQL).WillReturnRows(sqlmock.NewRows([]string{"id", "name", "time_zone", "pickup_code_prefix", "active"}).AddRow(1, "Matriz", "America/Sao_Paulo", "A", true))
+
+	stores, err := rs.repo.GetAll()
+	assert.NoError(rs.T(), err)
+	assert.Equal(rs.T(), "America/Sao_Paulo", stores[0].TimeZone)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *StoreRepositorySuite) TestGetOne_shouldNotFound() {
+	expectedSQL := "SELECT (.+) FROM \"stores\" WHERE (.+) LIMIT (.+)"
+	rs.mock.ExpectQuery(expectedSQL).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	store, err := rs.repo.GetOne(entities.Store{ID: 9})
+	assert.Nil(rs.T(), store)
+	assert.ErrorIs(rs.T(), err, gorm.ErrRecordNotFound)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *StoreRepositorySuite) TestCreate() {
+	rs.mock.ExpectBegin()
+	rs.mock.ExpectQuery("INSERT INTO \"stores\" (.+)").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2))
+	rs.mock.ExpectCommit()
+
+	store, err := rs.repo.Create(entities.Store{Name: "Centro", TimeZone: "America/Manaus", PickupCodePrefix: "C", Active: true})
+	assert.NoError(rs.T(), err)
+	assert.Equal(rs.T(), uint32(2), store.ID)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *StoreRepositorySuite) TestUpdateWritesTheActiveFlag() {
+	store := entities.Store{Name: "Centro", TimeZone: "America/Manaus", PickupCodePrefix: "C"}
+
+	expectedSQL := "UPDATE \"stores\" SET \"name\"=\\$1,\"time_zone\"=\\$2,\"pickup_code_prefix\"=\\$3,\"active\"=\\$4,\"updated_at\"=\\$5 WHERE (.+)"
+	rs.mock.ExpectBegin()
+	rs.mock.ExpectExec(expectedSQL).WithArgs("Centro", "America/Manaus", "C", false, sqlmock.AnyArg(), uint32(2)).WillReturnResult(sqlmock.NewResult(0, 1))
+	rs.mock.ExpectCommit()
+
+	updatedStore, err := rs.repo.Update(2, store)
+	assert.NoError(rs.T(), err)
+	assert.Equal(rs.T(), uint32(2), updatedStore.ID)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *StoreRepositorySuite) TestGetHours() {
+	expectedSQL := "SELECT (.+) FROM \"store_hours\" WHERE store_id = \\$1 ORDER BY weekday ASC"
+	rs.mock.ExpectQuery(expectedSQL).WithArgs(uint32(1)).WillReturnRows(sqlmock.NewRows([]string{"id", "weekday", "open_time", "close_time"}).AddRow(1, 5, "18:00", "02:00"))
+
+	hours, err := rs.repo.GetHours(1)
+	assert.NoError(rs.T(), err)
+	assert.Equal(rs.T(), time.Friday, hours[0].Weekday)
+	assert.Equal(rs.T(), "02:00", hours[0].CloseTime)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *StoreRepositorySuite) TestReplaceHoursRecreatesTheWeek() {
+	hours := []entities.StoreHours{{Weekday: time.Sunday, OpenTime: "10:00", CloseTime: "22:00"}}
+
+	rs.mock.ExpectBegin()
+	rs.mock.ExpectExec("DELETE FROM \"store_hours\" WHERE store_id = \\$1").WithArgs(uint32(1)).WillReturnResult(sqlmock.NewResult(0, 7))
+	rs.mock.ExpectQuery("INSERT INTO \"store_hours\" (.+)").WithArgs(uint32(1), time.Sunday, "10:00", "22:00").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(8))
+	rs.mock.ExpectCommit()
+
+	savedHours, err := rs.repo.ReplaceHours(1, hours)
+	assert.NoError(rs.T(), err)
+	assert.Equal(rs.T(), uint32(8), savedHours[0].ID)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *StoreRepositorySuite) TestReplaceHoursReturnsErrorOnDeleteFailure() {
+	rs.mock.ExpectBegin()
+	rs.mock.ExpectExec("DELETE FROM \"store_hours\" (.+)").WillReturnError(errors.New("delete error"))
+	rs.mock.ExpectRollback()
+
+	_, err := rs.repo.ReplaceHours(1, nil)
+	assert.Error(rs.T(), err)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *StoreRepositorySuite) TestGetCalendarFromTheDate() {
+	expectedSQL := "SELECT (.+) FROM \"store_calendar_days\" WHERE store_id = \\$1 AND date >= \\$2 ORDER BY date ASC"
+	rs.mock.ExpectQuery(expectedSQL).WithArgs(uint32(1), "2026-12-24").WillReturnRows(sqlmock.NewRows([]string{"id", "date", "closed"}).AddRow(2, rs.day.Date, true))
+
+	days, err := rs.repo.GetCalendar(1, time.Date(2026, time.December, 24, 23, 0, 0, 0, time.UTC))
+	assert.NoError(rs.T(), err)
+	assert.Equal(rs.T(), "2026-12-25", days[0].DateKey())
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *StoreRepositorySuite) TestGetCalendarDayByDate_shouldNotFound() {
+	expectedSQL := "SELECT (.+) FROM \"store_calendar_days\" WHERE store_id = \\$1 AND date = \\$2 (.+) LIMIT (.+)"
+	rs.mock.ExpectQuery(expectedSQL).WithArgs(uint32(1), "2026-12-25", 1).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	day, err := rs.repo.GetCalendarDayByDate(1, rs.day.Date)
+	assert.Nil(rs.T(), day)
+	assert.ErrorIs(rs.T(), err, gorm.ErrRecordNotFound)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *StoreRepositorySuite) TestCreateCalendarDay() {
+	rs.mock.ExpectBegin()
+	rs.mock.ExpectQuery("INSERT INTO \"store_calendar_days\" (.+)").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2))
+	rs.mock.ExpectCommit()
+
+	day := rs.day
+	day.ID = 0
+	savedDay, err := rs.repo.CreateCalendarDay(day)
+	assert.NoError(rs.T(), err)
+	assert.Equal(rs.T(), uint32(2), savedDay.ID)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *StoreRepositorySuite) TestUpdateCalendarDayWritesTheClosedFlag() {
+	day := rs.day
+	day.Closed = false
+	day.OpenTime = "12:00"
+	day.CloseTime = "18:00"
+
+	expectedSQL := "UPDATE \"store_calendar_days\" SET \"date\"=\\$1,\"closed\"=\\$2,\"open_time\"=\\$3,\"close_time\"=\\$4,\"description\"=\\$5,\"updated_at\"=\\$6 WHERE (.+)"
+	rs.mock.ExpectBegin()
+	rs.mock.ExpectExec(expectedSQL).WithArgs(day.Date, false, "12:00", "18:00", "Natal", sqlmock.AnyArg(), day.ID).WillReturnResult(sqlmock.NewResult(0, 1))
+	rs.mock.ExpectCommit()
+
+	updatedDay, err := rs.repo.UpdateCalendarDay(day.ID, day)
+	assert.NoError(rs.T(), err)
+	assert.False(rs.T(), updatedDay.Closed)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func (rs *StoreRepositorySuite) TestDeleteCalendarDay() {
+	expectedSQL := "DELETE FROM \"store_calendar_days\" WHERE \"store_calendar_days\".\"id\" = \\$1"
+	rs.mock.ExpectBegin()
+	rs.mock.ExpectExec(expectedSQL).WithArgs(rs.day.ID).WillReturnResult(sqlmock.NewResult(0, 1))
+	rs.mock.ExpectCommit()
+
+	err := rs.repo.DeleteCalendarDay(rs.day.ID)
+	assert.NoError(rs.T(), err)
+	assert.Nil(rs.T(), rs.mock.ExpectationsWereMet())
+}
+
+func TestStoreRepositorySuite(t *testing.T) {
+	suite.Run(t, new(StoreRepositorySuite))
+}
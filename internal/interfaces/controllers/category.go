@@ -0,0 +1,14 @@
+package controllers
+
+import (
+	"github.com/8soat-grupo35/fastfood-order/internal/adapters/dto"
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+)
+
+//go:generate mockgen -source=category.go -destination=mock/category.go
+type CategoryController interface {
+	GetAll(onlyActive bool) ([]entities.Category, error)
+	Create(categoryDto dto.CategoryDto) (*entities.Category, error)
+	Update(categoryId int, categoryDto dto.CategoryDto) (*entities.Category, error)
+	Delete(categoryId int) error
+}
@@ -0,0 +1,14 @@
+package controllers
+
+import (
+	"github.com/8soat-grupo35/fastfood-order/internal/adapters/dto"
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+)
+
+//go:generate mockgen -source=combo.go -destination=mock/combo.go
+type ComboController interface {
+	GetAll() ([]entities.Combo, error)
+	Create(comboDto dto.ComboDto) (*entities.Combo, error)
+	Update(comboId int, comboDto dto.ComboDto) (*entities.Combo, error)
+	Delete(comboId int) error
+}
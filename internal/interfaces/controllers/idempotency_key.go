@@ -0,0 +1,11 @@
+package controllers
+
+import "github.com/8soat-grupo35/fastfood-order/internal/entities"
+
+//go:generate mockgen -source=idempotency_key.go -destination=mock/idempotency_key.go
+type IdempotencyKeyController interface {
+	Begin(key string, scope string, requestBody []byte) (*entities.IdempotencyKey, error)
+	Complete(idempotencyKey entities.IdempotencyKey, responseStatus int, responseBody []byte) error
+	Release(idempotencyKey entities.IdempotencyKey) error
+	Purge() error
+}
@@ -7,8 +7,9 @@ import (
 
 //go:generate mockgen -source=item.go -destination=mock/item.go
 type ItemController interface {
-	GetAllByCategory(category string) ([]entities.Item, error)
-	Create(itemDto dto.ItemDto) (*entities.Item, error)
-	Update(itemId int, itemDto dto.ItemDto) (*entities.Item, error)
+	GetAllByCategory(store entities.Store, category string, includeOffSale bool) ([]entities.Item, error)
+	Create(store entities.Store, itemDto dto.ItemDto) (*entities.Item, error)
+	Update(store entities.Store, itemId int, itemDto dto.ItemDto) (*entities.Item, error)
+	SetAvailability(store entities.Store, itemId int, availabilityDto dto.ItemAvailabilityDto) (*entities.Item, error)
 	Delete(itemId int) error
 }
@@ -42,18 +42,18 @@ func (m *MockItemController) EXPECT() *MockItemControllerMockRecorder {
 }
 
 // Create mocks base method.
-func (m *MockItemController) Create(itemDto dto.ItemDto) (*entities.Item, error) {
+func (m *MockItemController) Create(store entities.Store, itemDto dto.ItemDto) (*entities.Item, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", itemDto)
+	ret := m.ctrl.Call(m, "Create", store, itemDto)
 	ret0, _ := ret[0].(*entities.Item)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockItemControllerMockRecorder) Create(itemDto any) *gomock.Call {
+func (mr *MockItemControllerMockRecorder) Create(store, itemDto any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockItemController)(nil).Create), itemDto)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockItemController)(nil).Create), store, itemDto)
 }
 
 // Delete mocks base method.
@@ -71,31 +71,46 @@ func (mr *MockItemControllerMockRecorder) Delete(itemId any) *gomock.Call {
 }
 
 // GetAllByCategory mocks base method.
-func (m *MockItemController) GetAllByCategory(category string) ([]entities.Item, error) {
+func (m *MockItemController) GetAllByCategory(store entities.Store, category string, includeOffSale bool) ([]entities.Item, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAllByCategory", category)
+	ret := m.ctrl.Call(m, "GetAllByCategory", store, category, includeOffSale)
 	ret0, _ := ret[0].([]entities.Item)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAllByCategory indicates an expected call of GetAllByCategory.
-func (mr *MockItemControllerMockRecorder) GetAllByCategory(category any) *gomock.Call {
+func (mr *MockItemControllerMockRecorder) GetAllByCategory(store, category, includeOffSale any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByCategory", reflect.TypeOf((*MockItemController)(nil).GetAllByCategory), category)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByCategory", reflect.TypeOf((*MockItemController)(nil).GetAllByCategory), store, category, includeOffSale)
+}
+
+// SetAvailability mocks base method.
+func (m *MockItemController) SetAvailability(store entities.Store, itemId int, availabilityDto dto.ItemAvailabilityDto) (*entities.Item, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAvailability", store, itemId, availabilityDto)
+	ret0, _ := ret[0].(*entities.Item)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetAvailability indicates an expected call of SetAvailability.
+func (mr *MockItemControllerMockRecorder) SetAvailability(store, itemId, availabilityDto any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAvailability", reflect.TypeOf((*MockItemController)(nil).SetAvailability), store, itemId, availabilityDto)
 }
 
 // Update mocks base method.
-func (m *MockItemController) Update(itemId int, itemDto dto.ItemDto) (*entities.Item, error) {
+func (m *MockItemController) Update(store entities.Store, itemId int, itemDto dto.ItemDto) (*entities.Item, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", itemId, itemDto)
+	ret := m.ctrl.Call(m, "Update", store, itemId, itemDto)
 	ret0, _ := ret[0].(*entities.Item)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Update indicates an expected call of Update.
-func (mr *MockItemControllerMockRecorder) Update(itemId, itemDto any) *gomock.Call {
+func (mr *MockItemControllerMockRecorder) Update(store, itemId, itemDto any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockItemController)(nil).Update), itemId, itemDto)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockItemController)(nil).Update), store, itemId, itemDto)
 }
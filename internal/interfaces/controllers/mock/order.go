@@ -42,47 +42,152 @@ func (m *MockOrderController) EXPECT() *MockOrderControllerMockRecorder {
 	return m.recorder
 }
 
+// Cancel mocks base method.
+func (m *MockOrderController) Cancel(store entities.Store, id uint32, cancelDto dto.OrderCancelDto) (*entities.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cancel", store, id, cancelDto)
+	ret0, _ := ret[0].(*entities.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Cancel indicates an expected call of Cancel.
+func (mr *MockOrderControllerMockRecorder) Cancel(store, id, cancelDto any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cancel", reflect.TypeOf((*MockOrderController)(nil).Cancel), store, id, cancelDto)
+}
+
 // Checkout mocks base method.
-func (m *MockOrderController) Checkout(orderDto dto.OrderDto) (*presenters.OrderPresenter, error) {
+func (m *MockOrderController) Checkout(store entities.Store, orderDto dto.OrderDto) (*presenters.OrderPresenter, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Checkout", orderDto)
+	ret := m.ctrl.Call(m, "Checkout", store, orderDto)
 	ret0, _ := ret[0].(*presenters.OrderPresenter)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Checkout indicates an expected call of Checkout.
-func (mr *MockOrderControllerMockRecorder) Checkout(orderDto any) *gomock.Call {
+func (mr *MockOrderControllerMockRecorder) Checkout(store, orderDto any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Checkout", reflect.TypeOf((*MockOrderController)(nil).Checkout), orderDto)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Checkout", reflect.TypeOf((*MockOrderController)(nil).Checkout), store, orderDto)
 }
 
 // GetAll mocks base method.
-func (m *MockOrderController) GetAll() ([]entities.Order, error) {
+func (m *MockOrderController) GetAll(store entities.Store) ([]entities.Order, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAll")
+	ret := m.ctrl.Call(m, "GetAll", store)
 	ret0, _ := ret[0].([]entities.Order)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAll indicates an expected call of GetAll.
-func (mr *MockOrderControllerMockRecorder) GetAll() *gomock.Call {
+func (mr *MockOrderControllerMockRecorder) GetAll(store any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockOrderController)(nil).GetAll), store)
+}
+
+// GetByCustomer mocks base method.
+func (m *MockOrderController) GetByCustomer(store entities.Store, customerId uint32) ([]entities.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByCustomer", store, customerId)
+	ret0, _ := ret[0].([]entities.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByCustomer indicates an expected call of GetByCustomer.
+func (mr *MockOrderControllerMockRecorder) GetByCustomer(store, customerId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByCustomer", reflect.TypeOf((*MockOrderController)(nil).GetByCustomer), store, customerId)
+}
+
+// Reorder mocks base method.
+func (m *MockOrderController) Reorder(store entities.Store, id uint32) (*presenters.ReorderPresenter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reorder", store, id)
+	ret0, _ := ret[0].(*presenters.ReorderPresenter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reorder indicates an expected call of Reorder.
+func (mr *MockOrderControllerMockRecorder) Reorder(store, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reorder", reflect.TypeOf((*MockOrderController)(nil).Reorder), store, id)
+}
+
+// Search mocks base method.
+func (m *MockOrderController) Search(store entities.Store, filterDto dto.OrderFilterDto) (*entities.OrderPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", store, filterDto)
+	ret0, _ := ret[0].(*entities.OrderPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockOrderControllerMockRecorder) Search(store, filterDto any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockOrderController)(nil).Search), store, filterDto)
+}
+
+// Timeline mocks base method.
+func (m *MockOrderController) Timeline(store entities.Store, id uint32) ([]entities.OrderStatusHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Timeline", store, id)
+	ret0, _ := ret[0].([]entities.OrderStatusHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Timeline indicates an expected call of Timeline.
+func (mr *MockOrderControllerMockRecorder) Timeline(store, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Timeline", reflect.TypeOf((*MockOrderController)(nil).Timeline), store, id)
+}
+
+// Track mocks base method.
+func (m *MockOrderController) Track(store entities.Store, trackingCode string) (*presenters.OrderTrackingPresenter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Track", store, trackingCode)
+	ret0, _ := ret[0].(*presenters.OrderTrackingPresenter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Track indicates an expected call of Track.
+func (mr *MockOrderControllerMockRecorder) Track(store, trackingCode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Track", reflect.TypeOf((*MockOrderController)(nil).Track), store, trackingCode)
+}
+
+// UpdatePaymentStatus mocks base method.
+func (m *MockOrderController) UpdatePaymentStatus(id uint32, paymentStatus string) (*entities.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePaymentStatus", id, paymentStatus)
+	ret0, _ := ret[0].(*entities.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePaymentStatus indicates an expected call of UpdatePaymentStatus.
+func (mr *MockOrderControllerMockRecorder) UpdatePaymentStatus(id, paymentStatus any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockOrderController)(nil).GetAll))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePaymentStatus", reflect.TypeOf((*MockOrderController)(nil).UpdatePaymentStatus), id, paymentStatus)
 }
 
 // UpdateStatus mocks base method.
-func (m *MockOrderController) UpdateStatus(id uint32, status string) (*entities.Order, error) {
+func (m *MockOrderController) UpdateStatus(store entities.Store, id uint32, statusDto dto.OrderStatusDto) (*entities.Order, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateStatus", id, status)
+	ret := m.ctrl.Call(m, "UpdateStatus", store, id, statusDto)
 	ret0, _ := ret[0].(*entities.Order)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateStatus indicates an expected call of UpdateStatus.
-func (mr *MockOrderControllerMockRecorder) UpdateStatus(id, status any) *gomock.Call {
+func (mr *MockOrderControllerMockRecorder) UpdateStatus(store, id, statusDto any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockOrderController)(nil).UpdateStatus), id, status)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockOrderController)(nil).UpdateStatus), store, id, statusDto)
 }
@@ -8,7 +8,14 @@ import (
 
 //go:generate mockgen -source=order.go -destination=mock/order.go
 type OrderController interface {
-	GetAll() ([]entities.Order, error)
-	Checkout(orderDto dto.OrderDto) (*presenters.OrderPresenter, error)
-	UpdateStatus(id uint32, status string) (*entities.Order, error)
+	GetAll(store entities.Store) ([]entities.Order, error)
+	Search(store entities.Store, filterDto dto.OrderFilterDto) (*entities.OrderPage, error)
+	GetByCustomer(store entities.Store, customerId uint32) ([]entities.Order, error)
+	Track(store entities.Store, trackingCode string) (*presenters.OrderTrackingPresenter, error)
+	Checkout(store entities.Store, orderDto dto.OrderDto) (*presenters.OrderPresenter, error)
+	Reorder(store entities.Store, id uint32) (*presenters.ReorderPresenter, error)
+	UpdateStatus(store entities.Store, id uint32, statusDto dto.OrderStatusDto) (*entities.Order, error)
+	Timeline(store entities.Store, id uint32) ([]entities.OrderStatusHistory, error)
+	Cancel(store entities.Store, id uint32, cancelDto dto.OrderCancelDto) (*entities.Order, error)
+	UpdatePaymentStatus(id uint32, paymentStatus string) (*entities.Order, error)
 }
@@ -0,0 +1,11 @@
+package controllers
+
+import (
+	"context"
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+)
+
+//go:generate mockgen -source=order_event.go -destination=mock/order_event.go
+type OrderEventController interface {
+	Listen(ctx context.Context, handle func(entities.OrderEvent)) error
+}
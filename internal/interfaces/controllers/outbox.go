@@ -0,0 +1,6 @@
+package controllers
+
+//go:generate mockgen -source=outbox.go -destination=mock/outbox.go
+type OutboxController interface {
+	Dispatch() error
+}
@@ -0,0 +1,14 @@
+package controllers
+
+import (
+	"github.com/8soat-grupo35/fastfood-order/internal/adapters/dto"
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+)
+
+//go:generate mockgen -source=promotion.go -destination=mock/promotion.go
+type PromotionController interface {
+	GetAll(store entities.Store) ([]entities.Promotion, error)
+	Create(store entities.Store, promotionDto dto.PromotionDto) (*entities.Promotion, error)
+	Update(store entities.Store, promotionId int, promotionDto dto.PromotionDto) (*entities.Promotion, error)
+	Delete(store entities.Store, promotionId int) error
+}
@@ -0,0 +1,21 @@
+package controllers
+
+import (
+	"github.com/8soat-grupo35/fastfood-order/internal/adapters/dto"
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+)
+
+//go:generate mockgen -source=store.go -destination=mock/store.go
+type StoreController interface {
+	GetAll() ([]entities.Store, error)
+	Resolve(storeId int) (*entities.Store, error)
+	Create(storeDto dto.StoreDto) (*entities.Store, error)
+	Update(storeId int, storeDto dto.StoreDto) (*entities.Store, error)
+	GetStatus(store entities.Store) (*entities.StoreStatus, error)
+	GetHours(store entities.Store) ([]entities.StoreHours, error)
+	UpdateHours(store entities.Store, hoursDto dto.StoreHoursDto) ([]entities.StoreHours, error)
+	GetCalendar(store entities.Store) ([]entities.StoreCalendarDay, error)
+	CreateCalendarDay(store entities.Store, dayDto dto.StoreCalendarDayDto) (*entities.StoreCalendarDay, error)
+	UpdateCalendarDay(store entities.Store, dayId int, dayDto dto.StoreCalendarDayDto) (*entities.StoreCalendarDay, error)
+	DeleteCalendarDay(store entities.Store, dayId int) error
+}
@@ -0,0 +1,13 @@
+package repository
+
+import "github.com/8soat-grupo35/fastfood-order/internal/entities"
+
+//go:generate mockgen -source=category.go -destination=mock/category.go
+type CategoryRepository interface {
+	GetAll(filter entities.Category) ([]entities.Category, error)
+	GetOne(entities.Category) (*entities.Category, error)
+	CountItems(categoryId uint32) (int64, error)
+	Create(category entities.Category) (*entities.Category, error)
+	Update(categoryId uint32, category entities.Category) (*entities.Category, error)
+	Delete(categoryId uint32) error
+}
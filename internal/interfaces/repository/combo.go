@@ -0,0 +1,13 @@
+package repository
+
+import "github.com/8soat-grupo35/fastfood-order/internal/entities"
+
+//go:generate mockgen -source=combo.go -destination=mock/combo.go
+type ComboRepository interface {
+	GetAll() ([]entities.Combo, error)
+	GetOne(entities.Combo) (*entities.Combo, error)
+	GetByIds(ids []uint32) ([]entities.Combo, error)
+	Create(combo entities.Combo) (*entities.Combo, error)
+	Update(comboId uint32, combo entities.Combo) (*entities.Combo, error)
+	Delete(comboId uint32) error
+}
@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+	"time"
+)
+
+//go:generate mockgen -source=idempotency_key.go -destination=mock/idempotency_key.go
+type IdempotencyKeyRepository interface {
+	Reserve(idempotencyKey entities.IdempotencyKey) (*entities.IdempotencyKey, bool, error)
+	Complete(idempotencyKey entities.IdempotencyKey) error
+	Release(idempotencyKey entities.IdempotencyKey) error
+	DeleteExpired(now time.Time) error
+}
@@ -0,0 +1,14 @@
+package repository
+
+import "github.com/8soat-grupo35/fastfood-order/internal/entities"
+
+//go:generate mockgen -source=ingredient.go -destination=mock/ingredient.go
+type IngredientRepository interface {
+	GetAll() ([]entities.Ingredient, error)
+	GetOne(entities.Ingredient) (*entities.Ingredient, error)
+	GetByIds(ids []uint32) ([]entities.Ingredient, error)
+	GetLowStock(storeId uint32) ([]entities.Ingredient, error)
+	Create(ingredient entities.Ingredient) (*entities.Ingredient, error)
+	Update(storeId uint32, ingredientId uint32, ingredient entities.Ingredient) (*entities.Ingredient, error)
+	Delete(ingredientId uint32) error
+}
@@ -6,7 +6,9 @@ import "github.com/8soat-grupo35/fastfood-order/internal/entities"
 type ItemRepository interface {
 	GetAll(entities.Item) ([]entities.Item, error)
 	GetOne(entities.Item) (*entities.Item, error)
+	GetByIds(ids []uint32) ([]entities.Item, error)
 	Create(item entities.Item) (*entities.Item, error)
-	Update(itemId uint32, item entities.Item) (*entities.Item, error)
+	Update(storeId uint32, itemId uint32, item entities.Item) (*entities.Item, error)
+	SetPaused(itemId uint32, paused bool) error
 	Delete(itemId uint32) error
 }
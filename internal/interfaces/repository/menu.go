@@ -0,0 +1,12 @@
+package repository
+
+import "github.com/8soat-grupo35/fastfood-order/internal/entities"
+
+//go:generate mockgen -source=menu.go -destination=mock/menu.go
+type MenuRepository interface {
+	GetAll(storeId uint32) ([]entities.Menu, error)
+	GetOne(entities.Menu) (*entities.Menu, error)
+	Create(menu entities.Menu) (*entities.Menu, error)
+	Update(storeId uint32, menuId uint32, menu entities.Menu) (*entities.Menu, error)
+	Delete(storeId uint32, menuId uint32) error
+}
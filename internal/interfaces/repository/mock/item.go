@@ -84,6 +84,21 @@ func (mr *MockItemRepositoryMockRecorder) GetAll(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockItemRepository)(nil).GetAll), arg0)
 }
 
+// GetByIds mocks base method.
+func (m *MockItemRepository) GetByIds(ids []uint32) ([]entities.Item, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByIds", ids)
+	ret0, _ := ret[0].([]entities.Item)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByIds indicates an expected call of GetByIds.
+func (mr *MockItemRepositoryMockRecorder) GetByIds(ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIds", reflect.TypeOf((*MockItemRepository)(nil).GetByIds), ids)
+}
+
 // GetOne mocks base method.
 func (m *MockItemRepository) GetOne(arg0 entities.Item) (*entities.Item, error) {
 	m.ctrl.T.Helper()
@@ -99,17 +114,31 @@ func (mr *MockItemRepositoryMockRecorder) GetOne(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOne", reflect.TypeOf((*MockItemRepository)(nil).GetOne), arg0)
 }
 
+// SetPaused mocks base method.
+func (m *MockItemRepository) SetPaused(itemId uint32, paused bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPaused", itemId, paused)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPaused indicates an expected call of SetPaused.
+func (mr *MockItemRepositoryMockRecorder) SetPaused(itemId, paused any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPaused", reflect.TypeOf((*MockItemRepository)(nil).SetPaused), itemId, paused)
+}
+
 // Update mocks base method.
-func (m *MockItemRepository) Update(itemId uint32, item entities.Item) (*entities.Item, error) {
+func (m *MockItemRepository) Update(storeId, itemId uint32, item entities.Item) (*entities.Item, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", itemId, item)
+	ret := m.ctrl.Call(m, "Update", storeId, itemId, item)
 	ret0, _ := ret[0].(*entities.Item)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Update indicates an expected call of Update.
-func (mr *MockItemRepositoryMockRecorder) Update(itemId, item any) *gomock.Call {
+func (mr *MockItemRepositoryMockRecorder) Update(storeId, itemId, item any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockItemRepository)(nil).Update), itemId, item)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockItemRepository)(nil).Update), storeId, itemId, item)
 }
@@ -11,6 +11,7 @@ package mock_repository
 
 import (
 	reflect "reflect"
+	time "time"
 
 	entities "github.com/8soat-grupo35/fastfood-order/internal/entities"
 	gomock "go.uber.org/mock/gomock"
@@ -40,34 +41,79 @@ func (m *MockOrderRepository) EXPECT() *MockOrderRepositoryMockRecorder {
 	return m.recorder
 }
 
+// AveragePreparationTime mocks base method.
+func (m *MockOrderRepository) AveragePreparationTime(storeId uint32, sampleSize int) (time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AveragePreparationTime", storeId, sampleSize)
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AveragePreparationTime indicates an expected call of AveragePreparationTime.
+func (mr *MockOrderRepositoryMockRecorder) AveragePreparationTime(storeId, sampleSize any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AveragePreparationTime", reflect.TypeOf((*MockOrderRepository)(nil).AveragePreparationTime), storeId, sampleSize)
+}
+
+// Cancel mocks base method.
+func (m *MockOrderRepository) Cancel(id uint32, order entities.Order) (*entities.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cancel", id, order)
+	ret0, _ := ret[0].(*entities.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Cancel indicates an expected call of Cancel.
+func (mr *MockOrderRepositoryMockRecorder) Cancel(id, order any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cancel", reflect.TypeOf((*MockOrderRepository)(nil).Cancel), id, order)
+}
+
 // Create mocks base method.
-func (m *MockOrderRepository) Create(order entities.Order) (*entities.Order, error) {
+func (m *MockOrderRepository) Create(order entities.Order, pickupCodeFormat entities.PickupCodeFormat) (*entities.Order, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", order)
+	ret := m.ctrl.Call(m, "Create", order, pickupCodeFormat)
 	ret0, _ := ret[0].(*entities.Order)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockOrderRepositoryMockRecorder) Create(order any) *gomock.Call {
+func (mr *MockOrderRepositoryMockRecorder) Create(order, pickupCodeFormat any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockOrderRepository)(nil).Create), order)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockOrderRepository)(nil).Create), order, pickupCodeFormat)
 }
 
 // GetAll mocks base method.
-func (m *MockOrderRepository) GetAll() ([]entities.Order, error) {
+func (m *MockOrderRepository) GetAll(filter entities.OrderFilter) ([]entities.Order, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAll")
+	ret := m.ctrl.Call(m, "GetAll", filter)
 	ret0, _ := ret[0].([]entities.Order)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAll indicates an expected call of GetAll.
-func (mr *MockOrderRepositoryMockRecorder) GetAll() *gomock.Call {
+func (mr *MockOrderRepositoryMockRecorder) GetAll(filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockOrderRepository)(nil).GetAll), filter)
+}
+
+// GetByCustomer mocks base method.
+func (m *MockOrderRepository) GetByCustomer(storeId, customerId uint32) ([]entities.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByCustomer", storeId, customerId)
+	ret0, _ := ret[0].([]entities.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByCustomer indicates an expected call of GetByCustomer.
+func (mr *MockOrderRepositoryMockRecorder) GetByCustomer(storeId, customerId any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockOrderRepository)(nil).GetAll))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByCustomer", reflect.TypeOf((*MockOrderRepository)(nil).GetByCustomer), storeId, customerId)
 }
 
 // GetById mocks base method.
@@ -85,6 +131,51 @@ func (mr *MockOrderRepositoryMockRecorder) GetById(id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockOrderRepository)(nil).GetById), id)
 }
 
+// GetByTrackingCode mocks base method.
+func (m *MockOrderRepository) GetByTrackingCode(storeId uint32, trackingCode string) (*entities.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByTrackingCode", storeId, trackingCode)
+	ret0, _ := ret[0].(*entities.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByTrackingCode indicates an expected call of GetByTrackingCode.
+func (mr *MockOrderRepositoryMockRecorder) GetByTrackingCode(storeId, trackingCode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByTrackingCode", reflect.TypeOf((*MockOrderRepository)(nil).GetByTrackingCode), storeId, trackingCode)
+}
+
+// GetInStore mocks base method.
+func (m *MockOrderRepository) GetInStore(storeId, id uint32) (*entities.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInStore", storeId, id)
+	ret0, _ := ret[0].(*entities.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInStore indicates an expected call of GetInStore.
+func (mr *MockOrderRepositoryMockRecorder) GetInStore(storeId, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInStore", reflect.TypeOf((*MockOrderRepository)(nil).GetInStore), storeId, id)
+}
+
+// GetStatusHistory mocks base method.
+func (m *MockOrderRepository) GetStatusHistory(orderId uint32) ([]entities.OrderStatusHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStatusHistory", orderId)
+	ret0, _ := ret[0].([]entities.OrderStatusHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStatusHistory indicates an expected call of GetStatusHistory.
+func (mr *MockOrderRepositoryMockRecorder) GetStatusHistory(orderId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatusHistory", reflect.TypeOf((*MockOrderRepository)(nil).GetStatusHistory), orderId)
+}
+
 // Update mocks base method.
 func (m *MockOrderRepository) Update(id uint32, order entities.Order) (*entities.Order, error) {
 	m.ctrl.T.Helper()
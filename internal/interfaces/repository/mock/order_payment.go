@@ -53,3 +53,17 @@ func (mr *MockOrderPaymentRepositoryMockRecorder) Create(orderPayment any) *gomo
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockOrderPaymentRepository)(nil).Create), orderPayment)
 }
+
+// Reverse mocks base method.
+func (m *MockOrderPaymentRepository) Reverse(orderPayment dto.OrderPaymentDto) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reverse", orderPayment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reverse indicates an expected call of Reverse.
+func (mr *MockOrderPaymentRepositoryMockRecorder) Reverse(orderPayment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reverse", reflect.TypeOf((*MockOrderPaymentRepository)(nil).Reverse), orderPayment)
+}
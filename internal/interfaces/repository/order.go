@@ -1,11 +1,20 @@
 package repository
 
-import "github.com/8soat-grupo35/fastfood-order/internal/entities"
+import (
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+	"time"
+)
 
 //go:generate mockgen -source=order.go -destination=mock/order.go
 type OrderRepository interface {
-	GetAll() ([]entities.Order, error)
+	GetAll(filter entities.OrderFilter) ([]entities.Order, error)
 	GetById(id uint32) (*entities.Order, error)
-	Create(order entities.Order) (*entities.Order, error)
+	GetInStore(storeId uint32, id uint32) (*entities.Order, error)
+	GetByCustomer(storeId uint32, customerId uint32) ([]entities.Order, error)
+	GetByTrackingCode(storeId uint32, trackingCode string) (*entities.Order, error)
+	AveragePreparationTime(storeId uint32, sampleSize int) (time.Duration, error)
+	GetStatusHistory(orderId uint32) ([]entities.OrderStatusHistory, error)
+	Create(order entities.Order, pickupCodeFormat entities.PickupCodeFormat) (*entities.Order, error)
 	Update(id uint32, order entities.Order) (*entities.Order, error)
+	Cancel(id uint32, order entities.Order) (*entities.Order, error)
 }
@@ -0,0 +1,12 @@
+package repository
+
+import (
+	"context"
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+)
+
+//go:generate mockgen -source=order_event.go -destination=mock/order_event.go
+type OrderEventRepository interface {
+	Publish(event entities.OrderEvent) error
+	Listen(ctx context.Context, handle func(entities.OrderEvent)) error
+}
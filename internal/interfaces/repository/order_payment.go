@@ -7,4 +7,5 @@ import (
 //go:generate mockgen -source=order_payment.go -destination=mock/order_payment.go
 type OrderPaymentRepository interface {
 	Create(orderPayment dto.OrderPaymentDto) error
+	Reverse(orderPayment dto.OrderPaymentDto) error
 }
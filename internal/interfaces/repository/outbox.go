@@ -0,0 +1,12 @@
+package repository
+
+import (
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+	"time"
+)
+
+//go:generate mockgen -source=outbox.go -destination=mock/outbox.go
+type OutboxRepository interface {
+	ClaimPending(limit int, lease time.Duration) ([]entities.OutboxMessage, error)
+	Update(message entities.OutboxMessage) error
+}
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+	"time"
+)
+
+//go:generate mockgen -source=promotion.go -destination=mock/promotion.go
+type PromotionRepository interface {
+	GetAll(storeId uint32) ([]entities.Promotion, error)
+	GetOne(entities.Promotion) (*entities.Promotion, error)
+	GetAutomatic(storeId uint32, at time.Time) ([]entities.Promotion, error)
+	GetByCode(storeId uint32, code string) (*entities.Promotion, error)
+	GetCustomerHistory(customerId uint32) (*entities.PromotionHistory, error)
+	Create(promotion entities.Promotion) (*entities.Promotion, error)
+	Update(storeId uint32, promotionId uint32, promotion entities.Promotion) (*entities.Promotion, error)
+	Delete(storeId uint32, promotionId uint32) error
+}
@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+	"time"
+)
+
+//go:generate mockgen -source=store.go -destination=mock/store.go
+type StoreRepository interface {
+	GetAll() ([]entities.Store, error)
+	GetOne(filter entities.Store) (*entities.Store, error)
+	Create(store entities.Store) (*entities.Store, error)
+	Update(storeId uint32, store entities.Store) (*entities.Store, error)
+	GetHours(storeId uint32) ([]entities.StoreHours, error)
+	ReplaceHours(storeId uint32, hours []entities.StoreHours) ([]entities.StoreHours, error)
+	GetCalendar(storeId uint32, from time.Time) ([]entities.StoreCalendarDay, error)
+	GetCalendarDay(storeId uint32, dayId uint32) (*entities.StoreCalendarDay, error)
+	GetCalendarDayByDate(storeId uint32, date time.Time) (*entities.StoreCalendarDay, error)
+	CreateCalendarDay(day entities.StoreCalendarDay) (*entities.StoreCalendarDay, error)
+	UpdateCalendarDay(dayId uint32, day entities.StoreCalendarDay) (*entities.StoreCalendarDay, error)
+	DeleteCalendarDay(dayId uint32) error
+}
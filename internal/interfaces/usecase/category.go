@@ -0,0 +1,14 @@
+package usecase
+
+import (
+	"github.com/8soat-grupo35/fastfood-order/internal/adapters/dto"
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+)
+
+//go:generate mockgen -source=category.go -destination=mock/category.go
+type CategoryUseCase interface {
+	GetAll(onlyActive bool) ([]entities.Category, error)
+	Create(category dto.CategoryDto) (*entities.Category, error)
+	Update(categoryId uint32, category dto.CategoryDto) (*entities.Category, error)
+	Delete(categoryId uint32) error
+}
@@ -0,0 +1,14 @@
+package usecase
+
+import (
+	"github.com/8soat-grupo35/fastfood-order/internal/adapters/dto"
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+)
+
+//go:generate mockgen -source=combo.go -destination=mock/combo.go
+type ComboUseCase interface {
+	GetAll() ([]entities.Combo, error)
+	Create(combo dto.ComboDto) (*entities.Combo, error)
+	Update(comboId uint32, combo dto.ComboDto) (*entities.Combo, error)
+	Delete(comboId uint32) error
+}
@@ -0,0 +1,15 @@
+package usecase
+
+import (
+	"github.com/8soat-grupo35/fastfood-order/internal/adapters/dto"
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+)
+
+//go:generate mockgen -source=ingredient.go -destination=mock/ingredient.go
+type IngredientUseCase interface {
+	GetAll(store entities.Store) ([]entities.Ingredient, error)
+	GetLowStock(store entities.Store) ([]entities.Ingredient, error)
+	Create(store entities.Store, ingredient dto.IngredientDto) (*entities.Ingredient, error)
+	Update(store entities.Store, ingredientId uint32, ingredient dto.IngredientDto) (*entities.Ingredient, error)
+	Delete(ingredientId uint32) error
+}
@@ -7,8 +7,9 @@ import (
 
 //go:generate mockgen -source=item.go -destination=mock/item.go
 type ItemUseCase interface {
-	GetAll(category string) ([]entities.Item, error)
-	Create(item dto.ItemDto) (*entities.Item, error)
-	Update(itemId uint32, item dto.ItemDto) (*entities.Item, error)
+	GetAll(store entities.Store, category string, includeOffSale bool) ([]entities.Item, error)
+	Create(store entities.Store, item dto.ItemDto) (*entities.Item, error)
+	Update(store entities.Store, itemId uint32, item dto.ItemDto) (*entities.Item, error)
+	SetAvailability(store entities.Store, itemId uint32, availability dto.ItemAvailabilityDto) (*entities.Item, error)
 	Delete(itemId uint32) error
 }
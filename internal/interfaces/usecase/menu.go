@@ -0,0 +1,14 @@
+package usecase
+
+import (
+	"github.com/8soat-grupo35/fastfood-order/internal/adapters/dto"
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+)
+
+//go:generate mockgen -source=menu.go -destination=mock/menu.go
+type MenuUseCase interface {
+	GetAll(store entities.Store) ([]entities.Menu, error)
+	Create(store entities.Store, menu dto.MenuDto) (*entities.Menu, error)
+	Update(store entities.Store, menuId uint32, menu dto.MenuDto) (*entities.Menu, error)
+	Delete(store entities.Store, menuId uint32) error
+}
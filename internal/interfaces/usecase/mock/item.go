@@ -42,18 +42,18 @@ func (m *MockItemUseCase) EXPECT() *MockItemUseCaseMockRecorder {
 }
 
 // Create mocks base method.
-func (m *MockItemUseCase) Create(item dto.ItemDto) (*entities.Item, error) {
+func (m *MockItemUseCase) Create(store entities.Store, item dto.ItemDto) (*entities.Item, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", item)
+	ret := m.ctrl.Call(m, "Create", store, item)
 	ret0, _ := ret[0].(*entities.Item)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockItemUseCaseMockRecorder) Create(item any) *gomock.Call {
+func (mr *MockItemUseCaseMockRecorder) Create(store, item any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockItemUseCase)(nil).Create), item)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockItemUseCase)(nil).Create), store, item)
 }
 
 // Delete mocks base method.
@@ -71,31 +71,46 @@ func (mr *MockItemUseCaseMockRecorder) Delete(itemId any) *gomock.Call {
 }
 
 // GetAll mocks base method.
-func (m *MockItemUseCase) GetAll(category string) ([]entities.Item, error) {
+func (m *MockItemUseCase) GetAll(store entities.Store, category string, includeOffSale bool) ([]entities.Item, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAll", category)
+	ret := m.ctrl.Call(m, "GetAll", store, category, includeOffSale)
 	ret0, _ := ret[0].([]entities.Item)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAll indicates an expected call of GetAll.
-func (mr *MockItemUseCaseMockRecorder) GetAll(category any) *gomock.Call {
+func (mr *MockItemUseCaseMockRecorder) GetAll(store, category, includeOffSale any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockItemUseCase)(nil).GetAll), category)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockItemUseCase)(nil).GetAll), store, category, includeOffSale)
+}
+
+// SetAvailability mocks base method.
+func (m *MockItemUseCase) SetAvailability(store entities.Store, itemId uint32, availability dto.ItemAvailabilityDto) (*entities.Item, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAvailability", store, itemId, availability)
+	ret0, _ := ret[0].(*entities.Item)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetAvailability indicates an expected call of SetAvailability.
+func (mr *MockItemUseCaseMockRecorder) SetAvailability(store, itemId, availability any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAvailability", reflect.TypeOf((*MockItemUseCase)(nil).SetAvailability), store, itemId, availability)
 }
 
 // Update mocks base method.
-func (m *MockItemUseCase) Update(itemId uint32, item dto.ItemDto) (*entities.Item, error) {
+func (m *MockItemUseCase) Update(store entities.Store, itemId uint32, item dto.ItemDto) (*entities.Item, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", itemId, item)
+	ret := m.ctrl.Call(m, "Update", store, itemId, item)
 	ret0, _ := ret[0].(*entities.Item)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Update indicates an expected call of Update.
-func (mr *MockItemUseCaseMockRecorder) Update(itemId, item any) *gomock.Call {
+func (mr *MockItemUseCaseMockRecorder) Update(store, itemId, item any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockItemUseCase)(nil).Update), itemId, item)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockItemUseCase)(nil).Update), store, itemId, item)
 }
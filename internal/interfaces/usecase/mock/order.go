@@ -41,47 +41,152 @@ func (m *MockOrderUseCase) EXPECT() *MockOrderUseCaseMockRecorder {
 	return m.recorder
 }
 
+// Cancel mocks base method.
+func (m *MockOrderUseCase) Cancel(store entities.Store, id uint32, cancelDto dto.OrderCancelDto) (*entities.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cancel", store, id, cancelDto)
+	ret0, _ := ret[0].(*entities.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Cancel indicates an expected call of Cancel.
+func (mr *MockOrderUseCaseMockRecorder) Cancel(store, id, cancelDto any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cancel", reflect.TypeOf((*MockOrderUseCase)(nil).Cancel), store, id, cancelDto)
+}
+
 // Create mocks base method.
-func (m *MockOrderUseCase) Create(order dto.OrderDto) (*entities.Order, error) {
+func (m *MockOrderUseCase) Create(store entities.Store, order dto.OrderDto) (*entities.Order, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", order)
+	ret := m.ctrl.Call(m, "Create", store, order)
 	ret0, _ := ret[0].(*entities.Order)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockOrderUseCaseMockRecorder) Create(order any) *gomock.Call {
+func (mr *MockOrderUseCaseMockRecorder) Create(store, order any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockOrderUseCase)(nil).Create), order)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockOrderUseCase)(nil).Create), store, order)
 }
 
 // GetAll mocks base method.
-func (m *MockOrderUseCase) GetAll() ([]entities.Order, error) {
+func (m *MockOrderUseCase) GetAll(store entities.Store) ([]entities.Order, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAll")
+	ret := m.ctrl.Call(m, "GetAll", store)
 	ret0, _ := ret[0].([]entities.Order)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAll indicates an expected call of GetAll.
-func (mr *MockOrderUseCaseMockRecorder) GetAll() *gomock.Call {
+func (mr *MockOrderUseCaseMockRecorder) GetAll(store any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockOrderUseCase)(nil).GetAll), store)
+}
+
+// GetByCustomer mocks base method.
+func (m *MockOrderUseCase) GetByCustomer(store entities.Store, customerId uint32) ([]entities.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByCustomer", store, customerId)
+	ret0, _ := ret[0].([]entities.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByCustomer indicates an expected call of GetByCustomer.
+func (mr *MockOrderUseCaseMockRecorder) GetByCustomer(store, customerId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByCustomer", reflect.TypeOf((*MockOrderUseCase)(nil).GetByCustomer), store, customerId)
+}
+
+// Reorder mocks base method.
+func (m *MockOrderUseCase) Reorder(store entities.Store, id uint32) (*entities.Reorder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reorder", store, id)
+	ret0, _ := ret[0].(*entities.Reorder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reorder indicates an expected call of Reorder.
+func (mr *MockOrderUseCaseMockRecorder) Reorder(store, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reorder", reflect.TypeOf((*MockOrderUseCase)(nil).Reorder), store, id)
+}
+
+// Search mocks base method.
+func (m *MockOrderUseCase) Search(store entities.Store, filterDto dto.OrderFilterDto) (*entities.OrderPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", store, filterDto)
+	ret0, _ := ret[0].(*entities.OrderPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockOrderUseCaseMockRecorder) Search(store, filterDto any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockOrderUseCase)(nil).Search), store, filterDto)
+}
+
+// Timeline mocks base method.
+func (m *MockOrderUseCase) Timeline(store entities.Store, id uint32) ([]entities.OrderStatusHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Timeline", store, id)
+	ret0, _ := ret[0].([]entities.OrderStatusHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Timeline indicates an expected call of Timeline.
+func (mr *MockOrderUseCaseMockRecorder) Timeline(store, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Timeline", reflect.TypeOf((*MockOrderUseCase)(nil).Timeline), store, id)
+}
+
+// Track mocks base method.
+func (m *MockOrderUseCase) Track(store entities.Store, trackingCode string) (*entities.OrderTracking, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Track", store, trackingCode)
+	ret0, _ := ret[0].(*entities.OrderTracking)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Track indicates an expected call of Track.
+func (mr *MockOrderUseCaseMockRecorder) Track(store, trackingCode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Track", reflect.TypeOf((*MockOrderUseCase)(nil).Track), store, trackingCode)
+}
+
+// UpdatePaymentStatus mocks base method.
+func (m *MockOrderUseCase) UpdatePaymentStatus(id uint32, paymentStatus string) (*entities.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePaymentStatus", id, paymentStatus)
+	ret0, _ := ret[0].(*entities.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePaymentStatus indicates an expected call of UpdatePaymentStatus.
+func (mr *MockOrderUseCaseMockRecorder) UpdatePaymentStatus(id, paymentStatus any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockOrderUseCase)(nil).GetAll))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePaymentStatus", reflect.TypeOf((*MockOrderUseCase)(nil).UpdatePaymentStatus), id, paymentStatus)
 }
 
 // UpdateStatus mocks base method.
-func (m *MockOrderUseCase) UpdateStatus(id uint32, status string) (*entities.Order, error) {
+func (m *MockOrderUseCase) UpdateStatus(store entities.Store, id uint32, statusDto dto.OrderStatusDto) (*entities.Order, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateStatus", id, status)
+	ret := m.ctrl.Call(m, "UpdateStatus", store, id, statusDto)
 	ret0, _ := ret[0].(*entities.Order)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateStatus indicates an expected call of UpdateStatus.
-func (mr *MockOrderUseCaseMockRecorder) UpdateStatus(id, status any) *gomock.Call {
+func (mr *MockOrderUseCaseMockRecorder) UpdateStatus(store, id, statusDto any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockOrderUseCase)(nil).UpdateStatus), id, status)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockOrderUseCase)(nil).UpdateStatus), store, id, statusDto)
 }
@@ -7,7 +7,14 @@ import (
 
 //go:generate mockgen -source=order.go -destination=mock/order.go
 type OrderUseCase interface {
-	GetAll() ([]entities.Order, error)
-	Create(order dto.OrderDto) (*entities.Order, error)
-	UpdateStatus(id uint32, status string) (*entities.Order, error)
+	GetAll(store entities.Store) ([]entities.Order, error)
+	Search(store entities.Store, filterDto dto.OrderFilterDto) (*entities.OrderPage, error)
+	GetByCustomer(store entities.Store, customerId uint32) ([]entities.Order, error)
+	Track(store entities.Store, trackingCode string) (*entities.OrderTracking, error)
+	Create(store entities.Store, order dto.OrderDto) (*entities.Order, error)
+	Reorder(store entities.Store, id uint32) (*entities.Reorder, error)
+	UpdateStatus(store entities.Store, id uint32, statusDto dto.OrderStatusDto) (*entities.Order, error)
+	Timeline(store entities.Store, id uint32) ([]entities.OrderStatusHistory, error)
+	Cancel(store entities.Store, id uint32, cancelDto dto.OrderCancelDto) (*entities.Order, error)
+	UpdatePaymentStatus(id uint32, paymentStatus string) (*entities.Order, error)
 }
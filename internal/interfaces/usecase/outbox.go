@@ -0,0 +1,6 @@
+package usecase
+
+//go:generate mockgen -source=outbox.go -destination=mock/outbox.go
+type OutboxUseCase interface {
+	Dispatch() error
+}
@@ -0,0 +1,14 @@
+package usecase
+
+import (
+	"github.com/8soat-grupo35/fastfood-order/internal/adapters/dto"
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+)
+
+//go:generate mockgen -source=promotion.go -destination=mock/promotion.go
+type PromotionUseCase interface {
+	GetAll(store entities.Store) ([]entities.Promotion, error)
+	Create(store entities.Store, promotion dto.PromotionDto) (*entities.Promotion, error)
+	Update(store entities.Store, promotionId uint32, promotion dto.PromotionDto) (*entities.Promotion, error)
+	Delete(store entities.Store, promotionId uint32) error
+}
@@ -0,0 +1,21 @@
+package usecase
+
+import (
+	"github.com/8soat-grupo35/fastfood-order/internal/adapters/dto"
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+)
+
+//go:generate mockgen -source=store.go -destination=mock/store.go
+type StoreUseCase interface {
+	GetAll() ([]entities.Store, error)
+	Resolve(storeId uint32) (*entities.Store, error)
+	Create(store dto.StoreDto) (*entities.Store, error)
+	Update(storeId uint32, store dto.StoreDto) (*entities.Store, error)
+	GetStatus(store entities.Store) (*entities.StoreStatus, error)
+	GetHours(store entities.Store) ([]entities.StoreHours, error)
+	UpdateHours(store entities.Store, hours dto.StoreHoursDto) ([]entities.StoreHours, error)
+	GetCalendar(store entities.Store) ([]entities.StoreCalendarDay, error)
+	CreateCalendarDay(store entities.Store, day dto.StoreCalendarDayDto) (*entities.StoreCalendarDay, error)
+	UpdateCalendarDay(store entities.Store, dayId uint32, day dto.StoreCalendarDayDto) (*entities.StoreCalendarDay, error)
+	DeleteCalendarDay(store entities.Store, dayId uint32) error
+}
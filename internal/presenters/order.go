@@ -1,5 +1,46 @@
 package presenters
 
+import (
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+	"time"
+)
+
 type OrderPresenter struct {
-	Id uint32 `json:"id"`
+	Id           uint32 `json:"id"`
+	TrackingCode string `json:"tracking_code,omitempty"`
+	PickupCode   string `json:"pickup_code,omitempty"`
+	CustomerName string `json:"customer_name,omitempty"`
+	// Total is what the customer pays, after the Discounts of the promotions applied at checkout.
+	Total     entities.Money           `json:"total"`
+	Discounts []OrderDiscountPresenter `json:"discounts,omitempty"`
 } //@name presenters.OrderPresenter
+
+type OrderDiscountPresenter struct {
+	PromotionName string         `json:"promotion_name"`
+	Code          string         `json:"code,omitempty"`
+	Amount        entities.Money `json:"amount"`
+} //@name presenters.OrderDiscountPresenter
+
+type ReorderPresenter struct {
+	OrderPresenter
+	DroppedItems []DroppedOrderItemPresenter `json:"dropped_items"`
+} //@name presenters.ReorderPresenter
+
+type DroppedOrderItemPresenter struct {
+	Id           uint32 `json:"id"`
+	ItemName     string `json:"item_name"`
+	VariantLabel string `json:"variant_label,omitempty"`
+	ComboName    string `json:"combo_name,omitempty"`
+	Quantity     uint32 `json:"quantity"`
+	Reason       string `json:"reason"`
+} //@name presenters.DroppedOrderItemPresenter
+
+type OrderTrackingPresenter struct {
+	TrackingCode     string     `json:"tracking_code"`
+	PickupCode       string     `json:"pickup_code"`
+	Status           string     `json:"status"`
+	PaymentStatus    string     `json:"payment_status"`
+	CustomerName     string     `json:"customer_name,omitempty"`
+	OrdersAhead      *int       `json:"orders_ahead"`
+	EstimatedReadyAt *time.Time `json:"estimated_ready_at"`
+} //@name presenters.OrderTrackingPresenter
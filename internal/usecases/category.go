@@ -0,0 +1,168 @@
+package usecases
+
+import (
+	"errors"
+	"github.com/8soat-grupo35/fastfood-order/internal/adapters/dto"
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+	"github.com/8soat-grupo35/fastfood-order/internal/interfaces/repository"
+	"github.com/8soat-grupo35/fastfood-order/internal/interfaces/usecase"
+	"log"
+
+	custom_errors "github.com/8soat-grupo35/fastfood-order/internal/api/errors"
+	"gorm.io/gorm"
+)
+
+type categoryService struct {
+	categoryRepository repository.CategoryRepository
+}
+
+func NewCategoryUseCase(categoryRepository repository.CategoryRepository) usecase.CategoryUseCase {
+	return &categoryService{
+		categoryRepository: categoryRepository,
+	}
+}
+
+func (service *categoryService) GetAll(onlyActive bool) ([]entities.Category, error) {
+	categories, err := service.categoryRepository.GetAll(entities.Category{Active: onlyActive})
+
+	if err != nil {
+		return []entities.Category{}, &custom_errors.DatabaseError{
+			Message: "get category from repository has failed",
+		}
+	}
+
+	return categories, nil
+}
+
+func (service *categoryService) Create(category dto.CategoryDto) (*entities.Category, error) {
+	newCategory, err := entities.NewCategory(category)
+
+	if err != nil {
+		return nil, custom_errors.NewValidationError(err)
+	}
+
+	if err = service.checkNameAvailable(*newCategory, 0); err != nil {
+		return nil, err
+	}
+
+	categorySaved, err := service.categoryRepository.Create(*newCategory)
+
+	if err != nil {
+		return nil, errors.New("create category on repository has failed")
+	}
+
+	return categorySaved, nil
+}
+
+func (service *categoryService) Update(categoryId uint32, category dto.CategoryDto) (*entities.Category, error) {
+	categoryToUpdate, err := entities.NewCategory(category)
+
+	if err != nil {
+		return nil, custom_errors.NewValidationError(err)
+	}
+
+	_, err = service.categoryRepository.GetOne(entities.Category{ID: categoryId})
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, &custom_errors.NotFoundError{
+			Message: "category not found to update",
+		}
+	}
+
+	if err != nil {
+		log.Println(err.Error())
+		return nil, &custom_errors.DatabaseError{
+			Message: "error on obtain category to update in repository",
+		}
+	}
+
+	if err = service.checkNameAvailable(*categoryToUpdate, categoryId); err != nil {
+		return nil, err
+	}
+
+	categoryUpdated, err := service.categoryRepository.Update(categoryId, *categoryToUpdate)
+
+	if err != nil {
+		return nil, &custom_errors.DatabaseError{
+			Message: "updated category on repository has failed",
+		}
+	}
+
+	return categoryUpdated, nil
+}
+
+// Delete keeps the categories that still have items, which would be left without one.
+func (service *categoryService) Delete(categoryId uint32) error {
+	_, err := service.categoryRepository.GetOne(entities.Category{ID: categoryId})
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &custom_errors.NotFoundError{
+			Message: "category not found to delete",
+		}
+	}
+
+	if err != nil {
+		log.Println(err.Error())
+		return &custom_errors.DatabaseError{
+			Message: "error on obtain category to delete in repository",
+		}
+	}
+
+	items, err := service.categoryRepository.CountItems(categoryId)
+
+	if err != nil {
+		return &custom_errors.DatabaseError{
+			Message: "count category items on repository has failed",
+		}
+	}
+
+	if items > 0 {
+		return &custom_errors.ConflictError{
+			Message: "category still has items",
+		}
+	}
+
+	err = service.categoryRepository.Delete(categoryId)
+
+	if err != nil {
+		return &custom_errors.DatabaseError{
+			Message: "error on delete in repository",
+		}
+	}
+
+	return nil
+}
+
+// checkNameAvailable rejects a name already used by another category.
+func (service *categoryService) checkNameAvailable(category entities.Category, categoryId uint32) error {
+	existing, err := findCategory(service.categoryRepository, category.Name)
+
+	if err != nil {
+		return err
+	}
+
+	if existing != nil && existing.ID != categoryId {
+		return &custom_errors.ConflictError{
+			Message: "category name already in use",
+		}
+	}
+
+	return nil
+}
+
+// findCategory looks a category up by name. The category is nil when no category has the name.
+func findCategory(categoryRepository repository.CategoryRepository, name string) (*entities.Category, error) {
+	category, err := categoryRepository.GetOne(entities.Category{Name: name})
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, &custom_errors.DatabaseError{
+			Message: "get category by name from repository has failed",
+		}
+	}
+
+	return category, nil
+}
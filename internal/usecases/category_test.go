@@ -0,0 +1,128 @@
+package usecases
+
+import (
+	"errors"
+	"github.com/8soat-grupo35/fastfood-order/internal/adapters/dto"
+	custom_errors "github.com/8soat-grupo35/fastfood-order/internal/api/errors"
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+	mockRepository "github.com/8soat-grupo35/fastfood-order/internal/interfaces/repository/mock"
+	"github.com/8soat-grupo35/fastfood-order/internal/interfaces/usecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+	"testing"
+)
+
+type CategoryUseCaseSuite struct {
+	suite.Suite
+	ctrl    *gomock.Controller
+	repo    *mockRepository.MockCategoryRepository
+	useCase usecase.CategoryUseCase
+}
+
+func (suite *CategoryUseCaseSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.repo = mockRepository.NewMockCategoryRepository(suite.ctrl)
+	suite.useCase = NewCategoryUseCase(suite.repo)
+}
+
+func (suite *CategoryUseCaseSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func breakfastDto() dto.CategoryDto {
+	return dto.CategoryDto{
+		Name:         "café da manhã",
+		DisplayOrder: 5,
+	}
+}
+
+func (suite *CategoryUseCaseSuite) TestGetAllOnlyActive() {
+	expectedCategories := []entities.Category{{ID: 1, Name: "LANCHE", Active: true}}
+
+	suite.repo.EXPECT().GetAll(entities.Category{Active: true}).Return(expectedCategories, nil)
+
+	categories, err := suite.useCase.GetAll(true)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedCategories, categories)
+}
+
+func (suite *CategoryUseCaseSuite) TestGetAllReturnsErrorOnRepositoryFailure() {
+	suite.repo.EXPECT().GetAll(entities.Category{}).Return(nil, errors.New("query error"))
+
+	categories, err := suite.useCase.GetAll(false)
+	assert.Empty(suite.T(), categories)
+	assert.IsType(suite.T(), &custom_errors.DatabaseError{}, err)
+}
+
+func (suite *CategoryUseCaseSuite) TestCreate() {
+	expectedCategory := entities.Category{ID: 5, Name: "CAFÉ DA MANHÃ", DisplayOrder: 5, Active: true}
+
+	suite.repo.EXPECT().GetOne(entities.Category{Name: "CAFÉ DA MANHÃ"}).Return(nil, gorm.ErrRecordNotFound)
+	suite.repo.EXPECT().Create(gomock.Any()).DoAndReturn(func(category entities.Category) (*entities.Category, error) {
+		assert.Equal(suite.T(), "CAFÉ DA MANHÃ", category.Name)
+		assert.True(suite.T(), category.Active)
+		return &expectedCategory, nil
+	})
+
+	category, err := suite.useCase.Create(breakfastDto())
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), &expectedCategory, category)
+}
+
+func (suite *CategoryUseCaseSuite) TestCreateReturnsBadRequestOnInvalidCategory() {
+	category, err := suite.useCase.Create(dto.CategoryDto{Name: " "})
+	assert.Nil(suite.T(), category)
+	assert.IsType(suite.T(), &custom_errors.BadRequestError{}, err)
+}
+
+func (suite *CategoryUseCaseSuite) TestCreateReturnsConflictOnNameInUse() {
+	suite.repo.EXPECT().GetOne(entities.Category{Name: "CAFÉ DA MANHÃ"}).Return(&entities.Category{ID: 5, Name: "CAFÉ DA MANHÃ"}, nil)
+
+	category, err := suite.useCase.Create(breakfastDto())
+	assert.Nil(suite.T(), category)
+	assert.IsType(suite.T(), &custom_errors.ConflictError{}, err)
+}
+
+func (suite *CategoryUseCaseSuite) TestUpdateKeepsItsOwnName() {
+	expectedCategory := entities.Category{ID: 5, Name: "CAFÉ DA MANHÃ"}
+
+	suite.repo.EXPECT().GetOne(entities.Category{ID: 5}).Return(&expectedCategory, nil)
+	suite.repo.EXPECT().GetOne(entities.Category{Name: "CAFÉ DA MANHÃ"}).Return(&expectedCategory, nil)
+	suite.repo.EXPECT().Update(uint32(5), gomock.Any()).Return(&expectedCategory, nil)
+
+	category, err := suite.useCase.Update(5, breakfastDto())
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), &expectedCategory, category)
+}
+
+func (suite *CategoryUseCaseSuite) TestUpdateReturnsNotFoundOnUnknownCategory() {
+	suite.repo.EXPECT().GetOne(entities.Category{ID: 9}).Return(nil, gorm.ErrRecordNotFound)
+
+	category, err := suite.useCase.Update(9, breakfastDto())
+	assert.Nil(suite.T(), category)
+	assert.IsType(suite.T(), &custom_errors.NotFoundError{}, err)
+}
+
+func (suite *CategoryUseCaseSuite) TestDelete() {
+	suite.repo.EXPECT().GetOne(entities.Category{ID: 5}).Return(&entities.Category{ID: 5}, nil)
+	suite.repo.EXPECT().CountItems(uint32(5)).Return(int64(0), nil)
+	suite.repo.EXPECT().Delete(uint32(5)).Return(nil)
+
+	err := suite.useCase.Delete(5)
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *CategoryUseCaseSuite) TestDeleteReturnsConflictOnCategoryWithItems() {
+	suite.repo.EXPECT().GetOne(entities.Category{ID: 1}).Return(&entities.Category{ID: 1}, nil)
+	suite.repo.EXPECT().CountItems(uint32(1)).Return(int64(2), nil)
+
+	err := suite.useCase.Delete(1)
+	assert.IsType(suite.T(), &custom_errors.ConflictError{}, err)
+	assert.Equal(suite.T(), "category still has items", err.Error())
+}
+
+func TestCategoryUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(CategoryUseCaseSuite))
+}
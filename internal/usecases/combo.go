@@ -0,0 +1,137 @@
+package usecases
+
+import (
+	"errors"
+	"github.com/8soat-grupo35/fastfood-order/internal/adapters/dto"
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+	"github.com/8soat-grupo35/fastfood-order/internal/interfaces/repository"
+	"github.com/8soat-grupo35/fastfood-order/internal/interfaces/usecase"
+	"log"
+
+	custom_errors "github.com/8soat-grupo35/fastfood-order/internal/api/errors"
+	"gorm.io/gorm"
+)
+
+type comboService struct {
+	comboRepository repository.ComboRepository
+	itemRepository  repository.ItemRepository
+}
+
+func NewComboUseCase(comboRepository repository.ComboRepository, itemRepository repository.ItemRepository) usecase.ComboUseCase {
+	return &comboService{
+		comboRepository: comboRepository,
+		itemRepository:  itemRepository,
+	}
+}
+
+func (service *comboService) GetAll() ([]entities.Combo, error) {
+	combos, err := service.comboRepository.GetAll()
+
+	if err != nil {
+		return []entities.Combo{}, &custom_errors.DatabaseError{
+			Message: "get combo from repository has failed",
+		}
+	}
+
+	return combos, nil
+}
+
+func (service *comboService) Create(combo dto.ComboDto) (*entities.Combo, error) {
+	newCombo, err := service.newCombo(combo)
+
+	if err != nil {
+		return nil, err
+	}
+
+	comboSaved, err := service.comboRepository.Create(*newCombo)
+
+	if err != nil {
+		return nil, errors.New("create combo on repository has failed")
+	}
+
+	return comboSaved, nil
+}
+
+func (service *comboService) Update(comboId uint32, combo dto.ComboDto) (*entities.Combo, error) {
+	comboToUpdate, err := service.newCombo(combo)
+
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = service.comboRepository.GetOne(entities.Combo{ID: comboId})
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, &custom_errors.NotFoundError{
+			Message: "combo not found to update",
+		}
+	}
+
+	if err != nil {
+		log.Println(err.Error())
+		return nil, &custom_errors.DatabaseError{
+			Message: "error on obtain combo to update in repository",
+		}
+	}
+
+	comboUpdated, err := service.comboRepository.Update(comboId, *comboToUpdate)
+
+	if err != nil {
+		log.Println(err.Error())
+		return nil, &custom_errors.DatabaseError{
+			Message: "updated combo on repository has failed",
+		}
+	}
+
+	return comboUpdated, nil
+}
+
+func (service *comboService) Delete(comboId uint32) error {
+	_, err := service.comboRepository.GetOne(entities.Combo{ID: comboId})
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &custom_errors.NotFoundError{
+			Message: "combo not found to delete",
+		}
+	}
+
+	if err != nil {
+		log.Println(err.Error())
+		return &custom_errors.DatabaseError{
+			Message: "error on obtain combo to delete in repository",
+		}
+	}
+
+	err = service.comboRepository.Delete(comboId)
+
+	if err != nil {
+		return &custom_errors.DatabaseError{
+			Message: "error on delete in repository",
+		}
+	}
+
+	return nil
+}
+
+// newCombo validates the combo and checks the items of its slots are in the catalog, in the slot category.
+func (service *comboService) newCombo(combo dto.ComboDto) (*entities.Combo, error) {
+	newCombo, err := entities.NewCombo(combo)
+
+	if err != nil {
+		return nil, custom_errors.NewValidationError(err)
+	}
+
+	items, err := service.itemRepository.GetByIds(newCombo.ItemIDs())
+
+	if err != nil {
+		return nil, &custom_errors.DatabaseError{
+			Message: "get combo items from repository has failed",
+		}
+	}
+
+	if err = newCombo.ValidateItems(items); err != nil {
+		return nil, custom_errors.NewValidationError(err)
+	}
+
+	return newCombo, nil
+}
@@ -0,0 +1,153 @@
+package usecases
+
+import (
+	"errors"
+	"github.com/8soat-grupo35/fastfood-order/internal/adapters/dto"
+	custom_errors "github.com/8soat-grupo35/fastfood-order/internal/api/errors"
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+	mockRepository "github.com/8soat-grupo35/fastfood-order/internal/interfaces/repository/mock"
+	"github.com/8soat-grupo35/fastfood-order/internal/interfaces/usecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+	"testing"
+)
+
+type ComboUseCaseSuite struct {
+	suite.Suite
+	ctrl     *gomock.Controller
+	repo     *mockRepository.MockComboRepository
+	itemRepo *mockRepository.MockItemRepository
+	useCase  usecase.ComboUseCase
+}
+
+func (suite *ComboUseCaseSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.repo = mockRepository.NewMockComboRepository(suite.ctrl)
+	suite.itemRepo = mockRepository.NewMockItemRepository(suite.ctrl)
+	suite.useCase = NewComboUseCase(suite.repo, suite.itemRepo)
+}
+
+func (suite *ComboUseCaseSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func classicComboDto() dto.ComboDto {
+	return dto.ComboDto{
+		Name:        "Combo Classico",
+		ImageUrl:    "https://example.com/combo.png",
+		PricingRule: "preco_fixo",
+		Price:       35,
+		Slots: []dto.ComboSlotDto{
+			{Category: "lanche", ItemIDs: []uint32{1}},
+			{Category: "acompanhamento", ItemIDs: []uint32{2}},
+			{Category: "bebida", ItemIDs: []uint32{3}},
+		},
+	}
+}
+
+func (suite *ComboUseCaseSuite) TestGetAll() {
+	expectedCombos := []entities.Combo{classicCombo()}
+
+	suite.repo.EXPECT().GetAll().Return(expectedCombos, nil)
+
+	combos, err := suite.useCase.GetAll()
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedCombos, combos)
+}
+
+func (suite *ComboUseCaseSuite) TestGetAllReturnsErrorOnRepositoryFailure() {
+	suite.repo.EXPECT().GetAll().Return(nil, errors.New("query error"))
+
+	combos, err := suite.useCase.GetAll()
+	assert.Empty(suite.T(), combos)
+	assert.IsType(suite.T(), &custom_errors.DatabaseError{}, err)
+}
+
+func (suite *ComboUseCaseSuite) TestCreate() {
+	expectedCombo := classicCombo()
+
+	suite.itemRepo.EXPECT().GetByIds([]uint32{1, 2, 3}).Return(comboItems(), nil)
+	suite.repo.EXPECT().Create(gomock.Any()).DoAndReturn(func(combo entities.Combo) (*entities.Combo, error) {
+		assert.Equal(suite.T(), entities.COMBO_FIXED_PRICE, combo.PricingRule)
+		assert.Equal(suite.T(), "BEBIDA", combo.Slots[2].Category)
+		return &expectedCombo, nil
+	})
+
+	combo, err := suite.useCase.Create(classicComboDto())
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), &expectedCombo, combo)
+}
+
+func (suite *ComboUseCaseSuite) TestCreateReturnsBadRequestOnInvalidCombo() {
+	comboDto := classicComboDto()
+	comboDto.Slots = comboDto.Slots[:1]
+
+	combo, err := suite.useCase.Create(comboDto)
+	assert.Nil(suite.T(), combo)
+	assert.IsType(suite.T(), &custom_errors.BadRequestError{}, err)
+}
+
+func (suite *ComboUseCaseSuite) TestCreateReturnsBadRequestOnItemOfAnotherCategory() {
+	comboDto := classicComboDto()
+	comboDto.Slots[2].ItemIDs = []uint32{2}
+
+	suite.itemRepo.EXPECT().GetByIds([]uint32{1, 2, 2}).Return(comboItems(), nil)
+
+	combo, err := suite.useCase.Create(comboDto)
+	assert.Nil(suite.T(), combo)
+	assert.IsType(suite.T(), &custom_errors.BadRequestError{}, err)
+	assert.Equal(suite.T(), []custom_errors.ErrorDetail{
+		{Field: "slots.2.item_ids.0", Message: "item 2 is not a bebida"},
+	}, err.(*custom_errors.BadRequestError).Details)
+}
+
+func (suite *ComboUseCaseSuite) TestCreateReturnsErrorOnRepositoryFailure() {
+	suite.itemRepo.EXPECT().GetByIds([]uint32{1, 2, 3}).Return(comboItems(), nil)
+	suite.repo.EXPECT().Create(gomock.Any()).Return(nil, errors.New("insert error"))
+
+	combo, err := suite.useCase.Create(classicComboDto())
+	assert.Nil(suite.T(), combo)
+	assert.Equal(suite.T(), "create combo on repository has failed", err.Error())
+}
+
+func (suite *ComboUseCaseSuite) TestUpdate() {
+	expectedCombo := classicCombo()
+
+	suite.itemRepo.EXPECT().GetByIds([]uint32{1, 2, 3}).Return(comboItems(), nil)
+	suite.repo.EXPECT().GetOne(entities.Combo{ID: 7}).Return(&expectedCombo, nil)
+	suite.repo.EXPECT().Update(uint32(7), gomock.Any()).Return(&expectedCombo, nil)
+
+	combo, err := suite.useCase.Update(7, classicComboDto())
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), &expectedCombo, combo)
+}
+
+func (suite *ComboUseCaseSuite) TestUpdateReturnsNotFoundOnUnknownCombo() {
+	suite.itemRepo.EXPECT().GetByIds([]uint32{1, 2, 3}).Return(comboItems(), nil)
+	suite.repo.EXPECT().GetOne(entities.Combo{ID: 9}).Return(nil, gorm.ErrRecordNotFound)
+
+	combo, err := suite.useCase.Update(9, classicComboDto())
+	assert.Nil(suite.T(), combo)
+	assert.IsType(suite.T(), &custom_errors.NotFoundError{}, err)
+}
+
+func (suite *ComboUseCaseSuite) TestDelete() {
+	suite.repo.EXPECT().GetOne(entities.Combo{ID: 7}).Return(&entities.Combo{ID: 7}, nil)
+	suite.repo.EXPECT().Delete(uint32(7)).Return(nil)
+
+	err := suite.useCase.Delete(7)
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *ComboUseCaseSuite) TestDeleteReturnsNotFoundOnUnknownCombo() {
+	suite.repo.EXPECT().GetOne(entities.Combo{ID: 9}).Return(nil, gorm.ErrRecordNotFound)
+
+	err := suite.useCase.Delete(9)
+	assert.IsType(suite.T(), &custom_errors.NotFoundError{}, err)
+}
+
+func TestComboUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(ComboUseCaseSuite))
+}
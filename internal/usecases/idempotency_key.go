@@ -0,0 +1,97 @@
+package usecases
+
+import (
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+	"github.com/8soat-grupo35/fastfood-order/internal/interfaces/repository"
+	"github.com/8soat-grupo35/fastfood-order/internal/interfaces/usecase"
+	"time"
+
+	custom_errors "github.com/8soat-grupo35/fastfood-order/internal/api/errors"
+)
+
+type idempotencyKeyUseCase struct {
+	idempotencyKeyRepository repository.IdempotencyKeyRepository
+}
+
+func NewIdempotencyKeyUseCase(idempotencyKeyRepository repository.IdempotencyKeyRepository) usecase.IdempotencyKeyUseCase {
+	return &idempotencyKeyUseCase{
+		idempotencyKeyRepository: idempotencyKeyRepository,
+	}
+}
+
+// Begin reserves the key for a new request. A completed key that was used with the same body is returned so
+// its response can be replayed.
+func (useCase *idempotencyKeyUseCase) Begin(key string, scope string, requestBody []byte) (*entities.IdempotencyKey, error) {
+	newIdempotencyKey, err := entities.NewIdempotencyKey(key, scope, requestBody)
+
+	if err != nil {
+		return nil, &custom_errors.BadRequestError{
+			Message: err.Error(),
+		}
+	}
+
+	idempotencyKey, created, err := useCase.idempotencyKeyRepository.Reserve(*newIdempotencyKey)
+
+	if err != nil {
+		return nil, &custom_errors.DatabaseError{
+			Message: "reserve idempotency key on repository has failed",
+		}
+	}
+
+	if created {
+		return idempotencyKey, nil
+	}
+
+	if !idempotencyKey.Matches(newIdempotencyKey.RequestHash) {
+		return nil, &custom_errors.ConflictError{
+			Message: "idempotency key was already used with a different request",
+		}
+	}
+
+	if !idempotencyKey.IsCompleted() {
+		return nil, &custom_errors.ConflictError{
+			Message: "a request with this idempotency key is still being processed",
+		}
+	}
+
+	return idempotencyKey, nil
+}
+
+func (useCase *idempotencyKeyUseCase) Complete(idempotencyKey entities.IdempotencyKey, responseStatus int, responseBody []byte) error {
+	idempotencyKey.Complete(responseStatus, responseBody)
+
+	err := useCase.idempotencyKeyRepository.Complete(idempotencyKey)
+
+	if err != nil {
+		return &custom_errors.DatabaseError{
+			Message: "complete idempotency key on repository has failed",
+		}
+	}
+
+	return nil
+}
+
+func (useCase *idempotencyKeyUseCase) Release(idempotencyKey entities.IdempotencyKey) error {
+	err := useCase.idempotencyKeyRepository.Release(idempotencyKey)
+
+	if err != nil {
+		return &custom_errors.DatabaseError{
+			Message: "release idempotency key on repository has failed",
+		}
+	}
+
+	return nil
+}
+
+// Purge deletes the keys that can no longer be replayed nor are held by a request.
+func (useCase *idempotencyKeyUseCase) Purge() error {
+	err := useCase.idempotencyKeyRepository.DeleteExpired(time.Now())
+
+	if err != nil {
+		return &custom_errors.DatabaseError{
+			Message: "delete expired idempotency keys on repository has failed",
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,115 @@
+package usecases
+
+import (
+	"errors"
+	custom_errors "github.com/8soat-grupo35/fastfood-order/internal/api/errors"
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+	mockRepository "github.com/8soat-grupo35/fastfood-order/internal/interfaces/repository/mock"
+	"github.com/8soat-grupo35/fastfood-order/internal/interfaces/usecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+	"testing"
+)
+
+type IdempotencyKeyUseCaseSuite struct {
+	suite.Suite
+	ctrl    *gomock.Controller
+	repo    *mockRepository.MockIdempotencyKeyRepository
+	useCase usecase.IdempotencyKeyUseCase
+}
+
+func (suite *IdempotencyKeyUseCaseSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.repo = mockRepository.NewMockIdempotencyKeyRepository(suite.ctrl)
+	suite.useCase = NewIdempotencyKeyUseCase(suite.repo)
+}
+
+func (suite *IdempotencyKeyUseCaseSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func (suite *IdempotencyKeyUseCaseSuite) TestBeginReservesNewKey() {
+	reservedKey := &entities.IdempotencyKey{ID: 1, Key: "abc"}
+
+	suite.repo.EXPECT().Reserve(gomock.Any()).Return(reservedKey, true, nil)
+
+	idempotencyKey, err := suite.useCase.Begin("abc", "POST /v1/orders/checkout", []byte(`{}`))
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), reservedKey, idempotencyKey)
+}
+
+func (suite *IdempotencyKeyUseCaseSuite) TestBeginReturnsCompletedKeyForReplay() {
+	storedKey := &entities.IdempotencyKey{ID: 1, Key: "abc", RequestHash: entities.HashRequest([]byte(`{}`)), ResponseStatus: 200}
+
+	suite.repo.EXPECT().Reserve(gomock.Any()).Return(storedKey, false, nil)
+
+	idempotencyKey, err := suite.useCase.Begin("abc", "POST /v1/orders/checkout", []byte(`{}`))
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), idempotencyKey.IsCompleted())
+}
+
+func (suite *IdempotencyKeyUseCaseSuite) TestBeginReturnsConflictOnDifferentBody() {
+	storedKey := &entities.IdempotencyKey{ID: 1, Key: "abc", RequestHash: entities.HashRequest([]byte(`{"a":1}`)), ResponseStatus: 200}
+
+	suite.repo.EXPECT().Reserve(gomock.Any()).Return(storedKey, false, nil)
+
+	idempotencyKey, err := suite.useCase.Begin("abc", "POST /v1/orders/checkout", []byte(`{"a":2}`))
+	assert.Nil(suite.T(), idempotencyKey)
+	assert.IsType(suite.T(), &custom_errors.ConflictError{}, err)
+	assert.Equal(suite.T(), "idempotency key was already used with a different request", err.Error())
+}
+
+func (suite *IdempotencyKeyUseCaseSuite) TestBeginReturnsConflictWhileInProgress() {
+	storedKey := &entities.IdempotencyKey{ID: 1, Key: "abc", RequestHash: entities.HashRequest([]byte(`{}`))}
+
+	suite.repo.EXPECT().Reserve(gomock.Any()).Return(storedKey, false, nil)
+
+	idempotencyKey, err := suite.useCase.Begin("abc", "POST /v1/orders/checkout", []byte(`{}`))
+	assert.Nil(suite.T(), idempotencyKey)
+	assert.IsType(suite.T(), &custom_errors.ConflictError{}, err)
+}
+
+func (suite *IdempotencyKeyUseCaseSuite) TestBeginReturnsErrorOnRepositoryFailure() {
+	suite.repo.EXPECT().Reserve(gomock.Any()).Return(nil, false, errors.New("insert error"))
+
+	idempotencyKey, err := suite.useCase.Begin("abc", "POST /v1/orders/checkout", []byte(`{}`))
+	assert.Nil(suite.T(), idempotencyKey)
+	assert.IsType(suite.T(), &custom_errors.DatabaseError{}, err)
+}
+
+func (suite *IdempotencyKeyUseCaseSuite) TestComplete() {
+	suite.repo.EXPECT().Complete(gomock.Any()).DoAndReturn(func(idempotencyKey entities.IdempotencyKey) error {
+		assert.Equal(suite.T(), 200, idempotencyKey.ResponseStatus)
+		assert.Equal(suite.T(), `{"id":1}`, idempotencyKey.ResponseBody)
+		return nil
+	})
+
+	err := suite.useCase.Complete(entities.IdempotencyKey{ID: 1}, 200, []byte(`{"id":1}`))
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *IdempotencyKeyUseCaseSuite) TestRelease() {
+	suite.repo.EXPECT().Release(entities.IdempotencyKey{ID: 1}).Return(nil)
+
+	err := suite.useCase.Release(entities.IdempotencyKey{ID: 1})
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *IdempotencyKeyUseCaseSuite) TestPurge() {
+	suite.repo.EXPECT().DeleteExpired(gomock.Any()).Return(nil)
+
+	err := suite.useCase.Purge()
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *IdempotencyKeyUseCaseSuite) TestPurgeReturnsErrorOnRepositoryFailure() {
+	suite.repo.EXPECT().DeleteExpired(gomock.Any()).Return(errors.New("delete error"))
+
+	err := suite.useCase.Purge()
+	assert.IsType(suite.T(), &custom_errors.DatabaseError{}, err)
+}
+
+func TestIdempotencyKeyUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(IdempotencyKeyUseCaseSuite))
+}
@@ -0,0 +1,136 @@
+package usecases
+
+import (
+	"errors"
+	"github.com/8soat-grupo35/fastfood-order/internal/adapters/dto"
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+	"github.com/8soat-grupo35/fastfood-order/internal/interfaces/repository"
+	"github.com/8soat-grupo35/fastfood-order/internal/interfaces/usecase"
+	"log"
+
+	custom_errors "github.com/8soat-grupo35/fastfood-order/internal/api/errors"
+	"gorm.io/gorm"
+)
+
+type ingredientService struct {
+	ingredientRepository repository.IngredientRepository
+}
+
+func NewIngredientUseCase(ingredientRepository repository.IngredientRepository) usecase.IngredientUseCase {
+	return &ingredientService{
+		ingredientRepository: ingredientRepository,
+	}
+}
+
+// GetAll lists the ingredients with their stock at the store.
+func (service *ingredientService) GetAll(store entities.Store) ([]entities.Ingredient, error) {
+	ingredients, err := service.ingredientRepository.GetAll()
+
+	if err != nil {
+		return []entities.Ingredient{}, &custom_errors.DatabaseError{
+			Message: "get ingredient from repository has failed",
+		}
+	}
+
+	for i := range ingredients {
+		ingredients[i].ApplyStock(store.ID)
+	}
+
+	return ingredients, nil
+}
+
+// GetLowStock lists the ingredients running out at the store.
+func (service *ingredientService) GetLowStock(store entities.Store) ([]entities.Ingredient, error) {
+	ingredients, err := service.ingredientRepository.GetLowStock(store.ID)
+
+	if err != nil {
+		return []entities.Ingredient{}, &custom_errors.DatabaseError{
+			Message: "get low stock ingredients from repository has failed",
+		}
+	}
+
+	for i := range ingredients {
+		ingredients[i].ApplyStock(store.ID)
+	}
+
+	return ingredients, nil
+}
+
+// Create registers the ingredient with the stock given as the one left at the store.
+func (service *ingredientService) Create(store entities.Store, ingredient dto.IngredientDto) (*entities.Ingredient, error) {
+	newIngredient, err := entities.NewIngredient(ingredient)
+
+	if err != nil {
+		return nil, custom_errors.NewValidationError(err)
+	}
+
+	newIngredient.KeepStockAt(store.ID)
+	ingredientSaved, err := service.ingredientRepository.Create(*newIngredient)
+
+	if err != nil {
+		return nil, errors.New("create ingredient on repository has failed")
+	}
+
+	return ingredientSaved, nil
+}
+
+// Update writes the ingredient with the stock given as the one left at the store.
+func (service *ingredientService) Update(store entities.Store, ingredientId uint32, ingredient dto.IngredientDto) (*entities.Ingredient, error) {
+	ingredientToUpdate, err := entities.NewIngredient(ingredient)
+
+	if err != nil {
+		return nil, custom_errors.NewValidationError(err)
+	}
+
+	_, err = service.ingredientRepository.GetOne(entities.Ingredient{ID: ingredientId})
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, &custom_errors.NotFoundError{
+			Message: "ingredient not found to update",
+		}
+	}
+
+	if err != nil {
+		log.Println(err.Error())
+		return nil, &custom_errors.DatabaseError{
+			Message: "error on obtain ingredient to update in repository",
+		}
+	}
+
+	ingredientUpdated, err := service.ingredientRepository.Update(store.ID, ingredientId, *ingredientToUpdate)
+
+	if err != nil {
+		return nil, &custom_errors.DatabaseError{
+			Message: "updated ingredient on repository has failed",
+		}
+	}
+
+	return ingredientUpdated, nil
+}
+
+func (service *ingredientService) Delete(ingredientId uint32) error {
+	_, err := service.ingredientRepository.GetOne(entities.Ingredient{ID: ingredientId})
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &custom_errors.NotFoundError{
+			Message: "ingredient not found to delete",
+		}
+	}
+
+	if err != nil {
+		log.Println(err.Error())
+		return &custom_errors.DatabaseError{
+			Message: "error on obtain ingredient to delete in repository",
+		}
+	}
+
+	err = service.ingredientRepository.Delete(ingredientId)
+
+	if err != nil {
+		return &custom_errors.DatabaseError{
+			Message: "error on delete in repository",
+		}
+	}
+
+	return nil
+}
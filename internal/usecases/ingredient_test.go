@@ -0,0 +1,130 @@
+package usecases
+
+import (
+	"errors"
+	"github.com/8soat-grupo35/fastfood-order/internal/adapters/dto"
+	custom_errors "github.com/8soat-grupo35/fastfood-order/internal/api/errors"
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+	mockRepository "github.com/8soat-grupo35/fastfood-order/internal/interfaces/repository/mock"
+	"github.com/8soat-grupo35/fastfood-order/internal/interfaces/usecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+	"testing"
+)
+
+type IngredientUseCaseSuite struct {
+	suite.Suite
+	ctrl    *gomock.Controller
+	repo    *mockRepository.MockIngredientRepository
+	useCase usecase.IngredientUseCase
+}
+
+func (suite *IngredientUseCaseSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.repo = mockRepository.NewMockIngredientRepository(suite.ctrl)
+	suite.useCase = NewIngredientUseCase(suite.repo)
+}
+
+func (suite *IngredientUseCaseSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func cheeseDto() dto.IngredientDto {
+	return dto.IngredientDto{
+		Name:              "Queijo",
+		Unit:              "g",
+		Stock:             2000,
+		LowStockThreshold: 500,
+	}
+}
+
+func (suite *IngredientUseCaseSuite) TestGetAll() {
+	expectedIngredients := []entities.Ingredient{{ID: 1, Name: "Queijo"}}
+
+	suite.repo.EXPECT().GetAll().Return(expectedIngredients, nil)
+
+	ingredients, err := suite.useCase.GetAll(matriz)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedIngredients, ingredients)
+}
+
+func (suite *IngredientUseCaseSuite) TestGetLowStock() {
+	expectedIngredients := []entities.Ingredient{{ID: 1, Name: "Queijo", Stock: 400000, LowStockThreshold: 500000}}
+
+	suite.repo.EXPECT().GetLowStock(matriz.ID).Return(expectedIngredients, nil)
+
+	ingredients, err := suite.useCase.GetLowStock(matriz)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedIngredients, ingredients)
+}
+
+func (suite *IngredientUseCaseSuite) TestGetLowStockReturnsErrorOnRepositoryFailure() {
+	suite.repo.EXPECT().GetLowStock(matriz.ID).Return(nil, errors.New("query error"))
+
+	ingredients, err := suite.useCase.GetLowStock(matriz)
+	assert.Empty(suite.T(), ingredients)
+	assert.IsType(suite.T(), &custom_errors.DatabaseError{}, err)
+}
+
+func (suite *IngredientUseCaseSuite) TestCreate() {
+	expectedIngredient := entities.Ingredient{ID: 1, Name: "Queijo", Unit: entities.INGREDIENT_GRAM}
+
+	suite.repo.EXPECT().Create(gomock.Any()).DoAndReturn(func(ingredient entities.Ingredient) (*entities.Ingredient, error) {
+		assert.Equal(suite.T(), entities.INGREDIENT_GRAM, ingredient.Unit)
+		assert.Equal(suite.T(), []entities.StoreIngredientStock{{StoreID: matriz.ID, Stock: 2000000}}, ingredient.Stocks)
+		return &expectedIngredient, nil
+	})
+
+	ingredient, err := suite.useCase.Create(matriz, cheeseDto())
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), &expectedIngredient, ingredient)
+}
+
+func (suite *IngredientUseCaseSuite) TestCreateReturnsBadRequestOnInvalidIngredient() {
+	ingredientDto := cheeseDto()
+	ingredientDto.Unit = "fatia"
+
+	ingredient, err := suite.useCase.Create(matriz, ingredientDto)
+	assert.Nil(suite.T(), ingredient)
+	assert.IsType(suite.T(), &custom_errors.BadRequestError{}, err)
+}
+
+func (suite *IngredientUseCaseSuite) TestUpdate() {
+	expectedIngredient := entities.Ingredient{ID: 4, Name: "Queijo"}
+
+	suite.repo.EXPECT().GetOne(entities.Ingredient{ID: 4}).Return(&expectedIngredient, nil)
+	suite.repo.EXPECT().Update(matriz.ID, uint32(4), gomock.Any()).Return(&expectedIngredient, nil)
+
+	ingredient, err := suite.useCase.Update(matriz, 4, cheeseDto())
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), &expectedIngredient, ingredient)
+}
+
+func (suite *IngredientUseCaseSuite) TestUpdateReturnsNotFoundOnUnknownIngredient() {
+	suite.repo.EXPECT().GetOne(entities.Ingredient{ID: 9}).Return(nil, gorm.ErrRecordNotFound)
+
+	ingredient, err := suite.useCase.Update(matriz, 9, cheeseDto())
+	assert.Nil(suite.T(), ingredient)
+	assert.IsType(suite.T(), &custom_errors.NotFoundError{}, err)
+}
+
+func (suite *IngredientUseCaseSuite) TestDelete() {
+	suite.repo.EXPECT().GetOne(entities.Ingredient{ID: 4}).Return(&entities.Ingredient{ID: 4}, nil)
+	suite.repo.EXPECT().Delete(uint32(4)).Return(nil)
+
+	err := suite.useCase.Delete(4)
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *IngredientUseCaseSuite) TestDeleteReturnsNotFoundOnUnknownIngredient() {
+	suite.repo.EXPECT().GetOne(entities.Ingredient{ID: 9}).Return(nil, gorm.ErrRecordNotFound)
+
+	err := suite.useCase.Delete(9)
+	assert.IsType(suite.T(), &custom_errors.NotFoundError{}, err)
+}
+
+func TestIngredientUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(IngredientUseCaseSuite))
+}
@@ -6,33 +6,42 @@ import (
 	"github.com/8soat-grupo35/fastfood-order/internal/entities"
 	"github.com/8soat-grupo35/fastfood-order/internal/interfaces/repository"
 	"github.com/8soat-grupo35/fastfood-order/internal/interfaces/usecase"
+	"strings"
 
 	custom_errors "github.com/8soat-grupo35/fastfood-order/internal/api/errors"
+	"gorm.io/gorm"
 
 	"log"
 )
 
 type itemService struct {
-	itemRepository repository.ItemRepository
+	itemRepository       repository.ItemRepository
+	ingredientRepository repository.IngredientRepository
+	categoryRepository   repository.CategoryRepository
 }
 
-func NewItemUseCase(itemRepository repository.ItemRepository) usecase.ItemUseCase {
+func NewItemUseCase(
+	itemRepository repository.ItemRepository,
+	ingredientRepository repository.IngredientRepository,
+	categoryRepository repository.CategoryRepository,
+) usecase.ItemUseCase {
 	return &itemService{
-		itemRepository: itemRepository,
+		itemRepository:       itemRepository,
+		ingredientRepository: ingredientRepository,
+		categoryRepository:   categoryRepository,
 	}
 }
 
-func (service *itemService) GetAll(category string) ([]entities.Item, error) {
+// GetAll lists the items on sale now at the store, priced by the menus of the store open now. Items off
+// sale are only listed when told to, for the admin. Items out of stock are listed as not available.
+func (service *itemService) GetAll(store entities.Store, category string, includeOffSale bool) ([]entities.Item, error) {
 	filter := entities.Item{}
 
 	if category != "" {
-		filter.Category = category
-		err := filter.ValidateCategory()
+		filter.Category = strings.ToUpper(strings.TrimSpace(category))
 
-		if err != nil {
-			return []entities.Item{}, &custom_errors.BadRequestError{
-				Message: err.Error(),
-			}
+		if err := service.placeInCategory(&filter); err != nil {
+			return []entities.Item{}, err
 		}
 	}
 
@@ -44,11 +53,24 @@ func (service *itemService) GetAll(category string) ([]entities.Item, error) {
 		}
 	}
 
-	return items, nil
+	now := store.Now()
+	onSale := make([]entities.Item, 0, len(items))
+
+	for i := range items {
+		items[i].ApplyMenus(store.ID, now)
+		items[i].ApplyStock(store.ID)
+		service.markAvailable(&items[i])
+
+		if includeOffSale || items[i].OnSale() {
+			onSale = append(onSale, items[i])
+		}
+	}
+
+	return onSale, nil
 }
 
-// Create implements ports.ItemService.
-func (service *itemService) Create(item dto.ItemDto) (*entities.Item, error) {
+// Create implements ports.ItemService. The stock given is the one left at the store.
+func (service *itemService) Create(store entities.Store, item dto.ItemDto) (*entities.Item, error) {
 
 	newItem, err := entities.NewItem(item)
 
@@ -58,17 +80,29 @@ func (service *itemService) Create(item dto.ItemDto) (*entities.Item, error) {
 		}
 	}
 
+	if err = service.placeInCategory(newItem); err != nil {
+		return nil, err
+	}
+
+	if err = service.checkIngredients(*newItem); err != nil {
+		return nil, err
+	}
+
+	newItem.KeepStockAt(store.ID)
 	itemSaved, err := service.itemRepository.Create(*newItem)
 
 	if err != nil {
 		return nil, errors.New("create item on repository has failed")
 	}
 
+	itemSaved.ApplyStock(store.ID)
+	service.markAvailable(itemSaved)
+
 	return itemSaved, err
 }
 
-// Update implements ports.ItemService.
-func (service *itemService) Update(itemId uint32, item dto.ItemDto) (*entities.Item, error) {
+// Update implements ports.ItemService. The stock given is the one left at the store.
+func (service *itemService) Update(store entities.Store, itemId uint32, item dto.ItemDto) (*entities.Item, error) {
 
 	itemToUpdate, err := entities.NewItem(item)
 
@@ -78,6 +112,14 @@ func (service *itemService) Update(itemId uint32, item dto.ItemDto) (*entities.I
 		}
 	}
 
+	if err = service.placeInCategory(itemToUpdate); err != nil {
+		return nil, err
+	}
+
+	if err = service.checkIngredients(*itemToUpdate); err != nil {
+		return nil, err
+	}
+
 	itemAlreadySaved, err := service.itemRepository.GetOne(entities.Item{
 		ID: itemId,
 	})
@@ -95,7 +137,7 @@ func (service *itemService) Update(itemId uint32, item dto.ItemDto) (*entities.I
 		}
 	}
 
-	itemUpdated, err := service.itemRepository.Update(itemId, *itemToUpdate)
+	itemUpdated, err := service.itemRepository.Update(store.ID, itemId, *itemToUpdate)
 
 	if err != nil {
 		log.Println(err.Error())
@@ -104,9 +146,54 @@ func (service *itemService) Update(itemId uint32, item dto.ItemDto) (*entities.I
 		}
 	}
 
+	itemUpdated.Paused = itemAlreadySaved.Paused
+	itemUpdated.MenuItems = itemAlreadySaved.MenuItems
+	service.markAvailable(itemUpdated)
+
 	return itemUpdated, err
 }
 
+// SetAvailability pauses the item, taking it off sale until it is made available again. The item is
+// told available by its stock at the store.
+func (service *itemService) SetAvailability(store entities.Store, itemId uint32, availability dto.ItemAvailabilityDto) (*entities.Item, error) {
+	if availability.Available == nil {
+		return nil, &custom_errors.BadRequestError{
+			Message: "available: cannot be blank.",
+		}
+	}
+
+	item, err := service.itemRepository.GetOne(entities.Item{
+		ID: itemId,
+	})
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, &custom_errors.NotFoundError{
+			Message: "item not found to set availability",
+		}
+	}
+
+	if err != nil {
+		log.Println(err.Error())
+		return nil, &custom_errors.DatabaseError{
+			Message: "error on obtain item to set availability in repository",
+		}
+	}
+
+	item.Paused = !*availability.Available
+
+	if err = service.itemRepository.SetPaused(itemId, item.Paused); err != nil {
+		log.Println(err.Error())
+		return nil, &custom_errors.DatabaseError{
+			Message: "set item availability on repository has failed",
+		}
+	}
+
+	item.ApplyStock(store.ID)
+	service.markAvailable(item)
+
+	return item, nil
+}
+
 // Delete implements ports.ItemService.
 func (service *itemService) Delete(itemId uint32) error {
 	itemAlreadySaved, err := service.itemRepository.GetOne(entities.Item{
@@ -136,3 +223,49 @@ func (service *itemService) Delete(itemId uint32) error {
 
 	return err
 }
+
+// markAvailable tells if the item can be sold now. The menus of a store, when applied first, take the items
+// of their closed exclusive menus off sale.
+func (service *itemService) markAvailable(item *entities.Item) {
+	item.Available = item.OnSale() && item.InStock(1)
+}
+
+// placeInCategory puts the item in the registered category of its name, rejecting names of no category.
+func (service *itemService) placeInCategory(item *entities.Item) error {
+	category, err := findCategory(service.categoryRepository, item.Category)
+
+	if err != nil {
+		return err
+	}
+
+	if err = item.PlaceInCategory(category); err != nil {
+		return &custom_errors.BadRequestError{
+			Message: err.Error(),
+		}
+	}
+
+	return nil
+}
+
+// checkIngredients rejects a recipe that uses an ingredient not registered.
+func (service *itemService) checkIngredients(item entities.Item) error {
+	if len(item.Recipe) == 0 {
+		return nil
+	}
+
+	ingredients, err := service.ingredientRepository.GetByIds(item.IngredientIDs())
+
+	if err != nil {
+		return &custom_errors.DatabaseError{
+			Message: "get recipe ingredients from repository has failed",
+		}
+	}
+
+	if err = item.ValidateIngredients(ingredients); err != nil {
+		return &custom_errors.BadRequestError{
+			Message: err.Error(),
+		}
+	}
+
+	return nil
+}
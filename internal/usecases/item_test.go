@@ -3,26 +3,36 @@ package usecases
 import (
 	"errors"
 	"github.com/8soat-grupo35/fastfood-order/internal/adapters/dto"
+	custom_errors "github.com/8soat-grupo35/fastfood-order/internal/api/errors"
 	"github.com/8soat-grupo35/fastfood-order/internal/entities"
 	mockRepository "github.com/8soat-grupo35/fastfood-order/internal/interfaces/repository/mock"
 	"github.com/8soat-grupo35/fastfood-order/internal/interfaces/usecase"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
 	"testing"
+	"time"
 )
 
 type ItemUseCaseSuite struct {
 	suite.Suite
-	ctrl    *gomock.Controller
-	repo    *mockRepository.MockItemRepository
-	useCase usecase.ItemUseCase
+	ctrl           *gomock.Controller
+	repo           *mockRepository.MockItemRepository
+	ingredientRepo *mockRepository.MockIngredientRepository
+	categoryRepo   *mockRepository.MockCategoryRepository
+	useCase        usecase.ItemUseCase
 }
 
 func (suite *ItemUseCaseSuite) SetupTest() {
 	suite.ctrl = gomock.NewController(suite.T())
 	suite.repo = mockRepository.NewMockItemRepository(suite.ctrl)
-	suite.useCase = NewItemUseCase(suite.repo)
+	suite.ingredientRepo = mockRepository.NewMockIngredientRepository(suite.ctrl)
+	suite.categoryRepo = mockRepository.NewMockCategoryRepository(suite.ctrl)
+	suite.useCase = NewItemUseCase(suite.repo, suite.ingredientRepo, suite.categoryRepo)
+
+	lanche := entities.Category{ID: 1, Name: "LANCHE", Active: true}
+	suite.categoryRepo.EXPECT().GetOne(entities.Category{Name: "LANCHE"}).Return(&lanche, nil).AnyTimes()
 }
 
 func (suite *ItemUseCaseSuite) TearDownTest() {
@@ -36,22 +46,110 @@ func (suite *ItemUseCaseSuite) TestGetAll() {
 
 	suite.repo.EXPECT().GetAll(gomock.Any()).Return(expectedItems, nil)
 
-	items, err := suite.useCase.GetAll("LANCHE")
+	items, err := suite.useCase.GetAll(matriz, "LANCHE", false)
 	assert.NoError(suite.T(), err)
 	assert.Equal(suite.T(), expectedItems, items)
 }
 
+func (suite *ItemUseCaseSuite) TestGetAllFlagsItemsSoldOutAtTheStoreUnavailable() {
+	expectedItems := []entities.Item{
+		{ID: 1, Name: "Burger", Category: "LANCHE"},
+		{ID: 2, Name: "Cheddar Burger", Category: "LANCHE", Stocks: []entities.StoreItemStock{{StoreID: matriz.ID, ItemID: 2, Stock: 0}}},
+		{ID: 3, Name: "Bacon Burger", Category: "LANCHE", Stocks: []entities.StoreItemStock{{StoreID: matriz.ID, ItemID: 3, Stock: 4}, {StoreID: 2, ItemID: 3, Stock: 0}}},
+	}
+
+	suite.repo.EXPECT().GetAll(gomock.Any()).Return(expectedItems, nil)
+
+	items, err := suite.useCase.GetAll(matriz, "LANCHE", false)
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), items[0].Available)
+	assert.False(suite.T(), items[1].Available)
+	assert.True(suite.T(), items[2].Available)
+	assert.Equal(suite.T(), uint32(4), *items[2].Stock)
+}
+
+func (suite *ItemUseCaseSuite) TestGetAllLeavesOutItemsOffSale() {
+	breakfast := menuAround(false, true)
+	expectedItems := []entities.Item{
+		{ID: 1, Name: "Burger", Category: "LANCHE"},
+		{ID: 2, Name: "Cheddar Burger", Category: "LANCHE", Paused: true},
+		{ID: 3, Name: "Egg Burger", Category: "LANCHE", MenuItems: []entities.MenuItem{{MenuID: 1, ItemID: 3, Menu: breakfast}}},
+	}
+
+	suite.repo.EXPECT().GetAll(gomock.Any()).Return(expectedItems, nil)
+
+	items, err := suite.useCase.GetAll(matriz, "LANCHE", false)
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), items, 1)
+	assert.Equal(suite.T(), uint32(1), items[0].ID)
+}
+
+func (suite *ItemUseCaseSuite) TestGetAllListsItemsOffSaleForTheAdmin() {
+	breakfast := menuAround(false, true)
+	expectedItems := []entities.Item{
+		{ID: 2, Name: "Cheddar Burger", Category: "LANCHE", Paused: true},
+		{ID: 3, Name: "Egg Burger", Category: "LANCHE", MenuItems: []entities.MenuItem{{MenuID: 1, ItemID: 3, Menu: breakfast}}},
+	}
+
+	suite.repo.EXPECT().GetAll(gomock.Any()).Return(expectedItems, nil)
+
+	items, err := suite.useCase.GetAll(matriz, "LANCHE", true)
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), items, 2)
+	assert.False(suite.T(), items[0].Available)
+	assert.False(suite.T(), items[1].Available)
+}
+
+func (suite *ItemUseCaseSuite) TestGetAllPricesByTheMenusOpenNow() {
+	happyHourPrice := entities.Money(1990)
+	happyHour := menuAround(true, false)
+	expectedItems := []entities.Item{
+		{ID: 1, Name: "Burger", Category: "LANCHE", Price: 2800, MenuItems: []entities.MenuItem{{MenuID: 1, ItemID: 1, Price: &happyHourPrice, Menu: happyHour}}},
+	}
+
+	suite.repo.EXPECT().GetAll(gomock.Any()).Return(expectedItems, nil)
+
+	items, err := suite.useCase.GetAll(matriz, "LANCHE", false)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), happyHourPrice, items[0].Price)
+	assert.True(suite.T(), items[0].Available)
+}
+
+func (suite *ItemUseCaseSuite) TestGetAllLeavesAsideTheMenusOfOtherStores() {
+	happyHourPrice := entities.Money(1990)
+	happyHour := menuAround(true, false)
+	happyHour.StoreID = 2
+	expectedItems := []entities.Item{
+		{ID: 1, Name: "Burger", Category: "LANCHE", Price: 2800, MenuItems: []entities.MenuItem{{MenuID: 1, ItemID: 1, Price: &happyHourPrice, Menu: happyHour}}},
+	}
+
+	suite.repo.EXPECT().GetAll(gomock.Any()).Return(expectedItems, nil)
+
+	items, err := suite.useCase.GetAll(matriz, "LANCHE", false)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), entities.Money(2800), items[0].Price)
+}
+
+func (suite *ItemUseCaseSuite) TestGetAllFiltersByTheCategoryReferenced() {
+	suite.repo.EXPECT().GetAll(entities.Item{Category: "LANCHE", CategoryID: 1}).Return([]entities.Item{}, nil)
+
+	_, err := suite.useCase.GetAll(matriz, " lanche", false)
+	assert.NoError(suite.T(), err)
+}
+
 func (suite *ItemUseCaseSuite) TestGetAllReturnsErrorOnInvalidCategory() {
-	items, err := suite.useCase.GetAll("INVALID_CATEGORY")
-	assert.Error(suite.T(), err)
+	suite.categoryRepo.EXPECT().GetOne(entities.Category{Name: "INVALID_CATEGORY"}).Return(nil, gorm.ErrRecordNotFound)
+
+	items, err := suite.useCase.GetAll(matriz, "INVALID_CATEGORY", false)
+	assert.IsType(suite.T(), &custom_errors.BadRequestError{}, err)
 	assert.Empty(suite.T(), items)
-	assert.Contains(suite.T(), err.Error(), "Category: must be a valid value between")
+	assert.Equal(suite.T(), "Category: must be a registered category.", err.Error())
 }
 
 func (suite *ItemUseCaseSuite) TestGetAllReturnsErrorOnRepositoryFailure() {
 	suite.repo.EXPECT().GetAll(gomock.Any()).Return(nil, errors.New("query error"))
 
-	items, err := suite.useCase.GetAll("LANCHE")
+	items, err := suite.useCase.GetAll(matriz, "LANCHE", false)
 	assert.Error(suite.T(), err)
 	assert.Empty(suite.T(), items)
 	assert.Equal(suite.T(), "get item from repository has failed", err.Error())
@@ -59,11 +157,11 @@ func (suite *ItemUseCaseSuite) TestGetAllReturnsErrorOnRepositoryFailure() {
 
 func (suite *ItemUseCaseSuite) TestCreate() {
 	itemDto := dto.ItemDto{Name: "Burger", Category: "LANCHE", Price: 10.0, ImageUrl: "http://image.com"}
-	newItem := &entities.Item{ID: 1, Name: "Burger", Category: "LANCHE", Price: 10.0, ImageUrl: "http://image.com"}
+	newItem := &entities.Item{ID: 1, Name: "Burger", Category: "LANCHE", Price: 1000, ImageUrl: "http://image.com"}
 
 	suite.repo.EXPECT().Create(gomock.Any()).Return(newItem, nil)
 
-	createdItem, err := suite.useCase.Create(itemDto)
+	createdItem, err := suite.useCase.Create(matriz, itemDto)
 	assert.NoError(suite.T(), err)
 	assert.Equal(suite.T(), newItem, createdItem)
 }
@@ -71,7 +169,7 @@ func (suite *ItemUseCaseSuite) TestCreate() {
 func (suite *ItemUseCaseSuite) TestCreateReturnsErrorOnInvalidItem() {
 	itemDto := dto.ItemDto{Name: "", Category: "LANCHE", Price: 10.0, ImageUrl: "http://image.com"}
 
-	createdItem, err := suite.useCase.Create(itemDto)
+	createdItem, err := suite.useCase.Create(matriz, itemDto)
 	assert.Error(suite.T(), err)
 	assert.Nil(suite.T(), createdItem)
 	assert.Contains(suite.T(), err.Error(), "Name: cannot be blank")
@@ -82,21 +180,63 @@ func (suite *ItemUseCaseSuite) TestCreateReturnsErrorOnRepositoryFailure() {
 
 	suite.repo.EXPECT().Create(gomock.Any()).Return(nil, errors.New("insert error"))
 
-	createdItem, err := suite.useCase.Create(itemDto)
+	createdItem, err := suite.useCase.Create(matriz, itemDto)
 	assert.Error(suite.T(), err)
 	assert.Nil(suite.T(), createdItem)
 	assert.Equal(suite.T(), "create item on repository has failed", err.Error())
 }
 
+func (suite *ItemUseCaseSuite) TestCreateKeepsTheStockAtTheStore() {
+	stock := uint32(12)
+	itemDto := dto.ItemDto{Name: "Burger", Category: "LANCHE", Price: 10.0, ImageUrl: "http://image.com", Stock: &stock}
+
+	suite.repo.EXPECT().Create(gomock.Any()).DoAndReturn(func(item entities.Item) (*entities.Item, error) {
+		assert.Equal(suite.T(), []entities.StoreItemStock{{StoreID: matriz.ID, Stock: 12}}, item.Stocks)
+		item.ID = 1
+		return &item, nil
+	})
+
+	createdItem, err := suite.useCase.Create(matriz, itemDto)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), uint32(12), *createdItem.Stock)
+	assert.True(suite.T(), createdItem.Available)
+}
+
+func (suite *ItemUseCaseSuite) TestCreateWithRecipeChecksTheIngredients() {
+	itemDto := dto.ItemDto{Name: "Burger", Category: "LANCHE", Price: 10.0, ImageUrl: "http://image.com", Recipe: []dto.RecipeIngredientDto{{IngredientID: 7, Quantity: 150}}}
+	newItem := &entities.Item{ID: 1, Name: "Burger", Category: "LANCHE", Price: 1000}
+
+	suite.ingredientRepo.EXPECT().GetByIds([]uint32{7}).Return([]entities.Ingredient{{ID: 7, Name: "Carne"}}, nil)
+	suite.repo.EXPECT().Create(gomock.Any()).DoAndReturn(func(item entities.Item) (*entities.Item, error) {
+		assert.Equal(suite.T(), []entities.RecipeIngredient{{IngredientID: 7, Quantity: 150000}}, item.Recipe)
+		return newItem, nil
+	})
+
+	createdItem, err := suite.useCase.Create(matriz, itemDto)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), newItem, createdItem)
+}
+
+func (suite *ItemUseCaseSuite) TestCreateReturnsBadRequestOnUnknownIngredient() {
+	itemDto := dto.ItemDto{Name: "Burger", Category: "LANCHE", Price: 10.0, ImageUrl: "http://image.com", Recipe: []dto.RecipeIngredientDto{{IngredientID: 7, Quantity: 150}}}
+
+	suite.ingredientRepo.EXPECT().GetByIds([]uint32{7}).Return([]entities.Ingredient{}, nil)
+
+	createdItem, err := suite.useCase.Create(matriz, itemDto)
+	assert.Nil(suite.T(), createdItem)
+	assert.IsType(suite.T(), &custom_errors.BadRequestError{}, err)
+	assert.Equal(suite.T(), "Recipe: (0: (IngredientID: ingredient 7 not found.).).", err.Error())
+}
+
 func (suite *ItemUseCaseSuite) TestUpdate() {
 	itemDto := dto.ItemDto{Name: "Burger", Category: "LANCHE", Price: 10.0, ImageUrl: "http://image.com"}
-	itemToUpdate := &entities.Item{ID: 1, Name: "Burger", Category: "SOBREMESA", Price: 5.0, ImageUrl: "http://image.com"}
-	itemAfterUpdate := &entities.Item{ID: 1, Name: "Burger", Category: "LANCHE", Price: 10.0, ImageUrl: "http://image.com"}
+	itemToUpdate := &entities.Item{ID: 1, Name: "Burger", Category: "SOBREMESA", Price: 500, ImageUrl: "http://image.com"}
+	itemAfterUpdate := &entities.Item{ID: 1, Name: "Burger", Category: "LANCHE", Price: 1000, ImageUrl: "http://image.com"}
 
 	suite.repo.EXPECT().GetOne(gomock.Any()).Return(itemToUpdate, nil)
-	suite.repo.EXPECT().Update(uint32(1), gomock.Any()).Return(itemAfterUpdate, nil)
+	suite.repo.EXPECT().Update(matriz.ID, uint32(1), gomock.Any()).Return(itemAfterUpdate, nil)
 
-	updatedItem, err := suite.useCase.Update(1, itemDto)
+	updatedItem, err := suite.useCase.Update(matriz, 1, itemDto)
 	assert.NoError(suite.T(), err)
 	assert.Equal(suite.T(), itemAfterUpdate, updatedItem)
 }
@@ -104,7 +244,7 @@ func (suite *ItemUseCaseSuite) TestUpdate() {
 func (suite *ItemUseCaseSuite) TestUpdateReturnsErrorOnInvalidItem() {
 	itemDto := dto.ItemDto{Name: "", Category: "LANCHE", Price: 10.0, ImageUrl: "http://image.com"}
 
-	updatedItem, err := suite.useCase.Update(1, itemDto)
+	updatedItem, err := suite.useCase.Update(matriz, 1, itemDto)
 	assert.Error(suite.T(), err)
 	assert.Nil(suite.T(), updatedItem)
 	assert.Contains(suite.T(), err.Error(), "Name: cannot be blank")
@@ -115,7 +255,7 @@ func (suite *ItemUseCaseSuite) TestUpdateReturnsErrorOnItemNotFound() {
 
 	suite.repo.EXPECT().GetOne(gomock.Any()).Return(nil, nil)
 
-	updatedItem, err := suite.useCase.Update(1, itemDto)
+	updatedItem, err := suite.useCase.Update(matriz, 1, itemDto)
 	assert.Error(suite.T(), err)
 	assert.Nil(suite.T(), updatedItem)
 	assert.Equal(suite.T(), "item not found to update", err.Error())
@@ -123,17 +263,45 @@ func (suite *ItemUseCaseSuite) TestUpdateReturnsErrorOnItemNotFound() {
 
 func (suite *ItemUseCaseSuite) TestUpdateReturnsErrorOnRepositoryFailure() {
 	itemDto := dto.ItemDto{Name: "Burger", Category: "LANCHE", Price: 10.0, ImageUrl: "http://image.com"}
-	itemToUpdate := &entities.Item{ID: 1, Name: "Burger", Category: "SOBREMESA", Price: 5.0, ImageUrl: "http://image.com"}
+	itemToUpdate := &entities.Item{ID: 1, Name: "Burger", Category: "SOBREMESA", Price: 500, ImageUrl: "http://image.com"}
 
 	suite.repo.EXPECT().GetOne(gomock.Any()).Return(itemToUpdate, nil)
-	suite.repo.EXPECT().Update(uint32(1), gomock.Any()).Return(nil, errors.New("update error"))
+	suite.repo.EXPECT().Update(matriz.ID, uint32(1), gomock.Any()).Return(nil, errors.New("update error"))
 
-	updatedItem, err := suite.useCase.Update(1, itemDto)
+	updatedItem, err := suite.useCase.Update(matriz, 1, itemDto)
 	assert.Error(suite.T(), err)
 	assert.Nil(suite.T(), updatedItem)
 	assert.Equal(suite.T(), "updated item on repository has failed", err.Error())
 }
 
+func (suite *ItemUseCaseSuite) TestSetAvailabilityPausesTheItem() {
+	available := false
+
+	suite.repo.EXPECT().GetOne(entities.Item{ID: 1}).Return(&entities.Item{ID: 1, Name: "Burger", Category: "LANCHE"}, nil)
+	suite.repo.EXPECT().SetPaused(uint32(1), true).Return(nil)
+
+	item, err := suite.useCase.SetAvailability(matriz, 1, dto.ItemAvailabilityDto{Available: &available})
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), item.Paused)
+	assert.False(suite.T(), item.Available)
+}
+
+func (suite *ItemUseCaseSuite) TestSetAvailabilityReturnsBadRequestWithoutAvailable() {
+	item, err := suite.useCase.SetAvailability(matriz, 1, dto.ItemAvailabilityDto{})
+	assert.Nil(suite.T(), item)
+	assert.IsType(suite.T(), &custom_errors.BadRequestError{}, err)
+}
+
+func (suite *ItemUseCaseSuite) TestSetAvailabilityReturnsNotFound() {
+	available := true
+
+	suite.repo.EXPECT().GetOne(entities.Item{ID: 9}).Return(nil, gorm.ErrRecordNotFound)
+
+	item, err := suite.useCase.SetAvailability(matriz, 9, dto.ItemAvailabilityDto{Available: &available})
+	assert.Nil(suite.T(), item)
+	assert.IsType(suite.T(), &custom_errors.NotFoundError{}, err)
+}
+
 func (suite *ItemUseCaseSuite) TestDelete() {
 	itemToDelete := &entities.Item{ID: 1, Name: "Burger", Category: "Food"}
 
@@ -166,3 +334,21 @@ func (suite *ItemUseCaseSuite) TestDeleteReturnsErrorOnRepositoryFailure() {
 func TestItemUseCaseSuite(t *testing.T) {
 	suite.Run(t, new(ItemUseCaseSuite))
 }
+
+// menuAround builds a menu of the matriz open from an hour before now to an hour after, in UTC, or one
+// that only opens in two hours.
+func menuAround(open bool, exclusive bool) *entities.Menu {
+	start := time.Now().UTC().Add(-time.Hour)
+	if !open {
+		start = start.Add(3 * time.Hour)
+	}
+
+	return &entities.Menu{
+		ID:        1,
+		StoreID:   1,
+		Name:      "Menu",
+		StartTime: start.Format(entities.TIME_OF_DAY_LAYOUT),
+		EndTime:   start.Add(2 * time.Hour).Format(entities.TIME_OF_DAY_LAYOUT),
+		Exclusive: exclusive,
+	}
+}
@@ -0,0 +1,152 @@
+package usecases
+
+import (
+	"errors"
+	"github.com/8soat-grupo35/fastfood-order/internal/adapters/dto"
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+	"github.com/8soat-grupo35/fastfood-order/internal/interfaces/repository"
+	"github.com/8soat-grupo35/fastfood-order/internal/interfaces/usecase"
+	"log"
+
+	custom_errors "github.com/8soat-grupo35/fastfood-order/internal/api/errors"
+	"gorm.io/gorm"
+)
+
+type menuService struct {
+	menuRepository repository.MenuRepository
+	itemRepository repository.ItemRepository
+}
+
+func NewMenuUseCase(menuRepository repository.MenuRepository, itemRepository repository.ItemRepository) usecase.MenuUseCase {
+	return &menuService{
+		menuRepository: menuRepository,
+		itemRepository: itemRepository,
+	}
+}
+
+func (service *menuService) GetAll(store entities.Store) ([]entities.Menu, error) {
+	menus, err := service.menuRepository.GetAll(store.ID)
+
+	if err != nil {
+		return []entities.Menu{}, &custom_errors.DatabaseError{
+			Message: "get menu from repository has failed",
+		}
+	}
+
+	return menus, nil
+}
+
+func (service *menuService) Create(store entities.Store, menu dto.MenuDto) (*entities.Menu, error) {
+	newMenu, err := service.newMenu(menu)
+
+	if err != nil {
+		return nil, err
+	}
+
+	newMenu.StoreID = store.ID
+
+	menuSaved, err := service.menuRepository.Create(*newMenu)
+
+	if err != nil {
+		return nil, errors.New("create menu on repository has failed")
+	}
+
+	return menuSaved, nil
+}
+
+func (service *menuService) Update(store entities.Store, menuId uint32, menu dto.MenuDto) (*entities.Menu, error) {
+	menuToUpdate, err := service.newMenu(menu)
+
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = service.menuRepository.GetOne(entities.Menu{ID: menuId, StoreID: store.ID})
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, &custom_errors.NotFoundError{
+			Message: "menu not found to update",
+		}
+	}
+
+	if err != nil {
+		log.Println(err.Error())
+		return nil, &custom_errors.DatabaseError{
+			Message: "error on obtain menu to update in repository",
+		}
+	}
+
+	menuToUpdate.StoreID = store.ID
+	menuUpdated, err := service.menuRepository.Update(store.ID, menuId, *menuToUpdate)
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, &custom_errors.NotFoundError{
+			Message: "menu not found to update",
+		}
+	}
+
+	if err != nil {
+		log.Println(err.Error())
+		return nil, &custom_errors.DatabaseError{
+			Message: "updated menu on repository has failed",
+		}
+	}
+
+	return menuUpdated, nil
+}
+
+func (service *menuService) Delete(store entities.Store, menuId uint32) error {
+	_, err := service.menuRepository.GetOne(entities.Menu{ID: menuId, StoreID: store.ID})
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &custom_errors.NotFoundError{
+			Message: "menu not found to delete",
+		}
+	}
+
+	if err != nil {
+		log.Println(err.Error())
+		return &custom_errors.DatabaseError{
+			Message: "error on obtain menu to delete in repository",
+		}
+	}
+
+	err = service.menuRepository.Delete(store.ID, menuId)
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &custom_errors.NotFoundError{
+			Message: "menu not found to delete",
+		}
+	}
+
+	if err != nil {
+		return &custom_errors.DatabaseError{
+			Message: "error on delete in repository",
+		}
+	}
+
+	return nil
+}
+
+// newMenu validates the menu and checks its items are in the catalog.
+func (service *menuService) newMenu(menu dto.MenuDto) (*entities.Menu, error) {
+	newMenu, err := entities.NewMenu(menu)
+
+	if err != nil {
+		return nil, custom_errors.NewValidationError(err)
+	}
+
+	items, err := service.itemRepository.GetByIds(newMenu.ItemIDs())
+
+	if err != nil {
+		return nil, &custom_errors.DatabaseError{
+			Message: "get menu items from repository has failed",
+		}
+	}
+
+	if err = newMenu.ValidateItems(items); err != nil {
+		return nil, custom_errors.NewValidationError(err)
+	}
+
+	return newMenu, nil
+}
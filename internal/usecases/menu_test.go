@@ -0,0 +1,161 @@
+package usecases
+
+import (
+	"errors"
+	"github.com/8soat-grupo35/fastfood-order/internal/adapters/dto"
+	custom_errors "github.com/8soat-grupo35/fastfood-order/internal/api/errors"
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+	mockRepository "github.com/8soat-grupo35/fastfood-order/internal/interfaces/repository/mock"
+	"github.com/8soat-grupo35/fastfood-order/internal/interfaces/usecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+	"testing"
+)
+
+type MenuUseCaseSuite struct {
+	suite.Suite
+	ctrl     *gomock.Controller
+	repo     *mockRepository.MockMenuRepository
+	itemRepo *mockRepository.MockItemRepository
+	useCase  usecase.MenuUseCase
+}
+
+func (suite *MenuUseCaseSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.repo = mockRepository.NewMockMenuRepository(suite.ctrl)
+	suite.itemRepo = mockRepository.NewMockItemRepository(suite.ctrl)
+	suite.useCase = NewMenuUseCase(suite.repo, suite.itemRepo)
+}
+
+func (suite *MenuUseCaseSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func breakfastMenuDto() dto.MenuDto {
+	return dto.MenuDto{
+		Name:      "Café da manhã",
+		StartTime: "06:00",
+		EndTime:   "11:00",
+		Exclusive: true,
+		Items:     []dto.MenuItemDto{{ItemID: 4}, {ItemID: 5}},
+	}
+}
+
+func breakfastItems() []entities.Item {
+	return []entities.Item{
+		{ID: 4, Name: "Pão de queijo", Category: "LANCHE", Price: 600},
+		{ID: 5, Name: "Café", Category: "BEBIDA", Price: 400},
+	}
+}
+
+func (suite *MenuUseCaseSuite) TestGetAll() {
+	expectedMenus := []entities.Menu{{ID: 1, Name: "Café da manhã", StartTime: "06:00", EndTime: "11:00"}}
+
+	suite.repo.EXPECT().GetAll(uint32(1)).Return(expectedMenus, nil)
+
+	menus, err := suite.useCase.GetAll(matriz)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedMenus, menus)
+}
+
+func (suite *MenuUseCaseSuite) TestGetAllReturnsErrorOnRepositoryFailure() {
+	suite.repo.EXPECT().GetAll(uint32(1)).Return(nil, errors.New("query error"))
+
+	menus, err := suite.useCase.GetAll(matriz)
+	assert.Empty(suite.T(), menus)
+	assert.IsType(suite.T(), &custom_errors.DatabaseError{}, err)
+}
+
+func (suite *MenuUseCaseSuite) TestCreate() {
+	suite.itemRepo.EXPECT().GetByIds([]uint32{4, 5}).Return(breakfastItems(), nil)
+	suite.repo.EXPECT().Create(gomock.Any()).DoAndReturn(func(menu entities.Menu) (*entities.Menu, error) {
+		assert.True(suite.T(), menu.Exclusive)
+		assert.Equal(suite.T(), "11:00", menu.EndTime)
+		assert.Equal(suite.T(), uint32(1), menu.StoreID)
+		menu.ID = 1
+		return &menu, nil
+	})
+
+	menu, err := suite.useCase.Create(matriz, breakfastMenuDto())
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), uint32(1), menu.ID)
+}
+
+func (suite *MenuUseCaseSuite) TestCreateReturnsBadRequestOnInvalidMenu() {
+	menuDto := breakfastMenuDto()
+	menuDto.EndTime = "11h"
+
+	menu, err := suite.useCase.Create(matriz, menuDto)
+	assert.Nil(suite.T(), menu)
+	assert.IsType(suite.T(), &custom_errors.BadRequestError{}, err)
+}
+
+func (suite *MenuUseCaseSuite) TestCreateReturnsBadRequestOnUnknownItem() {
+	suite.itemRepo.EXPECT().GetByIds([]uint32{4, 5}).Return(breakfastItems()[:1], nil)
+
+	menu, err := suite.useCase.Create(matriz, breakfastMenuDto())
+	assert.Nil(suite.T(), menu)
+	assert.IsType(suite.T(), &custom_errors.BadRequestError{}, err)
+	assert.Equal(suite.T(), []custom_errors.ErrorDetail{
+		{Field: "items.1.item_id", Message: "item 5 not found"},
+	}, err.(*custom_errors.BadRequestError).Details)
+}
+
+func (suite *MenuUseCaseSuite) TestCreateReturnsErrorOnRepositoryFailure() {
+	suite.itemRepo.EXPECT().GetByIds([]uint32{4, 5}).Return(breakfastItems(), nil)
+	suite.repo.EXPECT().Create(gomock.Any()).Return(nil, errors.New("insert error"))
+
+	menu, err := suite.useCase.Create(matriz, breakfastMenuDto())
+	assert.Nil(suite.T(), menu)
+	assert.Equal(suite.T(), "create menu on repository has failed", err.Error())
+}
+
+func (suite *MenuUseCaseSuite) TestUpdate() {
+	expectedMenu := &entities.Menu{ID: 1, Name: "Café da manhã"}
+
+	suite.itemRepo.EXPECT().GetByIds([]uint32{4, 5}).Return(breakfastItems(), nil)
+	suite.repo.EXPECT().GetOne(entities.Menu{ID: 1, StoreID: 1}).Return(expectedMenu, nil)
+	suite.repo.EXPECT().Update(uint32(1), uint32(1), gomock.Any()).Return(expectedMenu, nil)
+
+	menu, err := suite.useCase.Update(matriz, 1, breakfastMenuDto())
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedMenu, menu)
+}
+
+func (suite *MenuUseCaseSuite) TestUpdateReturnsNotFoundOnUnknownMenu() {
+	suite.itemRepo.EXPECT().GetByIds([]uint32{4, 5}).Return(breakfastItems(), nil)
+	suite.repo.EXPECT().GetOne(entities.Menu{ID: 9, StoreID: 1}).Return(nil, gorm.ErrRecordNotFound)
+
+	menu, err := suite.useCase.Update(matriz, 9, breakfastMenuDto())
+	assert.Nil(suite.T(), menu)
+	assert.IsType(suite.T(), &custom_errors.NotFoundError{}, err)
+}
+
+func (suite *MenuUseCaseSuite) TestDelete() {
+	suite.repo.EXPECT().GetOne(entities.Menu{ID: 1, StoreID: 1}).Return(&entities.Menu{ID: 1}, nil)
+	suite.repo.EXPECT().Delete(uint32(1), uint32(1)).Return(nil)
+
+	err := suite.useCase.Delete(matriz, 1)
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *MenuUseCaseSuite) TestDeleteReturnsNotFoundOnUnknownMenu() {
+	suite.repo.EXPECT().GetOne(entities.Menu{ID: 9, StoreID: 1}).Return(nil, gorm.ErrRecordNotFound)
+
+	err := suite.useCase.Delete(matriz, 9)
+	assert.IsType(suite.T(), &custom_errors.NotFoundError{}, err)
+}
+
+func (suite *MenuUseCaseSuite) TestDeleteReturnsNotFoundWhenMenuLeftTheStore() {
+	suite.repo.EXPECT().GetOne(entities.Menu{ID: 1, StoreID: 1}).Return(&entities.Menu{ID: 1}, nil)
+	suite.repo.EXPECT().Delete(uint32(1), uint32(1)).Return(gorm.ErrRecordNotFound)
+
+	err := suite.useCase.Delete(matriz, 1)
+	assert.IsType(suite.T(), &custom_errors.NotFoundError{}, err)
+}
+
+func TestMenuUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(MenuUseCaseSuite))
+}
@@ -2,26 +2,55 @@ package usecases
 
 import (
 	"errors"
+	"fmt"
 	"github.com/8soat-grupo35/fastfood-order/internal/adapters/dto"
 	"github.com/8soat-grupo35/fastfood-order/internal/entities"
 	"github.com/8soat-grupo35/fastfood-order/internal/interfaces/repository"
 	"github.com/8soat-grupo35/fastfood-order/internal/interfaces/usecase"
+	"log"
+	"strings"
+	"time"
 
 	custom_errors "github.com/8soat-grupo35/fastfood-order/internal/api/errors"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"gorm.io/gorm"
 )
 
+// preparationTimeSampleSize is how many of the latest ready orders feed the tracking estimates.
+const preparationTimeSampleSize = 20
+
 type orderService struct {
-	orderRepository repository.OrderRepository
+	orderRepository      repository.OrderRepository
+	itemRepository       repository.ItemRepository
+	comboRepository      repository.ComboRepository
+	promotionRepository  repository.PromotionRepository
+	customerRepository   repository.CustomerRepository
+	orderEventRepository repository.OrderEventRepository
+	storeRepository      repository.StoreRepository
 }
 
-func NewOrderUseCase(orderRepository repository.OrderRepository) usecase.OrderUseCase {
+func NewOrderUseCase(
+	orderRepository repository.OrderRepository,
+	itemRepository repository.ItemRepository,
+	comboRepository repository.ComboRepository,
+	promotionRepository repository.PromotionRepository,
+	customerRepository repository.CustomerRepository,
+	orderEventRepository repository.OrderEventRepository,
+	storeRepository repository.StoreRepository,
+) usecase.OrderUseCase {
 	return &orderService{
-		orderRepository: orderRepository,
+		orderRepository:      orderRepository,
+		itemRepository:       itemRepository,
+		comboRepository:      comboRepository,
+		promotionRepository:  promotionRepository,
+		customerRepository:   customerRepository,
+		orderEventRepository: orderEventRepository,
+		storeRepository:      storeRepository,
 	}
 }
 
-func (service *orderService) GetAll() ([]entities.Order, error) {
-	orders, err := service.orderRepository.GetAll()
+func (service *orderService) GetAll(store entities.Store) ([]entities.Order, error) {
+	orders, err := service.orderRepository.GetAll(entities.OrderFilter{StoreID: store.ID})
 
 	if err != nil {
 		return []entities.Order{}, &custom_errors.DatabaseError{
@@ -32,17 +61,186 @@ func (service *orderService) GetAll() ([]entities.Order, error) {
 	return orders, nil
 }
 
-// Create implements ports.OrderService.
-func (service *orderService) Create(order dto.OrderDto) (*entities.Order, error) {
+// GetByCustomer lists the orders the customer placed at the store. Customers are shared by every store.
+func (service *orderService) GetByCustomer(store entities.Store, customerId uint32) ([]entities.Order, error) {
+	_, err := service.customerRepository.GetOne(entities.Customer{ID: customerId})
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, &custom_errors.NotFoundError{
+			Message: "customer not found",
+		}
+	}
+
+	if err != nil {
+		return nil, &custom_errors.DatabaseError{
+			Message: "get customer from repository has failed",
+		}
+	}
+
+	orders, err := service.orderRepository.GetByCustomer(store.ID, customerId)
+
+	if err != nil {
+		return nil, &custom_errors.DatabaseError{
+			Message: "get customer orders from repository has failed",
+		}
+	}
+
+	return orders, nil
+}
+
+func (service *orderService) Search(store entities.Store, filterDto dto.OrderFilterDto) (*entities.OrderPage, error) {
+	filter, err := entities.NewOrderFilter(filterDto, store.Location())
+
+	if err != nil {
+		return nil, custom_errors.NewValidationError(err)
+	}
+
+	filter.StoreID = store.ID
+
+	pageSize := filter.Limit
+	if pageSize > 0 {
+		filter.Limit++
+	}
+
+	orders, err := service.orderRepository.GetAll(*filter)
+
+	if err != nil {
+		return nil, &custom_errors.DatabaseError{
+			Message: "get order from repository has failed",
+		}
+	}
+
+	page := entities.NewOrderPage(orders, pageSize)
+
+	return &page, nil
+}
+
+func (service *orderService) Track(store entities.Store, trackingCode string) (*entities.OrderTracking, error) {
+	order, err := service.orderRepository.GetByTrackingCode(store.ID, strings.ToUpper(trackingCode))
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, &custom_errors.NotFoundError{
+			Message: "order not found",
+		}
+	}
+
+	if err != nil {
+		return nil, &custom_errors.DatabaseError{
+			Message: "get order from repository has failed",
+		}
+	}
+
+	queue, err := service.orderRepository.GetAll(entities.OrderFilter{StoreID: store.ID})
+
+	if err != nil {
+		return nil, &custom_errors.DatabaseError{
+			Message: "get order queue from repository has failed",
+		}
+	}
+
+	averagePreparationTime, err := service.orderRepository.AveragePreparationTime(store.ID, preparationTimeSampleSize)
+
+	if err != nil {
+		return nil, &custom_errors.DatabaseError{
+			Message: "get order preparation time from repository has failed",
+		}
+	}
+
+	tracking := entities.NewOrderTracking(*order, queue, averagePreparationTime, time.Now())
+
+	return &tracking, nil
+}
+
+// Create implements ports.OrderService. Orders are refused while the store is closed.
+func (service *orderService) Create(store entities.Store, order dto.OrderDto) (*entities.Order, error) {
+	if err := service.checkStoreOpen(store); err != nil {
+		return nil, err
+	}
+
 	newOrder, err := entities.NewOrder(order)
 
 	if err != nil {
-		return nil, &custom_errors.BadRequestError{
+		return nil, custom_errors.NewValidationError(err)
+	}
+
+	newOrder.StoreID = store.ID
+
+	referenceErrors := validation.Errors{}
+
+	if !newOrder.IsGuest() {
+		customer, err := service.customerRepository.GetOne(entities.Customer{ID: *newOrder.CustomerID})
+
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			referenceErrors["customer_id"] = fmt.Errorf("customer %d not found", *newOrder.CustomerID)
+		} else if err != nil {
+			return nil, &custom_errors.DatabaseError{
+				Message: "get order customer from repository has failed",
+			}
+		} else {
+			newOrder.IdentifyCustomer(*customer)
+		}
+	}
+
+	items, err := service.itemRepository.GetByIds(newOrder.ItemIDs())
+
+	if err != nil {
+		return nil, &custom_errors.DatabaseError{
+			Message: "get order items from repository has failed",
+		}
+	}
+
+	service.applyMenus(store, items)
+
+	referenceErrors["items"] = newOrder.PriceItems(items)
+
+	if len(newOrder.Combos) > 0 {
+		combos, err := service.comboRepository.GetByIds(newOrder.ComboIDs())
+
+		if err != nil {
+			return nil, &custom_errors.DatabaseError{
+				Message: "get order combos from repository has failed",
+			}
+		}
+
+		referenceErrors["combos"] = newOrder.ExpandCombos(combos, items)
+	}
+
+	if err = referenceErrors.Filter(); err != nil {
+		return nil, custom_errors.NewValidationError(err)
+	}
+
+	newOrder.UseIngredients(items)
+
+	if err = newOrder.CheckStock(items); err != nil {
+		return nil, &custom_errors.ConflictError{
 			Message: err.Error(),
 		}
 	}
 
-	orderSaved, err := service.orderRepository.Create(*newOrder)
+	if err = service.applyPromotions(newOrder, items); err != nil {
+		return nil, err
+	}
+
+	orderSaved, err := service.orderRepository.Create(*newOrder, store.PickupCodeFormat())
+
+	if errors.Is(err, entities.ErrPromotionRunOut) {
+		return nil, &custom_errors.ConflictError{
+			Message: "a promotion of the order has just run out, please check out again",
+		}
+	}
+
+	if errors.Is(err, entities.ErrPromotionAlreadyUsed) {
+		return nil, &custom_errors.ConflictError{
+			Message: "a promotion of the order has just been used by another order of the customer, please check out again",
+		}
+	}
+
+	var outOfStock *entities.OutOfStockError
+	if errors.As(err, &outOfStock) {
+		return nil, &custom_errors.ConflictError{
+			Message: outOfStock.Error(),
+		}
+	}
 
 	if err != nil {
 		return nil, errors.New("create order on repository has failed")
@@ -51,24 +249,360 @@ func (service *orderService) Create(order dto.OrderDto) (*entities.Order, error)
 	return orderSaved, err
 }
 
-func (service *orderService) UpdateStatus(id uint32, status string) (*entities.Order, error) {
-	order, err := service.orderRepository.GetById(id)
+// checkStoreOpen refuses orders while the store is closed, telling when it opens again.
+func (service *orderService) checkStoreOpen(store entities.Store) error {
+	status, err := storeStatus(service.storeRepository, store, time.Now())
+
+	if err != nil {
+		return err
+	}
+
+	if status.Open {
+		return nil
+	}
+
+	message := "store is closed"
+	if status.NextOpening != nil {
+		message = fmt.Sprintf("store is closed, it opens again at %s", status.NextOpening.Format(time.RFC3339))
+	}
+
+	return &custom_errors.StoreClosedError{
+		Message:     message,
+		NextOpening: status.NextOpening,
+	}
+}
+
+// applyMenus prices the items by the menus of the store open now, takes the ones that cannot be sold
+// now off sale and sets their stock to the one left at the store.
+func (service *orderService) applyMenus(store entities.Store, items []entities.Item) {
+	now := store.Now()
+
+	for i := range items {
+		items[i].ApplyMenus(store.ID, now)
+		items[i].ApplyStock(store.ID)
+	}
+}
+
+// applyPromotions applies the automatic promotions of the store of the order valid now and the coupon
+// given at checkout. The earlier orders of the customer are only looked up when a promotion is limited
+// per customer.
+func (service *orderService) applyPromotions(order *entities.Order, items []entities.Item) error {
+	now := time.Now()
+
+	promotions, err := service.promotionRepository.GetAutomatic(order.StoreID, now)
+
+	if err != nil {
+		return &custom_errors.DatabaseError{
+			Message: "get promotions from repository has failed",
+		}
+	}
+
+	if order.Coupon != "" {
+		coupon, err := service.promotionRepository.GetByCode(order.StoreID, order.Coupon)
+
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return custom_errors.NewValidationError(validation.Errors{
+				"coupon": fmt.Errorf("coupon %s not found", order.Coupon),
+			})
+		}
+
+		if err != nil {
+			return &custom_errors.DatabaseError{
+				Message: "get coupon from repository has failed",
+			}
+		}
+
+		promotions = append(promotions, *coupon)
+	}
+
+	history := entities.PromotionHistory{}
+
+	for _, promotion := range promotions {
+		if !promotion.LimitedPerCustomer() || order.IsGuest() {
+			continue
+		}
+
+		customerHistory, err := service.promotionRepository.GetCustomerHistory(*order.CustomerID)
+
+		if err != nil {
+			return &custom_errors.DatabaseError{
+				Message: "get customer promotion history from repository has failed",
+			}
+		}
+
+		history = *customerHistory
+		break
+	}
+
+	if err = order.ApplyPromotions(promotions, items, history, now); err != nil {
+		return custom_errors.NewValidationError(err)
+	}
+
+	return nil
+}
+
+// Reorder checks out a new order with the items of an earlier one. Items that left the menu or are off
+// sale now are dropped and reported, the remaining ones go through the checkout validation again.
+func (service *orderService) Reorder(store entities.Store, id uint32) (*entities.Reorder, error) {
+	order, err := service.orderRepository.GetInStore(store.ID, id)
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, &custom_errors.NotFoundError{
+			Message: "order not found",
+		}
+	}
+
+	if err != nil {
+		return nil, &custom_errors.DatabaseError{
+			Message: "get order from repository has failed",
+		}
+	}
+
+	items, err := service.itemRepository.GetByIds(order.ItemIDs())
+
+	if err != nil {
+		return nil, &custom_errors.DatabaseError{
+			Message: "get order items from repository has failed",
+		}
+	}
+
+	service.applyMenus(store, items)
+
+	var combos []entities.Combo
+
+	if comboIds := order.ComboIDs(); len(comboIds) > 0 {
+		combos, err = service.comboRepository.GetByIds(comboIds)
+
+		if err != nil {
+			return nil, &custom_errors.DatabaseError{
+				Message: "get order combos from repository has failed",
+			}
+		}
+	}
+
+	orderDto, droppedItems := entities.NewReorderDto(*order, items, combos)
+
+	if len(orderDto.Items) == 0 && len(orderDto.Combos) == 0 {
+		return nil, &custom_errors.ConflictError{
+			Message: "none of the items of the order is available anymore",
+		}
+	}
+
+	newOrder, err := service.Create(store, orderDto)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &entities.Reorder{
+		Order:        *newOrder,
+		DroppedItems: droppedItems,
+	}, nil
+}
+
+func (service *orderService) UpdateStatus(store entities.Store, id uint32, statusDto dto.OrderStatusDto) (*entities.Order, error) {
+	status := statusDto.Status
+
+	order, err := service.orderRepository.GetInStore(store.ID, id)
 	if err != nil {
 		return nil, &custom_errors.BadRequestError{
 			Message: err.Error(),
 		}
 	}
 
-	order.Status = status
-	validateError := order.Validate()
+	validateError := entities.Order{Status: status}.ValidateStatus()
+	if validateError != nil {
+		return nil, &custom_errors.BadRequestError{
+			Message: validateError.Error(),
+		}
+	}
+
+	if status == entities.CANCELED_STATUS {
+		return nil, &custom_errors.BadRequestError{
+			Message: "orders must be canceled through the cancel endpoint",
+		}
+	}
+
+	if status == entities.IN_PREPARATION_STATUS && !order.IsPaid() {
+		return nil, &custom_errors.ConflictError{
+			Message: "order cannot be prepared before its payment is approved",
+		}
+	}
+
+	if !order.CanTransitionTo(status) {
+		return nil, &custom_errors.ConflictError{
+			Message: fmt.Sprintf("order status cannot change from %s to %s", order.Status, status),
+		}
+	}
+
+	order.ChangeStatus(status, statusDto.ChangedBy)
+	validateError = order.Validate()
 	if validateError != nil {
 		return nil, errors.New(validateError.Error())
 	}
 
 	orderSaved, err := service.orderRepository.Update(id, *order)
+	if errors.Is(err, entities.ErrOrderChanged) {
+		return nil, &custom_errors.ConflictError{
+			Message: "order was changed by someone else, please load it again",
+		}
+	}
+
 	if err != nil {
 		return nil, errors.New("update order on  repository has failed")
 	}
 
+	service.publish(entities.ORDER_STATUS_CHANGED_EVENT, *orderSaved)
+
 	return orderSaved, err
 }
+
+func (service *orderService) Timeline(store entities.Store, id uint32) ([]entities.OrderStatusHistory, error) {
+	_, err := service.orderRepository.GetInStore(store.ID, id)
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, &custom_errors.NotFoundError{
+			Message: "order not found",
+		}
+	}
+
+	if err != nil {
+		return nil, &custom_errors.DatabaseError{
+			Message: "get order from repository has failed",
+		}
+	}
+
+	history, err := service.orderRepository.GetStatusHistory(id)
+
+	if err != nil {
+		return nil, &custom_errors.DatabaseError{
+			Message: "get order status history from repository has failed",
+		}
+	}
+
+	return history, nil
+}
+
+func (service *orderService) Cancel(store entities.Store, id uint32, cancelDto dto.OrderCancelDto) (*entities.Order, error) {
+	order, err := service.orderRepository.GetInStore(store.ID, id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, &custom_errors.NotFoundError{
+			Message: "order not found",
+		}
+	}
+
+	if err != nil {
+		return nil, &custom_errors.DatabaseError{
+			Message: "get order from repository has failed",
+		}
+	}
+
+	if !order.CanTransitionTo(entities.CANCELED_STATUS) {
+		return nil, &custom_errors.ConflictError{
+			Message: fmt.Sprintf("order with status %s cannot be canceled", order.Status),
+		}
+	}
+
+	order.Cancel(cancelDto.CanceledBy, cancelDto.Reason)
+	validateError := order.ValidateCancellation()
+	if validateError != nil {
+		return nil, &custom_errors.BadRequestError{
+			Message: validateError.Error(),
+		}
+	}
+
+	orderSaved, err := service.orderRepository.Cancel(id, *order)
+	if errors.Is(err, entities.ErrOrderChanged) {
+		return nil, &custom_errors.ConflictError{
+			Message: "order was changed by someone else, please load it again",
+		}
+	}
+
+	if err != nil {
+		return nil, errors.New("cancel order on repository has failed")
+	}
+
+	service.publish(entities.ORDER_STATUS_CHANGED_EVENT, *orderSaved)
+
+	return orderSaved, err
+}
+
+// UpdatePaymentStatus takes the callbacks of the payment service, which know the orders of every store.
+// Orders whose payment was rejected or expired are canceled, giving their stock and promotions back.
+func (service *orderService) UpdatePaymentStatus(id uint32, paymentStatus string) (*entities.Order, error) {
+	paymentStatus = strings.ToUpper(paymentStatus)
+
+	validateError := entities.Order{PaymentStatus: paymentStatus}.ValidatePaymentStatus()
+	if validateError != nil {
+		return nil, &custom_errors.BadRequestError{
+			Message: validateError.Error(),
+		}
+	}
+
+	order, err := service.orderRepository.GetById(id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, &custom_errors.NotFoundError{
+			Message: "order not found",
+		}
+	}
+
+	if err != nil {
+		return nil, &custom_errors.DatabaseError{
+			Message: "get order from repository has failed",
+		}
+	}
+
+	if !order.CanChangePaymentStatusTo(paymentStatus) {
+		return nil, &custom_errors.ConflictError{
+			Message: fmt.Sprintf("order payment status cannot change from %s to %s", order.PaymentStatus, paymentStatus),
+		}
+	}
+
+	if order.PaymentStatus == paymentStatus {
+		return order, nil
+	}
+
+	order.ChangePaymentStatus(paymentStatus)
+
+	var orderSaved *entities.Order
+	if order.IsPaymentFailed() && order.CanTransitionTo(entities.CANCELED_STATUS) {
+		order.CancelUnpaid()
+		orderSaved, err = service.orderRepository.Cancel(id, *order)
+	} else {
+		orderSaved, err = service.orderRepository.Update(id, *order)
+	}
+
+	if errors.Is(err, entities.ErrOrderChanged) {
+		return nil, &custom_errors.ConflictError{
+			Message: "order was changed by someone else, please load it again",
+		}
+	}
+
+	if err != nil {
+		return nil, errors.New("update order payment status on repository has failed")
+	}
+
+	service.publish(paymentStatusEvent(*orderSaved), *orderSaved)
+
+	return orderSaved, err
+}
+
+// paymentStatusEvent is the event the kitchen boards get for a payment callback. Boards only show paid
+// orders, so an approved payment is when the order reaches them, unless it was canceled meanwhile.
+func paymentStatusEvent(order entities.Order) string {
+	if order.IsOnKitchenBoard() {
+		return entities.ORDER_CREATED_EVENT
+	}
+
+	return entities.ORDER_PAYMENT_STATUS_CHANGED_EVENT
+}
+
+// publish tells the kitchen boards about a saved change. A failure is only logged, because the order
+// is already stored and the boards resynchronize from a snapshot when they reconnect.
+func (service *orderService) publish(eventType string, order entities.Order) {
+	err := service.orderEventRepository.Publish(entities.NewOrderEvent(eventType, order))
+
+	if err != nil {
+		log.Printf("order %d %s event publishing has failed: %s", order.ID, eventType, err.Error())
+	}
+}
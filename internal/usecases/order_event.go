@@ -0,0 +1,32 @@
+package usecases
+
+import (
+	"context"
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+	"github.com/8soat-grupo35/fastfood-order/internal/interfaces/repository"
+	"github.com/8soat-grupo35/fastfood-order/internal/interfaces/usecase"
+
+	custom_errors "github.com/8soat-grupo35/fastfood-order/internal/api/errors"
+)
+
+type orderEventUseCase struct {
+	orderEventRepository repository.OrderEventRepository
+}
+
+func NewOrderEventUseCase(orderEventRepository repository.OrderEventRepository) usecase.OrderEventUseCase {
+	return &orderEventUseCase{
+		orderEventRepository: orderEventRepository,
+	}
+}
+
+func (o *orderEventUseCase) Listen(ctx context.Context, handle func(entities.OrderEvent)) error {
+	err := o.orderEventRepository.Listen(ctx, handle)
+
+	if err != nil {
+		return &custom_errors.DatabaseError{
+			Message: "listen order events on repository has failed",
+		}
+	}
+
+	return nil
+}
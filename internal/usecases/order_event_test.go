@@ -0,0 +1,60 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+	mockRepository "github.com/8soat-grupo35/fastfood-order/internal/interfaces/repository/mock"
+	"github.com/8soat-grupo35/fastfood-order/internal/interfaces/usecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+	"testing"
+
+	custom_errors "github.com/8soat-grupo35/fastfood-order/internal/api/errors"
+)
+
+type OrderEventUseCaseSuite struct {
+	suite.Suite
+	ctrl    *gomock.Controller
+	repo    *mockRepository.MockOrderEventRepository
+	useCase usecase.OrderEventUseCase
+}
+
+func (suite *OrderEventUseCaseSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.repo = mockRepository.NewMockOrderEventRepository(suite.ctrl)
+	suite.useCase = NewOrderEventUseCase(suite.repo)
+}
+
+func (suite *OrderEventUseCaseSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func (suite *OrderEventUseCaseSuite) TestListen() {
+	var received []entities.OrderEvent
+	handle := func(event entities.OrderEvent) {
+		received = append(received, event)
+	}
+
+	suite.repo.EXPECT().Listen(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, handle func(entities.OrderEvent)) error {
+		handle(entities.OrderEvent{Type: entities.ORDER_CREATED_EVENT, OrderID: 1})
+		return nil
+	})
+
+	err := suite.useCase.Listen(context.Background(), handle)
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), received, 1)
+}
+
+func (suite *OrderEventUseCaseSuite) TestListenReturnsErrorOnRepositoryFailure() {
+	suite.repo.EXPECT().Listen(gomock.Any(), gomock.Any()).Return(errors.New("connection lost"))
+
+	err := suite.useCase.Listen(context.Background(), func(entities.OrderEvent) {})
+	assert.IsType(suite.T(), &custom_errors.DatabaseError{}, err)
+	assert.Equal(suite.T(), "listen order events on repository has failed", err.Error())
+}
+
+func TestOrderEventUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(OrderEventUseCaseSuite))
+}
@@ -3,26 +3,46 @@ package usecases
 import (
 	"errors"
 	"github.com/8soat-grupo35/fastfood-order/internal/adapters/dto"
+	custom_errors "github.com/8soat-grupo35/fastfood-order/internal/api/errors"
 	"github.com/8soat-grupo35/fastfood-order/internal/entities"
 	mockRepository "github.com/8soat-grupo35/fastfood-order/internal/interfaces/repository/mock"
 	"github.com/8soat-grupo35/fastfood-order/internal/interfaces/usecase"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
 	"testing"
+	"time"
 )
 
+var registeredCustomerID = uint32(1)
+var unknownCustomerID = uint32(9)
+
 type OrderUseCaseSuite struct {
 	suite.Suite
-	ctrl    *gomock.Controller
-	repo    *mockRepository.MockOrderRepository
-	useCase usecase.OrderUseCase
+	ctrl          *gomock.Controller
+	repo          *mockRepository.MockOrderRepository
+	itemRepo      *mockRepository.MockItemRepository
+	comboRepo     *mockRepository.MockComboRepository
+	promotionRepo *mockRepository.MockPromotionRepository
+	customerRepo  *mockRepository.MockCustomerRepository
+	eventRepo     *mockRepository.MockOrderEventRepository
+	storeRepo     *mockRepository.MockStoreRepository
+	useCase       usecase.OrderUseCase
 }
 
 func (suite *OrderUseCaseSuite) SetupTest() {
 	suite.ctrl = gomock.NewController(suite.T())
 	suite.repo = mockRepository.NewMockOrderRepository(suite.ctrl)
-	suite.useCase = NewOrderUseCase(suite.repo)
+	suite.itemRepo = mockRepository.NewMockItemRepository(suite.ctrl)
+	suite.comboRepo = mockRepository.NewMockComboRepository(suite.ctrl)
+	suite.promotionRepo = mockRepository.NewMockPromotionRepository(suite.ctrl)
+	suite.customerRepo = mockRepository.NewMockCustomerRepository(suite.ctrl)
+	suite.eventRepo = mockRepository.NewMockOrderEventRepository(suite.ctrl)
+	suite.storeRepo = mockRepository.NewMockStoreRepository(suite.ctrl)
+	suite.storeRepo.EXPECT().GetHours(uint32(1)).Return(nil, nil).AnyTimes()
+	suite.storeRepo.EXPECT().GetCalendar(uint32(1), gomock.Any()).Return(nil, nil).AnyTimes()
+	suite.useCase = NewOrderUseCase(suite.repo, suite.itemRepo, suite.comboRepo, suite.promotionRepo, suite.customerRepo, suite.eventRepo, suite.storeRepo)
 }
 
 func (suite *OrderUseCaseSuite) TearDownTest() {
@@ -34,40 +54,689 @@ func (suite *OrderUseCaseSuite) TestGetAll() {
 		{ID: 1, Status: "Pending"},
 	}
 
-	suite.repo.EXPECT().GetAll().Return(expectedOrders, nil)
+	suite.repo.EXPECT().GetAll(entities.OrderFilter{StoreID: 1}).Return(expectedOrders, nil)
 
-	orders, err := suite.useCase.GetAll()
+	orders, err := suite.useCase.GetAll(matriz)
 	assert.NoError(suite.T(), err)
 	assert.Equal(suite.T(), expectedOrders, orders)
 }
 
 func (suite *OrderUseCaseSuite) TestGetAllReturnsErrorOnRepositoryFailure() {
-	suite.repo.EXPECT().GetAll().Return(nil, errors.New("query error"))
+	suite.repo.EXPECT().GetAll(entities.OrderFilter{StoreID: 1}).Return(nil, errors.New("query error"))
 
-	orders, err := suite.useCase.GetAll()
+	orders, err := suite.useCase.GetAll(matriz)
 	assert.Error(suite.T(), err)
 	assert.Empty(suite.T(), orders)
 	assert.Equal(suite.T(), "get order from repository has failed", err.Error())
 }
 
+func (suite *OrderUseCaseSuite) TestSearch() {
+	orders := []entities.Order{
+		{ID: 1, Status: entities.FINISHED_STATUS},
+		{ID: 2, Status: entities.FINISHED_STATUS},
+		{ID: 3, Status: entities.FINISHED_STATUS},
+	}
+
+	suite.repo.EXPECT().GetAll(gomock.Any()).DoAndReturn(func(filter entities.OrderFilter) ([]entities.Order, error) {
+		assert.Equal(suite.T(), uint32(1), filter.StoreID)
+		assert.Equal(suite.T(), []string{entities.FINISHED_STATUS}, filter.Statuses)
+		assert.Equal(suite.T(), 3, filter.Limit)
+		return orders, nil
+	})
+
+	page, err := suite.useCase.Search(matriz, dto.OrderFilterDto{Status: []string{"finalizado"}, Limit: 2})
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), page.Orders, 2)
+	assert.NotEmpty(suite.T(), page.NextCursor)
+}
+
+func (suite *OrderUseCaseSuite) TestSearchListsWholeKitchenBoard() {
+	orders := make([]entities.Order, entities.DEFAULT_ORDER_PAGE_SIZE+5)
+
+	suite.repo.EXPECT().GetAll(entities.OrderFilter{StoreID: 1}).Return(orders, nil)
+
+	page, err := suite.useCase.Search(matriz, dto.OrderFilterDto{})
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), page.Orders, entities.DEFAULT_ORDER_PAGE_SIZE+5)
+	assert.Empty(suite.T(), page.NextCursor)
+}
+
+func (suite *OrderUseCaseSuite) TestSearchReturnsBadRequestOnInvalidFilter() {
+	page, err := suite.useCase.Search(matriz, dto.OrderFilterDto{Status: []string{"INVALID_STATUS"}})
+	assert.Nil(suite.T(), page)
+	assert.IsType(suite.T(), &custom_errors.BadRequestError{}, err)
+}
+
+func (suite *OrderUseCaseSuite) TestSearchReturnsErrorOnRepositoryFailure() {
+	suite.repo.EXPECT().GetAll(gomock.Any()).Return(nil, errors.New("query error"))
+
+	page, err := suite.useCase.Search(matriz, dto.OrderFilterDto{})
+	assert.Nil(suite.T(), page)
+	assert.IsType(suite.T(), &custom_errors.DatabaseError{}, err)
+}
+
+func (suite *OrderUseCaseSuite) TestTrack() {
+	order := &entities.Order{ID: 2, TrackingCode: "ABCD2345", Status: entities.RECEIVED_STATUS, PaymentStatus: entities.PAYMENT_APPROVED_STATUS}
+	queue := []entities.Order{
+		{ID: 1, Status: entities.IN_PREPARATION_STATUS},
+		*order,
+	}
+
+	suite.repo.EXPECT().GetByTrackingCode(uint32(1), "ABCD2345").Return(order, nil)
+	suite.repo.EXPECT().GetAll(entities.OrderFilter{StoreID: 1}).Return(queue, nil)
+	suite.repo.EXPECT().AveragePreparationTime(uint32(1), 20).Return(5*time.Minute, nil)
+
+	tracking, err := suite.useCase.Track(matriz, "abcd2345")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "ABCD2345", tracking.TrackingCode)
+	assert.Equal(suite.T(), 1, *tracking.OrdersAhead)
+	assert.WithinDuration(suite.T(), time.Now().Add(10*time.Minute), *tracking.EstimatedReadyAt, time.Second)
+}
+
+func (suite *OrderUseCaseSuite) TestTrackReturnsNotFoundOnUnknownCode() {
+	suite.repo.EXPECT().GetByTrackingCode(uint32(1), "ZZZZ9999").Return(nil, gorm.ErrRecordNotFound)
+
+	tracking, err := suite.useCase.Track(matriz, "ZZZZ9999")
+	assert.Nil(suite.T(), tracking)
+	assert.IsType(suite.T(), &custom_errors.NotFoundError{}, err)
+}
+
+func (suite *OrderUseCaseSuite) TestTimeline() {
+	timeline := []entities.OrderStatusHistory{
+		{ID: 1, OrderID: 1, Status: entities.RECEIVED_STATUS, ChangedBy: entities.SYSTEM_ACTOR},
+	}
+
+	suite.repo.EXPECT().GetInStore(uint32(1), uint32(1)).Return(&entities.Order{ID: 1}, nil)
+	suite.repo.EXPECT().GetStatusHistory(uint32(1)).Return(timeline, nil)
+
+	history, err := suite.useCase.Timeline(matriz, 1)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), timeline, history)
+}
+
+func (suite *OrderUseCaseSuite) TestTimelineReturnsNotFoundOnUnknownOrder() {
+	suite.repo.EXPECT().GetInStore(uint32(1), uint32(9)).Return(nil, gorm.ErrRecordNotFound)
+
+	history, err := suite.useCase.Timeline(matriz, 9)
+	assert.Nil(suite.T(), history)
+	assert.IsType(suite.T(), &custom_errors.NotFoundError{}, err)
+}
+
+func (suite *OrderUseCaseSuite) TestTimelineReturnsErrorOnRepositoryFailure() {
+	suite.repo.EXPECT().GetInStore(uint32(1), uint32(1)).Return(&entities.Order{ID: 1}, nil)
+	suite.repo.EXPECT().GetStatusHistory(uint32(1)).Return(nil, errors.New("select error"))
+
+	history, err := suite.useCase.Timeline(matriz, 1)
+	assert.Nil(suite.T(), history)
+	assert.IsType(suite.T(), &custom_errors.DatabaseError{}, err)
+}
+
+func (suite *OrderUseCaseSuite) TestGetByCustomer() {
+	expectedOrders := []entities.Order{{ID: 2, CustomerID: &registeredCustomerID}, {ID: 1, CustomerID: &registeredCustomerID}}
+
+	suite.customerRepo.EXPECT().GetOne(entities.Customer{ID: 1}).Return(&entities.Customer{ID: 1}, nil)
+	suite.repo.EXPECT().GetByCustomer(uint32(1), uint32(1)).Return(expectedOrders, nil)
+
+	orders, err := suite.useCase.GetByCustomer(matriz, 1)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedOrders, orders)
+}
+
+func (suite *OrderUseCaseSuite) TestGetByCustomerReturnsNotFoundOnUnknownCustomer() {
+	suite.customerRepo.EXPECT().GetOne(entities.Customer{ID: unknownCustomerID}).Return(nil, gorm.ErrRecordNotFound)
+
+	orders, err := suite.useCase.GetByCustomer(matriz, unknownCustomerID)
+	assert.Nil(suite.T(), orders)
+	assert.IsType(suite.T(), &custom_errors.NotFoundError{}, err)
+}
+
+func (suite *OrderUseCaseSuite) TestReorderDropsUnavailableItems() {
+	previousOrder := &entities.Order{
+		ID:         1,
+		CustomerID: &registeredCustomerID,
+		Items: []entities.OrderItem{
+			{ItemID: 1, ItemName: "X-Burguer", Quantity: 2},
+			{ItemID: 2, ItemName: "Milkshake", Quantity: 1},
+		},
+	}
+	newOrder := &entities.Order{ID: 5, PickupCode: "A-042"}
+
+	suite.repo.EXPECT().GetInStore(uint32(1), uint32(1)).Return(previousOrder, nil)
+	suite.itemRepo.EXPECT().GetByIds([]uint32{1, 2}).Return([]entities.Item{{ID: 1, Name: "X-Burguer", Price: 2800}}, nil)
+	suite.customerRepo.EXPECT().GetOne(entities.Customer{ID: 1}).Return(&entities.Customer{ID: 1, Name: "John Doe"}, nil)
+	suite.itemRepo.EXPECT().GetByIds([]uint32{1}).Return([]entities.Item{{ID: 1, Name: "X-Burguer", Price: 2800}}, nil)
+	suite.promotionRepo.EXPECT().GetAutomatic(matriz.ID, gomock.Any()).Return(nil, nil)
+	suite.repo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(order entities.Order, pickupCodeFormat entities.PickupCodeFormat) (*entities.Order, error) {
+		assert.Len(suite.T(), order.Items, 1)
+		assert.Equal(suite.T(), uint32(2), order.Items[0].Quantity)
+		return newOrder, nil
+	})
+
+	reorder, err := suite.useCase.Reorder(matriz, 1)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), *newOrder, reorder.Order)
+	assert.Equal(suite.T(), []entities.DroppedOrderItem{
+		{ItemID: 2, ItemName: "Milkshake", Quantity: 1, Reason: entities.ITEM_UNAVAILABLE_REASON},
+	}, reorder.DroppedItems)
+}
+
+func (suite *OrderUseCaseSuite) TestReorderDropsItemsOffSale() {
+	previousOrder := &entities.Order{
+		ID:         1,
+		CustomerID: &registeredCustomerID,
+		Items: []entities.OrderItem{
+			{ItemID: 1, ItemName: "X-Burguer", Quantity: 1},
+			{ItemID: 2, ItemName: "Pão de queijo", Quantity: 2},
+		},
+	}
+	breakfast := menuAround(false, true)
+	items := []entities.Item{
+		{ID: 1, Name: "X-Burguer", Price: 2800},
+		{ID: 2, Name: "Pão de queijo", Price: 600, MenuItems: []entities.MenuItem{{MenuID: 1, ItemID: 2, Menu: breakfast}}},
+	}
+
+	suite.repo.EXPECT().GetInStore(uint32(1), uint32(1)).Return(previousOrder, nil)
+	suite.itemRepo.EXPECT().GetByIds([]uint32{1, 2}).Return(items, nil)
+	suite.customerRepo.EXPECT().GetOne(entities.Customer{ID: 1}).Return(&entities.Customer{ID: 1, Name: "John Doe"}, nil)
+	suite.itemRepo.EXPECT().GetByIds([]uint32{1}).Return([]entities.Item{{ID: 1, Name: "X-Burguer", Price: 2800}}, nil)
+	suite.promotionRepo.EXPECT().GetAutomatic(matriz.ID, gomock.Any()).Return(nil, nil)
+	suite.repo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(&entities.Order{ID: 5}, nil)
+
+	reorder, err := suite.useCase.Reorder(matriz, 1)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []entities.DroppedOrderItem{
+		{ItemID: 2, ItemName: "Pão de queijo", Quantity: 2, Reason: entities.ITEM_UNAVAILABLE_REASON},
+	}, reorder.DroppedItems)
+}
+
+func (suite *OrderUseCaseSuite) TestReorderRepeatsCombos() {
+	comboID := uint32(7)
+	previousOrder := &entities.Order{
+		ID:           1,
+		CustomerName: "Maria",
+		Items: []entities.OrderItem{
+			{ItemID: 1, Quantity: 1, ComboID: &comboID, ComboGroup: 1},
+			{ItemID: 2, Quantity: 1, ComboID: &comboID, ComboGroup: 1},
+			{ItemID: 3, Quantity: 1, ComboID: &comboID, ComboGroup: 1},
+		},
+	}
+	newOrder := &entities.Order{ID: 5, PickupCode: "A-042"}
+
+	suite.repo.EXPECT().GetInStore(uint32(1), uint32(1)).Return(previousOrder, nil)
+	suite.itemRepo.EXPECT().GetByIds([]uint32{1, 2, 3}).Return(comboItems(), nil).Times(2)
+	suite.comboRepo.EXPECT().GetByIds([]uint32{7, 7, 7}).Return([]entities.Combo{classicCombo()}, nil)
+	suite.comboRepo.EXPECT().GetByIds([]uint32{7}).Return([]entities.Combo{classicCombo()}, nil)
+	suite.promotionRepo.EXPECT().GetAutomatic(matriz.ID, gomock.Any()).Return(nil, nil)
+	suite.repo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(order entities.Order, pickupCodeFormat entities.PickupCodeFormat) (*entities.Order, error) {
+		assert.Len(suite.T(), order.Items, 3)
+		assert.Equal(suite.T(), entities.Money(3500), order.Total)
+		return newOrder, nil
+	})
+
+	reorder, err := suite.useCase.Reorder(matriz, 1)
+	assert.NoError(suite.T(), err)
+	assert.Empty(suite.T(), reorder.DroppedItems)
+}
+
+func (suite *OrderUseCaseSuite) TestReorderReturnsConflictWhenNoItemIsAvailable() {
+	previousOrder := &entities.Order{ID: 1, CustomerID: &registeredCustomerID, Items: []entities.OrderItem{{ItemID: 2, Quantity: 1}}}
+
+	suite.repo.EXPECT().GetInStore(uint32(1), uint32(1)).Return(previousOrder, nil)
+	suite.itemRepo.EXPECT().GetByIds([]uint32{2}).Return([]entities.Item{}, nil)
+
+	reorder, err := suite.useCase.Reorder(matriz, 1)
+	assert.Nil(suite.T(), reorder)
+	assert.IsType(suite.T(), &custom_errors.ConflictError{}, err)
+}
+
+func (suite *OrderUseCaseSuite) TestReorderReturnsNotFoundOnUnknownOrder() {
+	suite.repo.EXPECT().GetInStore(uint32(1), uint32(9)).Return(nil, gorm.ErrRecordNotFound)
+
+	reorder, err := suite.useCase.Reorder(matriz, 9)
+	assert.Nil(suite.T(), reorder)
+	assert.IsType(suite.T(), &custom_errors.NotFoundError{}, err)
+}
+
+func (suite *OrderUseCaseSuite) TestReorderReturnsCheckoutValidationErrors() {
+	previousOrder := &entities.Order{ID: 1, CustomerID: &unknownCustomerID, Items: []entities.OrderItem{{ItemID: 1, Quantity: 1}}}
+
+	suite.repo.EXPECT().GetInStore(uint32(1), uint32(1)).Return(previousOrder, nil)
+	suite.itemRepo.EXPECT().GetByIds([]uint32{1}).Return([]entities.Item{{ID: 1, Price: 2800}}, nil).Times(2)
+	suite.customerRepo.EXPECT().GetOne(entities.Customer{ID: unknownCustomerID}).Return(nil, gorm.ErrRecordNotFound)
+
+	reorder, err := suite.useCase.Reorder(matriz, 1)
+	assert.Nil(suite.T(), reorder)
+	assert.IsType(suite.T(), &custom_errors.BadRequestError{}, err)
+}
+
 func (suite *OrderUseCaseSuite) TestCreate() {
 	itemsDto := []dto.OrderItemDto{
 		{Id: 1, Quantity: 2},
 	}
-	orderDto := dto.OrderDto{Status: "Pending", CustomerID: 1, Items: itemsDto}
+	orderDto := dto.OrderDto{Status: "Pending", CustomerID: &registeredCustomerID, Items: itemsDto}
 	newOrder := &entities.Order{ID: 1, Status: "Pending"}
 
-	suite.repo.EXPECT().Create(gomock.Any()).Return(newOrder, nil)
+	suite.customerRepo.EXPECT().GetOne(entities.Customer{ID: 1}).Return(&entities.Customer{ID: 1, Name: "John Doe"}, nil)
+	suite.itemRepo.EXPECT().GetByIds([]uint32{1}).Return([]entities.Item{{ID: 1, Name: "X-Burguer", Price: 2800}}, nil)
+	suite.promotionRepo.EXPECT().GetAutomatic(matriz.ID, gomock.Any()).Return(nil, nil)
+	suite.repo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(order entities.Order, pickupCodeFormat entities.PickupCodeFormat) (*entities.Order, error) {
+		assert.Equal(suite.T(), "John Doe", order.CustomerName)
+		assert.Equal(suite.T(), uint32(1), order.StoreID)
+		assert.Equal(suite.T(), matriz.PickupCodeFormat(), pickupCodeFormat)
+		assert.Equal(suite.T(), "X-Burguer", order.Items[0].ItemName)
+		assert.Equal(suite.T(), entities.Money(2800), order.Items[0].UnitPrice)
+		assert.Equal(suite.T(), entities.Money(5600), order.Subtotal)
+		assert.Equal(suite.T(), entities.Money(5600), order.Total)
+		return newOrder, nil
+	})
+
+	createdOrder, err := suite.useCase.Create(matriz, orderDto)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), newOrder, createdOrder)
+}
+
+func (suite *OrderUseCaseSuite) TestCreatePricesChosenVariant() {
+	largeID := uint32(3)
+	orderDto := dto.OrderDto{CustomerName: "Maria", Items: []dto.OrderItemDto{{Id: 1, VariantID: &largeID, Quantity: 1}}}
+	newOrder := &entities.Order{ID: 1, CustomerName: "Maria"}
+
+	suite.itemRepo.EXPECT().GetByIds([]uint32{1}).Return([]entities.Item{{ID: 1, Name: "Refrigerante", Price: 790, Variants: []entities.ItemVariant{
+		{ID: 3, Label: "G", PriceDelta: 250, Available: true},
+	}}}, nil)
+	suite.promotionRepo.EXPECT().GetAutomatic(matriz.ID, gomock.Any()).Return(nil, nil)
+	suite.repo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(order entities.Order, pickupCodeFormat entities.PickupCodeFormat) (*entities.Order, error) {
+		assert.Equal(suite.T(), "G", order.Items[0].VariantLabel)
+		assert.Equal(suite.T(), entities.Money(1040), order.Total)
+		return newOrder, nil
+	})
+
+	createdOrder, err := suite.useCase.Create(matriz, orderDto)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), newOrder, createdOrder)
+}
+
+func (suite *OrderUseCaseSuite) TestCreateExpandsCombo() {
+	orderDto := dto.OrderDto{CustomerName: "Maria", Combos: []dto.OrderComboDto{
+		{Id: 7, Quantity: 2, Items: []dto.OrderComboItemDto{{Id: 3}, {Id: 1}, {Id: 2}}},
+	}}
+	newOrder := &entities.Order{ID: 1, CustomerName: "Maria"}
+
+	suite.itemRepo.EXPECT().GetByIds([]uint32{3, 1, 2}).Return(comboItems(), nil)
+	suite.comboRepo.EXPECT().GetByIds([]uint32{7}).Return([]entities.Combo{classicCombo()}, nil)
+	suite.promotionRepo.EXPECT().GetAutomatic(matriz.ID, gomock.Any()).Return(nil, nil)
+	suite.repo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(order entities.Order, pickupCodeFormat entities.PickupCodeFormat) (*entities.Order, error) {
+		assert.Len(suite.T(), order.Items, 3)
+		assert.Empty(suite.T(), order.Combos)
+		assert.Equal(suite.T(), "Combo Classico", order.Items[0].ComboName)
+		assert.Equal(suite.T(), uint32(1), order.Items[0].ComboGroup)
+		assert.Equal(suite.T(), uint32(2), order.Items[0].Quantity)
+		assert.Equal(suite.T(), entities.Money(7000), order.Total)
+		return newOrder, nil
+	})
+
+	createdOrder, err := suite.useCase.Create(matriz, orderDto)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), newOrder, createdOrder)
+}
+
+func (suite *OrderUseCaseSuite) TestCreateReturnsBadRequestOnComboMissingASlot() {
+	orderDto := dto.OrderDto{CustomerName: "Maria", Combos: []dto.OrderComboDto{
+		{Id: 7, Quantity: 1, Items: []dto.OrderComboItemDto{{Id: 1}, {Id: 2}}},
+	}}
+
+	suite.itemRepo.EXPECT().GetByIds([]uint32{1, 2}).Return(comboItems(), nil)
+	suite.comboRepo.EXPECT().GetByIds([]uint32{7}).Return([]entities.Combo{classicCombo()}, nil)
+
+	createdOrder, err := suite.useCase.Create(matriz, orderDto)
+	assert.Nil(suite.T(), createdOrder)
+	assert.IsType(suite.T(), &custom_errors.BadRequestError{}, err)
+	assert.Equal(suite.T(), []custom_errors.ErrorDetail{
+		{Field: "combos.0.items", Message: "must choose one item for each of the 3 slots of combo 7"},
+	}, err.(*custom_errors.BadRequestError).Details)
+}
+
+func (suite *OrderUseCaseSuite) TestCreateReturnsErrorOnComboRepositoryFailure() {
+	orderDto := dto.OrderDto{CustomerName: "Maria", Combos: []dto.OrderComboDto{
+		{Id: 7, Quantity: 1, Items: []dto.OrderComboItemDto{{Id: 1}, {Id: 2}, {Id: 3}}},
+	}}
+
+	suite.itemRepo.EXPECT().GetByIds([]uint32{1, 2, 3}).Return(comboItems(), nil)
+	suite.comboRepo.EXPECT().GetByIds([]uint32{7}).Return(nil, errors.New("database error"))
+
+	createdOrder, err := suite.useCase.Create(matriz, orderDto)
+	assert.Nil(suite.T(), createdOrder)
+	assert.IsType(suite.T(), &custom_errors.DatabaseError{}, err)
+}
+
+func (suite *OrderUseCaseSuite) TestCreateAppliesCouponAndAutomaticPromotions() {
+	orderDto := dto.OrderDto{CustomerID: &registeredCustomerID, Coupon: " primeira ", Items: []dto.OrderItemDto{{Id: 1, Quantity: 2}}}
+	sandwiches := entities.Promotion{ID: 1, Name: "Lanches 10%", Kind: entities.PROMOTION_CATEGORY_PERCENTAGE, Category: "LANCHE", PercentageOff: 10}
+	coupon := entities.Promotion{ID: 2, Name: "Primeira compra", Code: "PRIMEIRA", Kind: entities.PROMOTION_FIXED_AMOUNT, Amount: 500, FirstOrderOnly: true}
+
+	suite.customerRepo.EXPECT().GetOne(entities.Customer{ID: 1}).Return(&entities.Customer{ID: 1}, nil)
+	suite.itemRepo.EXPECT().GetByIds([]uint32{1}).Return([]entities.Item{{ID: 1, Category: "LANCHE", Price: 2800}}, nil)
+	suite.promotionRepo.EXPECT().GetAutomatic(matriz.ID, gomock.Any()).Return([]entities.Promotion{sandwiches}, nil)
+	suite.promotionRepo.EXPECT().GetByCode(matriz.ID, "PRIMEIRA").Return(&coupon, nil)
+	suite.promotionRepo.EXPECT().GetCustomerHistory(uint32(1)).Return(&entities.PromotionHistory{}, nil)
+	suite.repo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(order entities.Order, pickupCodeFormat entities.PickupCodeFormat) (*entities.Order, error) {
+		assert.Equal(suite.T(), []entities.OrderDiscount{
+			{PromotionID: 1, PromotionName: "Lanches 10%", Amount: 560},
+			{PromotionID: 2, PromotionName: "Primeira compra", Code: "PRIMEIRA", Amount: 500, FirstOrderOnly: true},
+		}, order.Discounts)
+		assert.Equal(suite.T(), entities.Money(1060), order.Discount)
+		assert.Equal(suite.T(), entities.Money(4540), order.Total)
+		return &order, nil
+	})
+
+	_, err := suite.useCase.Create(matriz, orderDto)
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *OrderUseCaseSuite) TestCreateReturnsBadRequestOnUnknownCoupon() {
+	orderDto := dto.OrderDto{CustomerName: "Maria", Coupon: "NADA", Items: []dto.OrderItemDto{{Id: 1, Quantity: 1}}}
+
+	suite.itemRepo.EXPECT().GetByIds([]uint32{1}).Return([]entities.Item{{ID: 1, Price: 2800}}, nil)
+	suite.promotionRepo.EXPECT().GetAutomatic(matriz.ID, gomock.Any()).Return(nil, nil)
+	suite.promotionRepo.EXPECT().GetByCode(matriz.ID, "NADA").Return(nil, gorm.ErrRecordNotFound)
+
+	createdOrder, err := suite.useCase.Create(matriz, orderDto)
+	assert.Nil(suite.T(), createdOrder)
+	assert.IsType(suite.T(), &custom_errors.BadRequestError{}, err)
+	assert.Equal(suite.T(), []custom_errors.ErrorDetail{
+		{Field: "coupon", Message: "coupon NADA not found"},
+	}, err.(*custom_errors.BadRequestError).Details)
+}
+
+func (suite *OrderUseCaseSuite) TestCreateReturnsBadRequestOnCouponThatDoesNotFit() {
+	orderDto := dto.OrderDto{CustomerName: "Maria", Coupon: "PRIMEIRA", Items: []dto.OrderItemDto{{Id: 1, Quantity: 1}}}
+	coupon := entities.Promotion{ID: 2, Code: "PRIMEIRA", Kind: entities.PROMOTION_FIXED_AMOUNT, Amount: 500, FirstOrderOnly: true}
+
+	suite.itemRepo.EXPECT().GetByIds([]uint32{1}).Return([]entities.Item{{ID: 1, Price: 2800}}, nil)
+	suite.promotionRepo.EXPECT().GetAutomatic(matriz.ID, gomock.Any()).Return(nil, nil)
+	suite.promotionRepo.EXPECT().GetByCode(matriz.ID, "PRIMEIRA").Return(&coupon, nil)
+
+	createdOrder, err := suite.useCase.Create(matriz, orderDto)
+	assert.Nil(suite.T(), createdOrder)
+	assert.Equal(suite.T(), []custom_errors.ErrorDetail{
+		{Field: "coupon", Message: "requires an identified customer"},
+	}, err.(*custom_errors.BadRequestError).Details)
+}
+
+func (suite *OrderUseCaseSuite) TestCreateReturnsConflictWhenPromotionRunsOut() {
+	orderDto := dto.OrderDto{CustomerName: "Maria", Items: []dto.OrderItemDto{{Id: 1, Quantity: 1}}}
+	promotion := entities.Promotion{ID: 3, Kind: entities.PROMOTION_FIXED_AMOUNT, Amount: 500, UsageLimit: 100, TimesUsed: 99}
+
+	suite.itemRepo.EXPECT().GetByIds([]uint32{1}).Return([]entities.Item{{ID: 1, Price: 2800}}, nil)
+	suite.promotionRepo.EXPECT().GetAutomatic(matriz.ID, gomock.Any()).Return([]entities.Promotion{promotion}, nil)
+	suite.repo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil, entities.ErrPromotionRunOut)
+
+	createdOrder, err := suite.useCase.Create(matriz, orderDto)
+	assert.Nil(suite.T(), createdOrder)
+	assert.IsType(suite.T(), &custom_errors.ConflictError{}, err)
+}
+
+func (suite *OrderUseCaseSuite) TestCreateReturnsConflictWhenCustomerAlreadyUsedPromotion() {
+	orderDto := dto.OrderDto{CustomerID: &registeredCustomerID, Coupon: "PRIMEIRA", Items: []dto.OrderItemDto{{Id: 1, Quantity: 1}}}
+	coupon := entities.Promotion{ID: 2, Code: "PRIMEIRA", Kind: entities.PROMOTION_FIXED_AMOUNT, Amount: 500, FirstOrderOnly: true}
+
+	suite.customerRepo.EXPECT().GetOne(entities.Customer{ID: 1}).Return(&entities.Customer{ID: 1, Name: "John Doe"}, nil)
+	suite.itemRepo.EXPECT().GetByIds([]uint32{1}).Return([]entities.Item{{ID: 1, Price: 2800}}, nil)
+	suite.promotionRepo.EXPECT().GetAutomatic(matriz.ID, gomock.Any()).Return(nil, nil)
+	suite.promotionRepo.EXPECT().GetByCode(matriz.ID, "PRIMEIRA").Return(&coupon, nil)
+	suite.promotionRepo.EXPECT().GetCustomerHistory(uint32(1)).Return(&entities.PromotionHistory{}, nil)
+	suite.repo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(order entities.Order, pickupCodeFormat entities.PickupCodeFormat) (*entities.Order, error) {
+		assert.True(suite.T(), order.Discounts[0].FirstOrderOnly)
+		return nil, entities.ErrPromotionAlreadyUsed
+	})
+
+	createdOrder, err := suite.useCase.Create(matriz, orderDto)
+	assert.Nil(suite.T(), createdOrder)
+	assert.IsType(suite.T(), &custom_errors.ConflictError{}, err)
+}
+
+func (suite *OrderUseCaseSuite) TestCreateReturnsConflictOnItemOutOfStock() {
+	orderDto := dto.OrderDto{CustomerName: "Maria", Items: []dto.OrderItemDto{{Id: 1, Quantity: 3}}}
+	stocks := []entities.StoreItemStock{{StoreID: matriz.ID, ItemID: 1, Stock: 2}, {StoreID: 2, ItemID: 1, Stock: 10}}
+
+	suite.itemRepo.EXPECT().GetByIds([]uint32{1}).Return([]entities.Item{{ID: 1, Name: "X-Burguer", Price: 2800, Stocks: stocks}}, nil)
+
+	createdOrder, err := suite.useCase.Create(matriz, orderDto)
+	assert.Nil(suite.T(), createdOrder)
+	assert.IsType(suite.T(), &custom_errors.ConflictError{}, err)
+	assert.Equal(suite.T(), "item 1 (X-Burguer) is out of stock", err.Error())
+}
+
+func (suite *OrderUseCaseSuite) TestCreateReturnsConflictOnIngredientOutOfStock() {
+	orderDto := dto.OrderDto{CustomerName: "Maria", Items: []dto.OrderItemDto{{Id: 1, Quantity: 3}}}
+	bread := entities.Ingredient{ID: 5, Name: "Pão", Unit: entities.INGREDIENT_UNIT, Stocks: []entities.StoreIngredientStock{{StoreID: matriz.ID, IngredientID: 5, Stock: 2000}}}
+	recipe := []entities.RecipeIngredient{{IngredientID: 5, Quantity: 1000, Ingredient: &bread}}
+
+	suite.itemRepo.EXPECT().GetByIds([]uint32{1}).Return([]entities.Item{{ID: 1, Name: "X-Burguer", Price: 2800, Recipe: recipe}}, nil)
+
+	createdOrder, err := suite.useCase.Create(matriz, orderDto)
+	assert.Nil(suite.T(), createdOrder)
+	assert.IsType(suite.T(), &custom_errors.ConflictError{}, err)
+	assert.Equal(suite.T(), "item 1 (X-Burguer) is out of stock", err.Error())
+}
+
+func (suite *OrderUseCaseSuite) TestCreateRecordsTheIngredientsTaken() {
+	orderDto := dto.OrderDto{CustomerName: "Maria", Items: []dto.OrderItemDto{{Id: 1, Quantity: 2}}}
+	meat := entities.Ingredient{ID: 5, Name: "Carne", Unit: entities.INGREDIENT_GRAM, Stocks: []entities.StoreIngredientStock{{StoreID: matriz.ID, IngredientID: 5, Stock: 1000000}}}
+	recipe := []entities.RecipeIngredient{{IngredientID: 5, Quantity: 150000, Ingredient: &meat}}
+
+	suite.itemRepo.EXPECT().GetByIds([]uint32{1}).Return([]entities.Item{{ID: 1, Name: "X-Burguer", Price: 2800, Recipe: recipe}}, nil)
+	suite.promotionRepo.EXPECT().GetAutomatic(matriz.ID, gomock.Any()).Return(nil, nil)
+	suite.repo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(order entities.Order, pickupCodeFormat entities.PickupCodeFormat) (*entities.Order, error) {
+		assert.Equal(suite.T(), []entities.OrderIngredient{{IngredientID: 5, Quantity: 300000, ItemID: 1, ItemName: "X-Burguer"}}, order.Ingredients)
+		return &order, nil
+	})
+
+	_, err := suite.useCase.Create(matriz, orderDto)
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *OrderUseCaseSuite) TestCreateReturnsConflictWhenStockRunsOut() {
+	orderDto := dto.OrderDto{CustomerName: "Maria", Items: []dto.OrderItemDto{{Id: 1, Quantity: 2}}}
+	stocks := []entities.StoreItemStock{{StoreID: matriz.ID, ItemID: 1, Stock: 2}}
+
+	suite.itemRepo.EXPECT().GetByIds([]uint32{1}).Return([]entities.Item{{ID: 1, Name: "X-Burguer", Price: 2800, Stocks: stocks}}, nil)
+	suite.promotionRepo.EXPECT().GetAutomatic(matriz.ID, gomock.Any()).Return(nil, nil)
+	suite.repo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil, &entities.OutOfStockError{ItemID: 1, ItemName: "X-Burguer"})
+
+	createdOrder, err := suite.useCase.Create(matriz, orderDto)
+	assert.Nil(suite.T(), createdOrder)
+	assert.IsType(suite.T(), &custom_errors.ConflictError{}, err)
+	assert.Equal(suite.T(), "item 1 (X-Burguer) is out of stock", err.Error())
+}
+
+func (suite *OrderUseCaseSuite) TestCreateReturnsBadRequestOnModifierNotOffered() {
+	itemsDto := []dto.OrderItemDto{
+		{Id: 1, Quantity: 1, Modifiers: []string{"Cheddar"}},
+	}
+	orderDto := dto.OrderDto{CustomerName: "Maria", Items: itemsDto}
+
+	suite.itemRepo.EXPECT().GetByIds([]uint32{1}).Return([]entities.Item{{ID: 1, Price: 2800, Modifiers: []entities.ItemModifier{{Name: "Bacon extra", Price: 450}}}}, nil)
+
+	createdOrder, err := suite.useCase.Create(matriz, orderDto)
+	assert.Nil(suite.T(), createdOrder)
+	assert.IsType(suite.T(), &custom_errors.BadRequestError{}, err)
+	assert.Equal(suite.T(), []custom_errors.ErrorDetail{
+		{Field: "items.0.modifiers.0", Message: "modifier Cheddar is not available for item 1"},
+	}, err.(*custom_errors.BadRequestError).Details)
+}
+
+func (suite *OrderUseCaseSuite) TestCreateGuestOrderSkipsCustomerLookup() {
+	itemsDto := []dto.OrderItemDto{
+		{Id: 1, Quantity: 1},
+	}
+	orderDto := dto.OrderDto{CustomerName: "Maria", Items: itemsDto}
+	newOrder := &entities.Order{ID: 1, CustomerName: "Maria"}
 
-	createdOrder, err := suite.useCase.Create(orderDto)
+	suite.itemRepo.EXPECT().GetByIds([]uint32{1}).Return([]entities.Item{{ID: 1, Name: "X-Burguer", Price: 2800}}, nil)
+	suite.promotionRepo.EXPECT().GetAutomatic(matriz.ID, gomock.Any()).Return(nil, nil)
+	suite.repo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(order entities.Order, pickupCodeFormat entities.PickupCodeFormat) (*entities.Order, error) {
+		assert.Nil(suite.T(), order.CustomerID)
+		assert.Equal(suite.T(), "Maria", order.CustomerName)
+		return newOrder, nil
+	})
+
+	createdOrder, err := suite.useCase.Create(matriz, orderDto)
 	assert.NoError(suite.T(), err)
 	assert.Equal(suite.T(), newOrder, createdOrder)
 }
 
+func (suite *OrderUseCaseSuite) TestCreateReturnsErrorOnUnknownItem() {
+	itemsDto := []dto.OrderItemDto{
+		{Id: 1, Quantity: 2},
+	}
+	orderDto := dto.OrderDto{CustomerID: &registeredCustomerID, Items: itemsDto}
+
+	suite.customerRepo.EXPECT().GetOne(entities.Customer{ID: 1}).Return(&entities.Customer{ID: 1}, nil)
+	suite.itemRepo.EXPECT().GetByIds([]uint32{1}).Return([]entities.Item{}, nil)
+
+	createdOrder, err := suite.useCase.Create(matriz, orderDto)
+	assert.Nil(suite.T(), createdOrder)
+	assert.IsType(suite.T(), &custom_errors.BadRequestError{}, err)
+	assert.Equal(suite.T(), []custom_errors.ErrorDetail{
+		{Field: "items.0.id", Message: "item 1 not found"},
+	}, err.(*custom_errors.BadRequestError).Details)
+}
+
+func (suite *OrderUseCaseSuite) TestCreateReturnsErrorOnItemPaused() {
+	orderDto := dto.OrderDto{CustomerName: "Maria", Items: []dto.OrderItemDto{{Id: 1, Quantity: 1}}}
+
+	suite.itemRepo.EXPECT().GetByIds([]uint32{1}).Return([]entities.Item{{ID: 1, Name: "X-Burguer", Price: 2800, Paused: true}}, nil)
+
+	createdOrder, err := suite.useCase.Create(matriz, orderDto)
+	assert.Nil(suite.T(), createdOrder)
+	assert.IsType(suite.T(), &custom_errors.BadRequestError{}, err)
+	assert.Equal(suite.T(), []custom_errors.ErrorDetail{
+		{Field: "items.0.id", Message: "item 1 is not on sale now"},
+	}, err.(*custom_errors.BadRequestError).Details)
+}
+
+func (suite *OrderUseCaseSuite) TestCreateChargesThePriceOfTheMenuOpenNow() {
+	happyHourPrice := entities.Money(1990)
+	happyHour := menuAround(true, false)
+	orderDto := dto.OrderDto{CustomerName: "Maria", Items: []dto.OrderItemDto{{Id: 1, Quantity: 2}}}
+	items := []entities.Item{
+		{ID: 1, Name: "X-Burguer", Price: 2800, MenuItems: []entities.MenuItem{{MenuID: 1, ItemID: 1, Price: &happyHourPrice, Menu: happyHour}}},
+	}
+
+	suite.itemRepo.EXPECT().GetByIds([]uint32{1}).Return(items, nil)
+	suite.promotionRepo.EXPECT().GetAutomatic(matriz.ID, gomock.Any()).Return(nil, nil)
+	suite.repo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(order entities.Order, pickupCodeFormat entities.PickupCodeFormat) (*entities.Order, error) {
+		assert.Equal(suite.T(), happyHourPrice, order.Items[0].UnitPrice)
+		assert.Equal(suite.T(), entities.Money(3980), order.Total)
+		return &order, nil
+	})
+
+	_, err := suite.useCase.Create(matriz, orderDto)
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *OrderUseCaseSuite) TestCreateNumbersThePickupCodeByTheStore() {
+	orderDto := dto.OrderDto{CustomerName: "Maria", Items: []dto.OrderItemDto{{Id: 1, Quantity: 1}}}
+
+	suite.storeRepo.EXPECT().GetHours(uint32(2)).Return(nil, nil)
+	suite.storeRepo.EXPECT().GetCalendar(uint32(2), gomock.Any()).Return(nil, nil)
+	suite.itemRepo.EXPECT().GetByIds([]uint32{1}).Return([]entities.Item{{ID: 1, Name: "X-Burguer", Price: 2800}}, nil)
+	suite.promotionRepo.EXPECT().GetAutomatic(centro.ID, gomock.Any()).Return(nil, nil)
+	suite.repo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(order entities.Order, pickupCodeFormat entities.PickupCodeFormat) (*entities.Order, error) {
+		assert.Equal(suite.T(), uint32(2), order.StoreID)
+		assert.Equal(suite.T(), centro.PickupCodeFormat(), pickupCodeFormat)
+		return &order, nil
+	})
+
+	_, err := suite.useCase.Create(centro, orderDto)
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *OrderUseCaseSuite) TestCreateReturnsStoreClosedWithTheNextOpening() {
+	tomorrow := time.Now().In(centro.Location()).AddDate(0, 0, 1)
+	suite.storeRepo.EXPECT().GetHours(uint32(2)).Return([]entities.StoreHours{{Weekday: tomorrow.Weekday(), OpenTime: "10:00", CloseTime: "22:00"}}, nil)
+	suite.storeRepo.EXPECT().GetCalendar(uint32(2), gomock.Any()).Return(nil, nil)
+
+	createdOrder, err := suite.useCase.Create(centro, dto.OrderDto{CustomerName: "Maria", Items: []dto.OrderItemDto{{Id: 1, Quantity: 1}}})
+	assert.Nil(suite.T(), createdOrder)
+	assert.IsType(suite.T(), &custom_errors.StoreClosedError{}, err)
+
+	nextOpening := time.Date(tomorrow.Year(), tomorrow.Month(), tomorrow.Day(), 10, 0, 0, 0, centro.Location())
+	assert.True(suite.T(), nextOpening.Equal(*err.(*custom_errors.StoreClosedError).NextOpening))
+	assert.Equal(suite.T(), "store is closed, it opens again at "+nextOpening.Format(time.RFC3339), err.Error())
+}
+
+func (suite *OrderUseCaseSuite) TestCreateReturnsStoreClosedOnAHoliday() {
+	year, month, day := time.Now().In(centro.Location()).Date()
+	suite.storeRepo.EXPECT().GetHours(uint32(2)).Return(nil, nil)
+	suite.storeRepo.EXPECT().GetCalendar(uint32(2), gomock.Any()).Return([]entities.StoreCalendarDay{
+		{Date: time.Date(year, month, day, 0, 0, 0, 0, time.UTC), Closed: true, Description: "Natal"},
+	}, nil)
+
+	_, err := suite.useCase.Create(centro, dto.OrderDto{CustomerName: "Maria", Items: []dto.OrderItemDto{{Id: 1, Quantity: 1}}})
+	assert.IsType(suite.T(), &custom_errors.StoreClosedError{}, err)
+}
+
+func (suite *OrderUseCaseSuite) TestCreateReturnsErrorOnStoreRepositoryFailure() {
+	suite.storeRepo.EXPECT().GetHours(uint32(2)).Return(nil, errors.New("query error"))
+
+	_, err := suite.useCase.Create(centro, dto.OrderDto{CustomerName: "Maria", Items: []dto.OrderItemDto{{Id: 1, Quantity: 1}}})
+	assert.IsType(suite.T(), &custom_errors.DatabaseError{}, err)
+	assert.Equal(suite.T(), "get store hours from repository has failed", err.Error())
+}
+
+func (suite *OrderUseCaseSuite) TestCreateReturnsErrorOnUnknownCustomerAndItems() {
+	itemsDto := []dto.OrderItemDto{
+		{Id: 1, Quantity: 2},
+		{Id: 7, Quantity: 1},
+	}
+	orderDto := dto.OrderDto{CustomerID: &unknownCustomerID, Items: itemsDto}
+
+	suite.customerRepo.EXPECT().GetOne(entities.Customer{ID: 9}).Return(nil, gorm.ErrRecordNotFound)
+	suite.itemRepo.EXPECT().GetByIds([]uint32{1, 7}).Return([]entities.Item{{ID: 1, Price: 2800}}, nil)
+
+	createdOrder, err := suite.useCase.Create(matriz, orderDto)
+	assert.Nil(suite.T(), createdOrder)
+	assert.IsType(suite.T(), &custom_errors.BadRequestError{}, err)
+	assert.Equal(suite.T(), []custom_errors.ErrorDetail{
+		{Field: "customer_id", Message: "customer 9 not found"},
+		{Field: "items.1.id", Message: "item 7 not found"},
+	}, err.(*custom_errors.BadRequestError).Details)
+}
+
+func (suite *OrderUseCaseSuite) TestCreateReturnsErrorOnInvalidQuantity() {
+	itemsDto := []dto.OrderItemDto{
+		{Id: 1, Quantity: 0},
+		{Id: 2, Quantity: entities.ORDER_ITEM_MAX_QUANTITY + 1},
+	}
+	orderDto := dto.OrderDto{CustomerID: &registeredCustomerID, Items: itemsDto}
+
+	createdOrder, err := suite.useCase.Create(matriz, orderDto)
+	assert.Nil(suite.T(), createdOrder)
+	assert.IsType(suite.T(), &custom_errors.BadRequestError{}, err)
+	assert.Equal(suite.T(), []custom_errors.ErrorDetail{
+		{Field: "items.0.quantity", Message: "cannot be blank"},
+		{Field: "items.1.quantity", Message: "must be no greater than 20"},
+	}, err.(*custom_errors.BadRequestError).Details)
+}
+
+func (suite *OrderUseCaseSuite) TestCreateReturnsErrorOnCustomerRepositoryFailure() {
+	itemsDto := []dto.OrderItemDto{
+		{Id: 1, Quantity: 2},
+	}
+	orderDto := dto.OrderDto{CustomerID: &registeredCustomerID, Items: itemsDto}
+
+	suite.customerRepo.EXPECT().GetOne(entities.Customer{ID: 1}).Return(nil, errors.New("connection refused"))
+
+	createdOrder, err := suite.useCase.Create(matriz, orderDto)
+	assert.Nil(suite.T(), createdOrder)
+	assert.IsType(suite.T(), &custom_errors.DatabaseError{}, err)
+}
+
 func (suite *OrderUseCaseSuite) TestCreateReturnsErrorOnInvalidOrder() {
-	orderDto := dto.OrderDto{Status: "Pending", CustomerID: 1}
+	orderDto := dto.OrderDto{Status: "Pending", CustomerID: &registeredCustomerID}
 
-	createdOrder, err := suite.useCase.Create(orderDto)
+	createdOrder, err := suite.useCase.Create(matriz, orderDto)
 	assert.Error(suite.T(), err)
 	assert.Nil(suite.T(), createdOrder)
 	assert.Contains(suite.T(), err.Error(), "items: cannot be blank")
@@ -77,11 +746,14 @@ func (suite *OrderUseCaseSuite) TestCreateReturnsErrorOnRepositoryFailure() {
 	itemsDto := []dto.OrderItemDto{
 		{Id: 1, Quantity: 2},
 	}
-	orderDto := dto.OrderDto{Status: "Pending", CustomerID: 1, Items: itemsDto}
+	orderDto := dto.OrderDto{Status: "Pending", CustomerID: &registeredCustomerID, Items: itemsDto}
 
-	suite.repo.EXPECT().Create(gomock.Any()).Return(nil, errors.New("insert error"))
+	suite.customerRepo.EXPECT().GetOne(entities.Customer{ID: 1}).Return(&entities.Customer{ID: 1}, nil)
+	suite.itemRepo.EXPECT().GetByIds([]uint32{1}).Return([]entities.Item{{ID: 1, Price: 2800}}, nil)
+	suite.promotionRepo.EXPECT().GetAutomatic(matriz.ID, gomock.Any()).Return(nil, nil)
+	suite.repo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil, errors.New("insert error"))
 
-	createdOrder, err := suite.useCase.Create(orderDto)
+	createdOrder, err := suite.useCase.Create(matriz, orderDto)
 	assert.Error(suite.T(), err)
 	assert.Nil(suite.T(), createdOrder)
 	assert.Equal(suite.T(), "create order on repository has failed", err.Error())
@@ -89,28 +761,336 @@ func (suite *OrderUseCaseSuite) TestCreateReturnsErrorOnRepositoryFailure() {
 
 func (suite *OrderUseCaseSuite) TestUpdateStatus() {
 	items := []entities.OrderItem{
-		{ID: 1, Quantity: 2},
+		{ID: 1, ItemID: 1, Quantity: 2},
 	}
-	orderToUpdate := &entities.Order{ID: 1, Status: entities.IN_PREPARATION_STATUS, CustomerID: 1, Items: items}
-	orderAfterUpdate := &entities.Order{ID: 1, Status: entities.DONE_STATUS, CustomerID: 1, Items: items}
+	orderToUpdate := &entities.Order{ID: 1, Status: entities.IN_PREPARATION_STATUS, PaymentStatus: entities.PAYMENT_APPROVED_STATUS, CustomerID: &registeredCustomerID, Items: items}
+	orderAfterUpdate := &entities.Order{ID: 1, Status: entities.DONE_STATUS, PaymentStatus: entities.PAYMENT_APPROVED_STATUS, CustomerID: &registeredCustomerID, Items: items}
 
-	suite.repo.EXPECT().GetById(uint32(1)).Return(orderToUpdate, nil)
+	suite.repo.EXPECT().GetInStore(uint32(1), uint32(1)).Return(orderToUpdate, nil)
 	suite.repo.EXPECT().Update(uint32(1), gomock.Any()).Return(orderAfterUpdate, nil)
+	suite.eventRepo.EXPECT().Publish(gomock.Any()).DoAndReturn(func(event entities.OrderEvent) error {
+		assert.Equal(suite.T(), entities.ORDER_STATUS_CHANGED_EVENT, event.Type)
+		return nil
+	})
 
-	updatedOrder, err := suite.useCase.UpdateStatus(1, entities.DONE_STATUS)
+	updatedOrder, err := suite.useCase.UpdateStatus(matriz, 1, dto.OrderStatusDto{Status: entities.DONE_STATUS})
 	assert.NoError(suite.T(), err)
 	assert.Equal(suite.T(), orderAfterUpdate, updatedOrder)
+	assert.NotNil(suite.T(), orderToUpdate.ReadyAt)
+}
+
+func (suite *OrderUseCaseSuite) TestUpdateStatusIgnoresEventPublishingFailure() {
+	items := []entities.OrderItem{
+		{ID: 1, ItemID: 1, Quantity: 2},
+	}
+	orderToUpdate := &entities.Order{ID: 1, Status: entities.IN_PREPARATION_STATUS, PaymentStatus: entities.PAYMENT_APPROVED_STATUS, CustomerID: &registeredCustomerID, Items: items}
+	orderAfterUpdate := &entities.Order{ID: 1, Status: entities.DONE_STATUS, PaymentStatus: entities.PAYMENT_APPROVED_STATUS, CustomerID: &registeredCustomerID, Items: items}
+
+	suite.repo.EXPECT().GetInStore(uint32(1), uint32(1)).Return(orderToUpdate, nil)
+	suite.repo.EXPECT().Update(uint32(1), gomock.Any()).Return(orderAfterUpdate, nil)
+	suite.eventRepo.EXPECT().Publish(gomock.Any()).Return(errors.New("notify error"))
+
+	updatedOrder, err := suite.useCase.UpdateStatus(matriz, 1, dto.OrderStatusDto{Status: entities.DONE_STATUS})
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), orderAfterUpdate, updatedOrder)
+}
+
+func (suite *OrderUseCaseSuite) TestUpdateStatusReturnsConflictWhenOrderChangedMeanwhile() {
+	items := []entities.OrderItem{
+		{ID: 1, ItemID: 1, Quantity: 2},
+	}
+	orderToUpdate := &entities.Order{ID: 1, Status: entities.RECEIVED_STATUS, PaymentStatus: entities.PAYMENT_APPROVED_STATUS, CustomerID: &registeredCustomerID, Items: items}
+
+	suite.repo.EXPECT().GetInStore(uint32(1), uint32(1)).Return(orderToUpdate, nil)
+	suite.repo.EXPECT().Update(uint32(1), gomock.Any()).Return(nil, entities.ErrOrderChanged)
+
+	updatedOrder, err := suite.useCase.UpdateStatus(matriz, 1, dto.OrderStatusDto{Status: entities.IN_PREPARATION_STATUS})
+	assert.Nil(suite.T(), updatedOrder)
+	assert.IsType(suite.T(), &custom_errors.ConflictError{}, err)
 }
 
 func (suite *OrderUseCaseSuite) TestUpdateStatusReturnsErrorOnOrderNotFound() {
-	suite.repo.EXPECT().GetById(uint32(1)).Return(nil, errors.New("order not found"))
+	suite.repo.EXPECT().GetInStore(uint32(1), uint32(1)).Return(nil, errors.New("order not found"))
 
-	updatedOrder, err := suite.useCase.UpdateStatus(1, entities.DONE_STATUS)
+	updatedOrder, err := suite.useCase.UpdateStatus(matriz, 1, dto.OrderStatusDto{Status: entities.DONE_STATUS})
 	assert.Error(suite.T(), err)
 	assert.Nil(suite.T(), updatedOrder)
 	assert.Equal(suite.T(), "order not found", err.Error())
 }
 
+func (suite *OrderUseCaseSuite) TestUpdateStatusReturnsConflictOnInvalidTransition() {
+	items := []entities.OrderItem{
+		{ID: 1, ItemID: 1, Quantity: 2},
+	}
+	orderToUpdate := &entities.Order{ID: 1, Status: entities.RECEIVED_STATUS, CustomerID: &registeredCustomerID, Items: items}
+
+	suite.repo.EXPECT().GetInStore(uint32(1), uint32(1)).Return(orderToUpdate, nil)
+
+	updatedOrder, err := suite.useCase.UpdateStatus(matriz, 1, dto.OrderStatusDto{Status: entities.FINISHED_STATUS})
+	assert.Nil(suite.T(), updatedOrder)
+	assert.IsType(suite.T(), &custom_errors.ConflictError{}, err)
+	assert.Equal(suite.T(), "order status cannot change from RECEBIDO to FINALIZADO", err.Error())
+}
+
+func (suite *OrderUseCaseSuite) TestUpdateStatusReturnsBadRequestOnUnknownStatus() {
+	orderToUpdate := &entities.Order{ID: 1, Status: entities.RECEIVED_STATUS, CustomerID: &registeredCustomerID}
+
+	suite.repo.EXPECT().GetInStore(uint32(1), uint32(1)).Return(orderToUpdate, nil)
+
+	updatedOrder, err := suite.useCase.UpdateStatus(matriz, 1, dto.OrderStatusDto{Status: "INVALID_STATUS"})
+	assert.Nil(suite.T(), updatedOrder)
+	assert.IsType(suite.T(), &custom_errors.BadRequestError{}, err)
+}
+
+func (suite *OrderUseCaseSuite) TestUpdateStatusReturnsBadRequestOnCancelStatus() {
+	orderToUpdate := &entities.Order{ID: 1, Status: entities.RECEIVED_STATUS, CustomerID: &registeredCustomerID}
+
+	suite.repo.EXPECT().GetInStore(uint32(1), uint32(1)).Return(orderToUpdate, nil)
+
+	updatedOrder, err := suite.useCase.UpdateStatus(matriz, 1, dto.OrderStatusDto{Status: entities.CANCELED_STATUS})
+	assert.Nil(suite.T(), updatedOrder)
+	assert.IsType(suite.T(), &custom_errors.BadRequestError{}, err)
+}
+
+func (suite *OrderUseCaseSuite) TestCancel() {
+	items := []entities.OrderItem{
+		{ID: 1, ItemID: 1, Quantity: 2},
+	}
+	orderToCancel := &entities.Order{ID: 1, Status: entities.IN_PREPARATION_STATUS, CustomerID: &registeredCustomerID, Items: items}
+	cancelDto := dto.OrderCancelDto{CanceledBy: "atendente", Reason: "cliente desistiu"}
+
+	suite.repo.EXPECT().GetInStore(uint32(1), uint32(1)).Return(orderToCancel, nil)
+	suite.repo.EXPECT().Cancel(uint32(1), gomock.Any()).DoAndReturn(func(id uint32, order entities.Order) (*entities.Order, error) {
+		return &order, nil
+	})
+	suite.eventRepo.EXPECT().Publish(gomock.Any()).DoAndReturn(func(event entities.OrderEvent) error {
+		assert.Equal(suite.T(), entities.ORDER_STATUS_CHANGED_EVENT, event.Type)
+		return nil
+	})
+
+	canceledOrder, err := suite.useCase.Cancel(matriz, 1, cancelDto)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), entities.CANCELED_STATUS, canceledOrder.Status)
+	assert.Equal(suite.T(), "atendente", canceledOrder.CanceledBy)
+	assert.Equal(suite.T(), "cliente desistiu", canceledOrder.CancellationReason)
+	assert.NotNil(suite.T(), canceledOrder.CanceledAt)
+}
+
+func (suite *OrderUseCaseSuite) TestCancelReturnsNotFoundOnUnknownOrder() {
+	cancelDto := dto.OrderCancelDto{CanceledBy: "atendente", Reason: "cliente desistiu"}
+
+	suite.repo.EXPECT().GetInStore(uint32(1), uint32(9)).Return(nil, gorm.ErrRecordNotFound)
+
+	canceledOrder, err := suite.useCase.Cancel(matriz, 9, cancelDto)
+	assert.Nil(suite.T(), canceledOrder)
+	assert.IsType(suite.T(), &custom_errors.NotFoundError{}, err)
+}
+
+func (suite *OrderUseCaseSuite) TestCancelReturnsErrorOnRepositoryFailure() {
+	cancelDto := dto.OrderCancelDto{CanceledBy: "atendente", Reason: "cliente desistiu"}
+
+	suite.repo.EXPECT().GetInStore(uint32(1), uint32(1)).Return(nil, errors.New("select error"))
+
+	canceledOrder, err := suite.useCase.Cancel(matriz, 1, cancelDto)
+	assert.Nil(suite.T(), canceledOrder)
+	assert.IsType(suite.T(), &custom_errors.DatabaseError{}, err)
+}
+
+func (suite *OrderUseCaseSuite) TestCancelReturnsConflictWhenOrderIsDone() {
+	orderToCancel := &entities.Order{ID: 1, Status: entities.DONE_STATUS, CustomerID: &registeredCustomerID}
+	cancelDto := dto.OrderCancelDto{CanceledBy: "atendente", Reason: "cliente desistiu"}
+
+	suite.repo.EXPECT().GetInStore(uint32(1), uint32(1)).Return(orderToCancel, nil)
+
+	canceledOrder, err := suite.useCase.Cancel(matriz, 1, cancelDto)
+	assert.Nil(suite.T(), canceledOrder)
+	assert.IsType(suite.T(), &custom_errors.ConflictError{}, err)
+}
+
+func (suite *OrderUseCaseSuite) TestCancelReturnsConflictWhenOrderChangedMeanwhile() {
+	orderToCancel := &entities.Order{ID: 1, Status: entities.IN_PREPARATION_STATUS, CustomerID: &registeredCustomerID}
+	cancelDto := dto.OrderCancelDto{CanceledBy: "atendente", Reason: "cliente desistiu"}
+
+	suite.repo.EXPECT().GetInStore(uint32(1), uint32(1)).Return(orderToCancel, nil)
+	suite.repo.EXPECT().Cancel(uint32(1), gomock.Any()).Return(nil, entities.ErrOrderChanged)
+
+	canceledOrder, err := suite.useCase.Cancel(matriz, 1, cancelDto)
+	assert.Nil(suite.T(), canceledOrder)
+	assert.IsType(suite.T(), &custom_errors.ConflictError{}, err)
+}
+
+func (suite *OrderUseCaseSuite) TestCancelReturnsBadRequestOnMissingReason() {
+	orderToCancel := &entities.Order{ID: 1, Status: entities.RECEIVED_STATUS, CustomerID: &registeredCustomerID}
+	cancelDto := dto.OrderCancelDto{CanceledBy: "atendente"}
+
+	suite.repo.EXPECT().GetInStore(uint32(1), uint32(1)).Return(orderToCancel, nil)
+
+	canceledOrder, err := suite.useCase.Cancel(matriz, 1, cancelDto)
+	assert.Nil(suite.T(), canceledOrder)
+	assert.IsType(suite.T(), &custom_errors.BadRequestError{}, err)
+}
+
+func (suite *OrderUseCaseSuite) TestUpdateStatusReturnsConflictOnUnpaidOrder() {
+	orderToUpdate := &entities.Order{ID: 1, Status: entities.RECEIVED_STATUS, PaymentStatus: entities.PAYMENT_PENDING_STATUS, CustomerID: &registeredCustomerID}
+
+	suite.repo.EXPECT().GetInStore(uint32(1), uint32(1)).Return(orderToUpdate, nil)
+
+	updatedOrder, err := suite.useCase.UpdateStatus(matriz, 1, dto.OrderStatusDto{Status: entities.IN_PREPARATION_STATUS})
+	assert.Nil(suite.T(), updatedOrder)
+	assert.IsType(suite.T(), &custom_errors.ConflictError{}, err)
+	assert.Equal(suite.T(), "order cannot be prepared before its payment is approved", err.Error())
+}
+
+func (suite *OrderUseCaseSuite) TestUpdatePaymentStatus() {
+	orderToUpdate := &entities.Order{ID: 1, Status: entities.RECEIVED_STATUS, PaymentStatus: entities.PAYMENT_PENDING_STATUS, CustomerID: &registeredCustomerID}
+
+	suite.repo.EXPECT().GetById(uint32(1)).Return(orderToUpdate, nil)
+	suite.repo.EXPECT().Update(uint32(1), gomock.Any()).DoAndReturn(func(id uint32, order entities.Order) (*entities.Order, error) {
+		return &order, nil
+	})
+	suite.eventRepo.EXPECT().Publish(gomock.Any()).DoAndReturn(func(event entities.OrderEvent) error {
+		assert.Equal(suite.T(), entities.ORDER_CREATED_EVENT, event.Type)
+		return nil
+	})
+
+	updatedOrder, err := suite.useCase.UpdatePaymentStatus(1, "aprovado")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), entities.PAYMENT_APPROVED_STATUS, updatedOrder.PaymentStatus)
+}
+
+func (suite *OrderUseCaseSuite) TestUpdatePaymentStatusKeepsOrderCanceledWhilePendingOffKitchenBoards() {
+	pendingOrder := &entities.Order{ID: 1, StoreID: matriz.ID, Status: entities.RECEIVED_STATUS, PaymentStatus: entities.PAYMENT_PENDING_STATUS, CustomerID: &registeredCustomerID}
+	cancelDto := dto.OrderCancelDto{CanceledBy: "atendente", Reason: "cliente desistiu"}
+
+	var canceledOrder *entities.Order
+	suite.repo.EXPECT().GetInStore(matriz.ID, uint32(1)).Return(pendingOrder, nil)
+	suite.repo.EXPECT().Cancel(uint32(1), gomock.Any()).DoAndReturn(func(id uint32, order entities.Order) (*entities.Order, error) {
+		canceledOrder = &order
+		return &order, nil
+	})
+	suite.repo.EXPECT().GetById(uint32(1)).DoAndReturn(func(id uint32) (*entities.Order, error) {
+		return canceledOrder, nil
+	})
+	suite.repo.EXPECT().Update(uint32(1), gomock.Any()).DoAndReturn(func(id uint32, order entities.Order) (*entities.Order, error) {
+		return &order, nil
+	})
+	gomock.InOrder(
+		suite.eventRepo.EXPECT().Publish(gomock.Any()).DoAndReturn(func(event entities.OrderEvent) error {
+			assert.Equal(suite.T(), entities.ORDER_STATUS_CHANGED_EVENT, event.Type)
+			return nil
+		}),
+		suite.eventRepo.EXPECT().Publish(gomock.Any()).DoAndReturn(func(event entities.OrderEvent) error {
+			assert.Equal(suite.T(), entities.ORDER_PAYMENT_STATUS_CHANGED_EVENT, event.Type)
+			return nil
+		}),
+	)
+
+	_, err := suite.useCase.Cancel(matriz, 1, cancelDto)
+	assert.NoError(suite.T(), err)
+
+	updatedOrder, err := suite.useCase.UpdatePaymentStatus(1, "aprovado")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), entities.PAYMENT_APPROVED_STATUS, updatedOrder.PaymentStatus)
+	assert.Equal(suite.T(), entities.CANCELED_STATUS, updatedOrder.Status)
+}
+
+func (suite *OrderUseCaseSuite) TestUpdatePaymentStatusCancelsOrderOnRejectedPayment() {
+	orderToUpdate := &entities.Order{ID: 1, Status: entities.RECEIVED_STATUS, PaymentStatus: entities.PAYMENT_PENDING_STATUS, CustomerID: &registeredCustomerID}
+
+	suite.repo.EXPECT().GetById(uint32(1)).Return(orderToUpdate, nil)
+	suite.repo.EXPECT().Cancel(uint32(1), gomock.Any()).DoAndReturn(func(id uint32, order entities.Order) (*entities.Order, error) {
+		assert.Equal(suite.T(), entities.PAYMENT_PENDING_STATUS, order.PreviousPaymentStatus())
+		assert.Equal(suite.T(), entities.RECEIVED_STATUS, order.PreviousStatus())
+		assert.Equal(suite.T(), "payment rejected", order.CancellationReason)
+		return &order, nil
+	})
+	suite.eventRepo.EXPECT().Publish(gomock.Any()).DoAndReturn(func(event entities.OrderEvent) error {
+		assert.Equal(suite.T(), entities.ORDER_PAYMENT_STATUS_CHANGED_EVENT, event.Type)
+		return nil
+	})
+
+	updatedOrder, err := suite.useCase.UpdatePaymentStatus(1, "recusado")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), entities.PAYMENT_REJECTED_STATUS, updatedOrder.PaymentStatus)
+	assert.Equal(suite.T(), entities.CANCELED_STATUS, updatedOrder.Status)
+}
+
+func (suite *OrderUseCaseSuite) TestUpdatePaymentStatusOnlyRecordsExpiredPaymentOfCanceledOrder() {
+	orderToUpdate := &entities.Order{ID: 1, Status: entities.CANCELED_STATUS, PaymentStatus: entities.PAYMENT_PENDING_STATUS, CustomerID: &registeredCustomerID}
+
+	suite.repo.EXPECT().GetById(uint32(1)).Return(orderToUpdate, nil)
+	suite.repo.EXPECT().Update(uint32(1), gomock.Any()).DoAndReturn(func(id uint32, order entities.Order) (*entities.Order, error) {
+		return &order, nil
+	})
+	suite.eventRepo.EXPECT().Publish(gomock.Any()).Return(nil)
+
+	updatedOrder, err := suite.useCase.UpdatePaymentStatus(1, "expirado")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), entities.PAYMENT_EXPIRED_STATUS, updatedOrder.PaymentStatus)
+	assert.Empty(suite.T(), updatedOrder.StatusChanges)
+}
+
+func (suite *OrderUseCaseSuite) TestUpdatePaymentStatusIgnoresRepeatedNotification() {
+	orderToUpdate := &entities.Order{ID: 1, Status: entities.RECEIVED_STATUS, PaymentStatus: entities.PAYMENT_APPROVED_STATUS, CustomerID: &registeredCustomerID}
+
+	suite.repo.EXPECT().GetById(uint32(1)).Return(orderToUpdate, nil)
+
+	updatedOrder, err := suite.useCase.UpdatePaymentStatus(1, entities.PAYMENT_APPROVED_STATUS)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), orderToUpdate, updatedOrder)
+}
+
+func (suite *OrderUseCaseSuite) TestUpdatePaymentStatusReturnsConflictOnSettledPayment() {
+	orderToUpdate := &entities.Order{ID: 1, Status: entities.RECEIVED_STATUS, PaymentStatus: entities.PAYMENT_REJECTED_STATUS, CustomerID: &registeredCustomerID}
+
+	suite.repo.EXPECT().GetById(uint32(1)).Return(orderToUpdate, nil)
+
+	updatedOrder, err := suite.useCase.UpdatePaymentStatus(1, entities.PAYMENT_APPROVED_STATUS)
+	assert.Nil(suite.T(), updatedOrder)
+	assert.IsType(suite.T(), &custom_errors.ConflictError{}, err)
+}
+
+func (suite *OrderUseCaseSuite) TestUpdatePaymentStatusReturnsNotFoundOnUnknownOrder() {
+	suite.repo.EXPECT().GetById(uint32(9)).Return(nil, gorm.ErrRecordNotFound)
+
+	updatedOrder, err := suite.useCase.UpdatePaymentStatus(9, entities.PAYMENT_APPROVED_STATUS)
+	assert.Nil(suite.T(), updatedOrder)
+	assert.IsType(suite.T(), &custom_errors.NotFoundError{}, err)
+}
+
+func (suite *OrderUseCaseSuite) TestUpdatePaymentStatusReturnsErrorOnRepositoryFailure() {
+	suite.repo.EXPECT().GetById(uint32(1)).Return(nil, errors.New("select error"))
+
+	updatedOrder, err := suite.useCase.UpdatePaymentStatus(1, entities.PAYMENT_APPROVED_STATUS)
+	assert.Nil(suite.T(), updatedOrder)
+	assert.IsType(suite.T(), &custom_errors.DatabaseError{}, err)
+}
+
+func (suite *OrderUseCaseSuite) TestUpdatePaymentStatusReturnsBadRequestOnUnknownStatus() {
+	updatedOrder, err := suite.useCase.UpdatePaymentStatus(1, "PAGO")
+	assert.Nil(suite.T(), updatedOrder)
+	assert.IsType(suite.T(), &custom_errors.BadRequestError{}, err)
+}
+
 func TestOrderUseCaseSuite(t *testing.T) {
 	suite.Run(t, new(OrderUseCaseSuite))
 }
+
+// centro is a store in another time zone than the matriz, with its own pickup codes.
+var centro = entities.Store{ID: 2, Name: "Centro", TimeZone: "Asia/Tokyo", PickupCodePrefix: "C", Active: true}
+
+func classicCombo() entities.Combo {
+	return entities.Combo{ID: 7, Name: "Combo Classico", PricingRule: entities.COMBO_FIXED_PRICE, Price: 3500, Slots: []entities.ComboSlot{
+		{Category: "LANCHE", Items: []entities.ComboSlotItem{{ItemID: 1}}},
+		{Category: "ACOMPANHAMENTO", Items: []entities.ComboSlotItem{{ItemID: 2}}},
+		{Category: "BEBIDA", Items: []entities.ComboSlotItem{{ItemID: 3}}},
+	}}
+}
+
+func comboItems() []entities.Item {
+	return []entities.Item{
+		{ID: 1, Name: "X-Burguer", Category: "LANCHE", Price: 2800},
+		{ID: 2, Name: "Batata frita", Category: "ACOMPANHAMENTO", Price: 1200},
+		{ID: 3, Name: "Refrigerante", Category: "BEBIDA", Price: 790},
+	}
+}
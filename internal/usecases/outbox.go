@@ -0,0 +1,85 @@
+package usecases
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/8soat-grupo35/fastfood-order/internal/adapters/dto"
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+	"github.com/8soat-grupo35/fastfood-order/internal/interfaces/repository"
+	"github.com/8soat-grupo35/fastfood-order/internal/interfaces/usecase"
+	"log"
+	"time"
+
+	custom_errors "github.com/8soat-grupo35/fastfood-order/internal/api/errors"
+)
+
+// outboxLease is how long a claimed message stays hidden from other dispatchers while it is being delivered.
+const outboxLease = time.Minute
+
+type outboxUseCase struct {
+	outboxRepository       repository.OutboxRepository
+	orderPaymentRepository repository.OrderPaymentRepository
+	batchSize              int
+}
+
+func NewOutboxUseCase(outboxRepository repository.OutboxRepository, orderPaymentRepository repository.OrderPaymentRepository, batchSize int) usecase.OutboxUseCase {
+	return &outboxUseCase{
+		outboxRepository:       outboxRepository,
+		orderPaymentRepository: orderPaymentRepository,
+		batchSize:              batchSize,
+	}
+}
+
+func (o *outboxUseCase) Dispatch() error {
+	messages, err := o.outboxRepository.ClaimPending(o.batchSize, outboxLease)
+
+	if err != nil {
+		return &custom_errors.DatabaseError{
+			Message: "claim outbox messages from repository has failed",
+		}
+	}
+
+	for _, message := range messages {
+		err = o.deliver(message)
+
+		if err != nil {
+			log.Printf("outbox message %d delivery has failed: %s", message.ID, err.Error())
+			message.MarkFailed(err)
+		} else {
+			message.MarkSent()
+		}
+
+		err = o.outboxRepository.Update(message)
+
+		if err != nil {
+			log.Printf("outbox message %d status update has failed: %s", message.ID, err.Error())
+		}
+	}
+
+	return nil
+}
+
+func (o *outboxUseCase) deliver(message entities.OutboxMessage) error {
+	switch message.EventType {
+	case entities.PAYMENT_REQUESTED_EVENT:
+		orderPayment := dto.OrderPaymentDto{}
+
+		err := json.Unmarshal([]byte(message.Payload), &orderPayment)
+		if err != nil {
+			return err
+		}
+
+		return o.orderPaymentRepository.Create(orderPayment)
+	case entities.PAYMENT_REVERSAL_EVENT:
+		orderPayment := dto.OrderPaymentDto{}
+
+		err := json.Unmarshal([]byte(message.Payload), &orderPayment)
+		if err != nil {
+			return err
+		}
+
+		return o.orderPaymentRepository.Reverse(orderPayment)
+	default:
+		return fmt.Errorf("unknown outbox event type %s", message.EventType)
+	}
+}
@@ -0,0 +1,102 @@
+package usecases
+
+import (
+	"errors"
+	"github.com/8soat-grupo35/fastfood-order/internal/adapters/dto"
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+	mockRepository "github.com/8soat-grupo35/fastfood-order/internal/interfaces/repository/mock"
+	"github.com/8soat-grupo35/fastfood-order/internal/interfaces/usecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+	"testing"
+)
+
+type OutboxUseCaseSuite struct {
+	suite.Suite
+	ctrl        *gomock.Controller
+	repo        *mockRepository.MockOutboxRepository
+	paymentRepo *mockRepository.MockOrderPaymentRepository
+	useCase     usecase.OutboxUseCase
+}
+
+func (suite *OutboxUseCaseSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.repo = mockRepository.NewMockOutboxRepository(suite.ctrl)
+	suite.paymentRepo = mockRepository.NewMockOrderPaymentRepository(suite.ctrl)
+	suite.useCase = NewOutboxUseCase(suite.repo, suite.paymentRepo, 10)
+}
+
+func (suite *OutboxUseCaseSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func (suite *OutboxUseCaseSuite) TestDispatch() {
+	message, _ := entities.NewPaymentRequestedMessage(entities.Order{ID: 1, Total: 5600})
+
+	suite.repo.EXPECT().ClaimPending(10, gomock.Any()).Return([]entities.OutboxMessage{*message}, nil)
+	suite.paymentRepo.EXPECT().Create(dto.OrderPaymentDto{OrderID: 1, Amount: 56}).Return(nil)
+	suite.repo.EXPECT().Update(gomock.Any()).DoAndReturn(func(message entities.OutboxMessage) error {
+		assert.Equal(suite.T(), entities.OUTBOX_SENT_STATUS, message.Status)
+		assert.NotNil(suite.T(), message.SentAt)
+		return nil
+	})
+
+	err := suite.useCase.Dispatch()
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *OutboxUseCaseSuite) TestDispatchReversesPayment() {
+	message, _ := entities.NewPaymentReversalMessage(entities.Order{ID: 1, Total: 5600})
+
+	suite.repo.EXPECT().ClaimPending(10, gomock.Any()).Return([]entities.OutboxMessage{*message}, nil)
+	suite.paymentRepo.EXPECT().Reverse(dto.OrderPaymentDto{OrderID: 1}).Return(nil)
+	suite.repo.EXPECT().Update(gomock.Any()).DoAndReturn(func(message entities.OutboxMessage) error {
+		assert.Equal(suite.T(), entities.OUTBOX_SENT_STATUS, message.Status)
+		return nil
+	})
+
+	err := suite.useCase.Dispatch()
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *OutboxUseCaseSuite) TestDispatchSchedulesRetryOnDeliveryFailure() {
+	message, _ := entities.NewPaymentRequestedMessage(entities.Order{ID: 1, Total: 5600})
+
+	suite.repo.EXPECT().ClaimPending(10, gomock.Any()).Return([]entities.OutboxMessage{*message}, nil)
+	suite.paymentRepo.EXPECT().Create(gomock.Any()).Return(errors.New("unexpected status code: 503"))
+	suite.repo.EXPECT().Update(gomock.Any()).DoAndReturn(func(message entities.OutboxMessage) error {
+		assert.Equal(suite.T(), entities.OUTBOX_PENDING_STATUS, message.Status)
+		assert.Equal(suite.T(), uint32(1), message.Attempts)
+		assert.Equal(suite.T(), "unexpected status code: 503", message.LastError)
+		return nil
+	})
+
+	err := suite.useCase.Dispatch()
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *OutboxUseCaseSuite) TestDispatchFailsUnknownEventType() {
+	message := entities.OutboxMessage{ID: 1, EventType: "unknown", Status: entities.OUTBOX_PENDING_STATUS}
+
+	suite.repo.EXPECT().ClaimPending(10, gomock.Any()).Return([]entities.OutboxMessage{message}, nil)
+	suite.repo.EXPECT().Update(gomock.Any()).DoAndReturn(func(message entities.OutboxMessage) error {
+		assert.Equal(suite.T(), "unknown outbox event type unknown", message.LastError)
+		return nil
+	})
+
+	err := suite.useCase.Dispatch()
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *OutboxUseCaseSuite) TestDispatchReturnsErrorOnRepositoryFailure() {
+	suite.repo.EXPECT().ClaimPending(10, gomock.Any()).Return(nil, errors.New("query error"))
+
+	err := suite.useCase.Dispatch()
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), "claim outbox messages from repository has failed", err.Error())
+}
+
+func TestOutboxUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(OutboxUseCaseSuite))
+}
@@ -0,0 +1,189 @@
+package usecases
+
+import (
+	"errors"
+	"github.com/8soat-grupo35/fastfood-order/internal/adapters/dto"
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+	"github.com/8soat-grupo35/fastfood-order/internal/interfaces/repository"
+	"github.com/8soat-grupo35/fastfood-order/internal/interfaces/usecase"
+	"log"
+
+	custom_errors "github.com/8soat-grupo35/fastfood-order/internal/api/errors"
+	"gorm.io/gorm"
+)
+
+type promotionService struct {
+	promotionRepository repository.PromotionRepository
+	categoryRepository  repository.CategoryRepository
+}
+
+func NewPromotionUseCase(promotionRepository repository.PromotionRepository, categoryRepository repository.CategoryRepository) usecase.PromotionUseCase {
+	return &promotionService{
+		promotionRepository: promotionRepository,
+		categoryRepository:  categoryRepository,
+	}
+}
+
+func (service *promotionService) GetAll(store entities.Store) ([]entities.Promotion, error) {
+	promotions, err := service.promotionRepository.GetAll(store.ID)
+
+	if err != nil {
+		return []entities.Promotion{}, &custom_errors.DatabaseError{
+			Message: "get promotion from repository has failed",
+		}
+	}
+
+	return promotions, nil
+}
+
+func (service *promotionService) Create(store entities.Store, promotion dto.PromotionDto) (*entities.Promotion, error) {
+	newPromotion, err := entities.NewPromotion(promotion)
+
+	if err != nil {
+		return nil, custom_errors.NewValidationError(err)
+	}
+
+	if err = service.checkCategory(*newPromotion); err != nil {
+		return nil, err
+	}
+
+	if err = service.checkCodeAvailable(store, *newPromotion, 0); err != nil {
+		return nil, err
+	}
+
+	newPromotion.StoreID = store.ID
+	promotionSaved, err := service.promotionRepository.Create(*newPromotion)
+
+	if err != nil {
+		return nil, errors.New("create promotion on repository has failed")
+	}
+
+	return promotionSaved, nil
+}
+
+func (service *promotionService) Update(store entities.Store, promotionId uint32, promotion dto.PromotionDto) (*entities.Promotion, error) {
+	promotionToUpdate, err := entities.NewPromotion(promotion)
+
+	if err != nil {
+		return nil, custom_errors.NewValidationError(err)
+	}
+
+	_, err = service.promotionRepository.GetOne(entities.Promotion{ID: promotionId, StoreID: store.ID})
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, &custom_errors.NotFoundError{
+			Message: "promotion not found to update",
+		}
+	}
+
+	if err != nil {
+		log.Println(err.Error())
+		return nil, &custom_errors.DatabaseError{
+			Message: "error on obtain promotion to update in repository",
+		}
+	}
+
+	if err = service.checkCategory(*promotionToUpdate); err != nil {
+		return nil, err
+	}
+
+	if err = service.checkCodeAvailable(store, *promotionToUpdate, promotionId); err != nil {
+		return nil, err
+	}
+
+	promotionToUpdate.StoreID = store.ID
+	promotionUpdated, err := service.promotionRepository.Update(store.ID, promotionId, *promotionToUpdate)
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, &custom_errors.NotFoundError{
+			Message: "promotion not found to update",
+		}
+	}
+
+	if err != nil {
+		return nil, &custom_errors.DatabaseError{
+			Message: "updated promotion on repository has failed",
+		}
+	}
+
+	return promotionUpdated, nil
+}
+
+func (service *promotionService) Delete(store entities.Store, promotionId uint32) error {
+	_, err := service.promotionRepository.GetOne(entities.Promotion{ID: promotionId, StoreID: store.ID})
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &custom_errors.NotFoundError{
+			Message: "promotion not found to delete",
+		}
+	}
+
+	if err != nil {
+		log.Println(err.Error())
+		return &custom_errors.DatabaseError{
+			Message: "error on obtain promotion to delete in repository",
+		}
+	}
+
+	err = service.promotionRepository.Delete(store.ID, promotionId)
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &custom_errors.NotFoundError{
+			Message: "promotion not found to delete",
+		}
+	}
+
+	if err != nil {
+		return &custom_errors.DatabaseError{
+			Message: "error on delete in repository",
+		}
+	}
+
+	return nil
+}
+
+// checkCategory rejects a category no registered category has.
+func (service *promotionService) checkCategory(promotion entities.Promotion) error {
+	if promotion.Category == "" {
+		return nil
+	}
+
+	category, err := findCategory(service.categoryRepository, promotion.Category)
+
+	if err != nil {
+		return err
+	}
+
+	if err = promotion.ValidateCategory(category); err != nil {
+		return custom_errors.NewValidationError(err)
+	}
+
+	return nil
+}
+
+// checkCodeAvailable rejects a coupon code already used by another promotion of the store.
+func (service *promotionService) checkCodeAvailable(store entities.Store, promotion entities.Promotion, promotionId uint32) error {
+	if !promotion.IsCoupon() {
+		return nil
+	}
+
+	existing, err := service.promotionRepository.GetByCode(store.ID, promotion.Code)
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return &custom_errors.DatabaseError{
+			Message: "get promotion by code from repository has failed",
+		}
+	}
+
+	if existing.ID != promotionId {
+		return &custom_errors.ConflictError{
+			Message: "coupon code already in use",
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,183 @@
+package usecases
+
+import (
+	"errors"
+	"github.com/8soat-grupo35/fastfood-order/internal/adapters/dto"
+	custom_errors "github.com/8soat-grupo35/fastfood-order/internal/api/errors"
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+	mockRepository "github.com/8soat-grupo35/fastfood-order/internal/interfaces/repository/mock"
+	"github.com/8soat-grupo35/fastfood-order/internal/interfaces/usecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+	"testing"
+)
+
+type PromotionUseCaseSuite struct {
+	suite.Suite
+	ctrl         *gomock.Controller
+	repo         *mockRepository.MockPromotionRepository
+	categoryRepo *mockRepository.MockCategoryRepository
+	useCase      usecase.PromotionUseCase
+}
+
+func (suite *PromotionUseCaseSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.repo = mockRepository.NewMockPromotionRepository(suite.ctrl)
+	suite.categoryRepo = mockRepository.NewMockCategoryRepository(suite.ctrl)
+	suite.useCase = NewPromotionUseCase(suite.repo, suite.categoryRepo)
+}
+
+func (suite *PromotionUseCaseSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func welcomeCouponDto() dto.PromotionDto {
+	return dto.PromotionDto{
+		Name:           "Bem-vindo",
+		Code:           "bemvindo10",
+		Kind:           "valor_fixo",
+		Amount:         10,
+		MinimumTotal:   30,
+		FirstOrderOnly: true,
+	}
+}
+
+func (suite *PromotionUseCaseSuite) TestGetAll() {
+	expectedPromotions := []entities.Promotion{{ID: 1, Name: "Bem-vindo"}}
+
+	suite.repo.EXPECT().GetAll(matriz.ID).Return(expectedPromotions, nil)
+
+	promotions, err := suite.useCase.GetAll(matriz)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedPromotions, promotions)
+}
+
+func (suite *PromotionUseCaseSuite) TestGetAllReturnsErrorOnRepositoryFailure() {
+	suite.repo.EXPECT().GetAll(matriz.ID).Return(nil, errors.New("query error"))
+
+	promotions, err := suite.useCase.GetAll(matriz)
+	assert.Empty(suite.T(), promotions)
+	assert.IsType(suite.T(), &custom_errors.DatabaseError{}, err)
+}
+
+func (suite *PromotionUseCaseSuite) TestCreate() {
+	expectedPromotion := entities.Promotion{ID: 1, Code: "BEMVINDO10"}
+
+	suite.repo.EXPECT().GetByCode(matriz.ID, "BEMVINDO10").Return(nil, gorm.ErrRecordNotFound)
+	suite.repo.EXPECT().Create(gomock.Any()).DoAndReturn(func(promotion entities.Promotion) (*entities.Promotion, error) {
+		assert.Equal(suite.T(), entities.PROMOTION_FIXED_AMOUNT, promotion.Kind)
+		assert.Equal(suite.T(), "BEMVINDO10", promotion.Code)
+		assert.Equal(suite.T(), matriz.ID, promotion.StoreID)
+		return &expectedPromotion, nil
+	})
+
+	promotion, err := suite.useCase.Create(matriz, welcomeCouponDto())
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), &expectedPromotion, promotion)
+}
+
+func (suite *PromotionUseCaseSuite) TestCreateAutomaticPromotionDoesNotCheckTheCode() {
+	promotionDto := dto.PromotionDto{Name: "Bebidas 10%", Kind: "percentual_categoria", Category: "bebida", PercentageOff: 10}
+
+	suite.categoryRepo.EXPECT().GetOne(entities.Category{Name: "BEBIDA"}).Return(&entities.Category{ID: 3, Name: "BEBIDA"}, nil)
+	suite.repo.EXPECT().Create(gomock.Any()).Return(&entities.Promotion{ID: 2}, nil)
+
+	_, err := suite.useCase.Create(matriz, promotionDto)
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *PromotionUseCaseSuite) TestCreateReturnsBadRequestOnCategoryNotRegistered() {
+	promotionDto := dto.PromotionDto{Name: "Brunch 10%", Kind: "percentual_categoria", Category: "brunch", PercentageOff: 10}
+
+	suite.categoryRepo.EXPECT().GetOne(entities.Category{Name: "BRUNCH"}).Return(nil, gorm.ErrRecordNotFound)
+
+	promotion, err := suite.useCase.Create(matriz, promotionDto)
+	assert.Nil(suite.T(), promotion)
+	assert.IsType(suite.T(), &custom_errors.BadRequestError{}, err)
+	assert.Equal(suite.T(), "category: must be a registered category.", err.Error())
+}
+
+func (suite *PromotionUseCaseSuite) TestCreateReturnsBadRequestOnInvalidPromotion() {
+	promotionDto := welcomeCouponDto()
+	promotionDto.Code = "bem vindo"
+
+	promotion, err := suite.useCase.Create(matriz, promotionDto)
+	assert.Nil(suite.T(), promotion)
+	assert.IsType(suite.T(), &custom_errors.BadRequestError{}, err)
+}
+
+func (suite *PromotionUseCaseSuite) TestCreateReturnsConflictOnCodeInUse() {
+	suite.repo.EXPECT().GetByCode(matriz.ID, "BEMVINDO10").Return(&entities.Promotion{ID: 4, Code: "BEMVINDO10"}, nil)
+
+	promotion, err := suite.useCase.Create(matriz, welcomeCouponDto())
+	assert.Nil(suite.T(), promotion)
+	assert.IsType(suite.T(), &custom_errors.ConflictError{}, err)
+}
+
+func (suite *PromotionUseCaseSuite) TestCreateReturnsErrorOnRepositoryFailure() {
+	suite.repo.EXPECT().GetByCode(matriz.ID, "BEMVINDO10").Return(nil, gorm.ErrRecordNotFound)
+	suite.repo.EXPECT().Create(gomock.Any()).Return(nil, errors.New("insert error"))
+
+	promotion, err := suite.useCase.Create(matriz, welcomeCouponDto())
+	assert.Nil(suite.T(), promotion)
+	assert.Equal(suite.T(), "create promotion on repository has failed", err.Error())
+}
+
+func (suite *PromotionUseCaseSuite) TestUpdateKeepsItsOwnCode() {
+	expectedPromotion := entities.Promotion{ID: 4, Code: "BEMVINDO10"}
+
+	suite.repo.EXPECT().GetOne(entities.Promotion{ID: 4, StoreID: matriz.ID}).Return(&expectedPromotion, nil)
+	suite.repo.EXPECT().GetByCode(matriz.ID, "BEMVINDO10").Return(&expectedPromotion, nil)
+	suite.repo.EXPECT().Update(matriz.ID, uint32(4), gomock.Any()).Return(&expectedPromotion, nil)
+
+	promotion, err := suite.useCase.Update(matriz, 4, welcomeCouponDto())
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), &expectedPromotion, promotion)
+}
+
+func (suite *PromotionUseCaseSuite) TestUpdateReturnsNotFoundOnUnknownPromotion() {
+	suite.repo.EXPECT().GetOne(entities.Promotion{ID: 9, StoreID: matriz.ID}).Return(nil, gorm.ErrRecordNotFound)
+
+	promotion, err := suite.useCase.Update(matriz, 9, welcomeCouponDto())
+	assert.Nil(suite.T(), promotion)
+	assert.IsType(suite.T(), &custom_errors.NotFoundError{}, err)
+}
+
+func (suite *PromotionUseCaseSuite) TestUpdateReturnsNotFoundWhenThePromotionLeftTheStore() {
+	suite.repo.EXPECT().GetOne(entities.Promotion{ID: 4, StoreID: matriz.ID}).Return(&entities.Promotion{ID: 4}, nil)
+	suite.repo.EXPECT().GetByCode(matriz.ID, "BEMVINDO10").Return(nil, gorm.ErrRecordNotFound)
+	suite.repo.EXPECT().Update(matriz.ID, uint32(4), gomock.Any()).Return(nil, gorm.ErrRecordNotFound)
+
+	promotion, err := suite.useCase.Update(matriz, 4, welcomeCouponDto())
+	assert.Nil(suite.T(), promotion)
+	assert.IsType(suite.T(), &custom_errors.NotFoundError{}, err)
+}
+
+func (suite *PromotionUseCaseSuite) TestDelete() {
+	suite.repo.EXPECT().GetOne(entities.Promotion{ID: 4, StoreID: matriz.ID}).Return(&entities.Promotion{ID: 4}, nil)
+	suite.repo.EXPECT().Delete(matriz.ID, uint32(4)).Return(nil)
+
+	err := suite.useCase.Delete(matriz, 4)
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *PromotionUseCaseSuite) TestDeleteReturnsNotFoundOnUnknownPromotion() {
+	suite.repo.EXPECT().GetOne(entities.Promotion{ID: 9, StoreID: matriz.ID}).Return(nil, gorm.ErrRecordNotFound)
+
+	err := suite.useCase.Delete(matriz, 9)
+	assert.IsType(suite.T(), &custom_errors.NotFoundError{}, err)
+}
+
+func (suite *PromotionUseCaseSuite) TestDeleteReturnsNotFoundWhenThePromotionLeftTheStore() {
+	suite.repo.EXPECT().GetOne(entities.Promotion{ID: 4, StoreID: matriz.ID}).Return(&entities.Promotion{ID: 4}, nil)
+	suite.repo.EXPECT().Delete(matriz.ID, uint32(4)).Return(gorm.ErrRecordNotFound)
+
+	err := suite.useCase.Delete(matriz, 4)
+	assert.IsType(suite.T(), &custom_errors.NotFoundError{}, err)
+}
+
+func TestPromotionUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(PromotionUseCaseSuite))
+}
@@ -0,0 +1,290 @@
+package usecases
+
+import (
+	"errors"
+	"github.com/8soat-grupo35/fastfood-order/internal/adapters/dto"
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+	"github.com/8soat-grupo35/fastfood-order/internal/interfaces/repository"
+	"github.com/8soat-grupo35/fastfood-order/internal/interfaces/usecase"
+	"log"
+	"time"
+
+	custom_errors "github.com/8soat-grupo35/fastfood-order/internal/api/errors"
+	"gorm.io/gorm"
+)
+
+type storeService struct {
+	storeRepository repository.StoreRepository
+}
+
+func NewStoreUseCase(storeRepository repository.StoreRepository) usecase.StoreUseCase {
+	return &storeService{
+		storeRepository: storeRepository,
+	}
+}
+
+func (service *storeService) GetAll() ([]entities.Store, error) {
+	stores, err := service.storeRepository.GetAll()
+
+	if err != nil {
+		return []entities.Store{}, &custom_errors.DatabaseError{
+			Message: "get store from repository has failed",
+		}
+	}
+
+	return stores, nil
+}
+
+// Resolve finds the store a request is made to. Inactive stores are not found, as they take no requests.
+func (service *storeService) Resolve(storeId uint32) (*entities.Store, error) {
+	store, err := service.storeRepository.GetOne(entities.Store{ID: storeId})
+
+	if errors.Is(err, gorm.ErrRecordNotFound) || (err == nil && !store.Active) {
+		return nil, &custom_errors.NotFoundError{
+			Message: "store not found",
+		}
+	}
+
+	if err != nil {
+		log.Println(err.Error())
+		return nil, &custom_errors.DatabaseError{
+			Message: "error on obtain store in repository",
+		}
+	}
+
+	return store, nil
+}
+
+func (service *storeService) Create(store dto.StoreDto) (*entities.Store, error) {
+	newStore, err := entities.NewStore(store)
+
+	if err != nil {
+		return nil, custom_errors.NewValidationError(err)
+	}
+
+	storeSaved, err := service.storeRepository.Create(*newStore)
+
+	if err != nil {
+		return nil, errors.New("create store on repository has failed")
+	}
+
+	return storeSaved, nil
+}
+
+func (service *storeService) Update(storeId uint32, store dto.StoreDto) (*entities.Store, error) {
+	storeToUpdate, err := entities.NewStore(store)
+
+	if err != nil {
+		return nil, custom_errors.NewValidationError(err)
+	}
+
+	_, err = service.storeRepository.GetOne(entities.Store{ID: storeId})
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, &custom_errors.NotFoundError{
+			Message: "store not found to update",
+		}
+	}
+
+	if err != nil {
+		log.Println(err.Error())
+		return nil, &custom_errors.DatabaseError{
+			Message: "error on obtain store to update in repository",
+		}
+	}
+
+	storeUpdated, err := service.storeRepository.Update(storeId, *storeToUpdate)
+
+	if err != nil {
+		return nil, &custom_errors.DatabaseError{
+			Message: "updated store on repository has failed",
+		}
+	}
+
+	return storeUpdated, nil
+}
+
+func (service *storeService) GetStatus(store entities.Store) (*entities.StoreStatus, error) {
+	return storeStatus(service.storeRepository, store, time.Now())
+}
+
+func (service *storeService) GetHours(store entities.Store) ([]entities.StoreHours, error) {
+	hours, err := service.storeRepository.GetHours(store.ID)
+
+	if err != nil {
+		return []entities.StoreHours{}, &custom_errors.DatabaseError{
+			Message: "get store hours from repository has failed",
+		}
+	}
+
+	return hours, nil
+}
+
+func (service *storeService) UpdateHours(store entities.Store, hoursDto dto.StoreHoursDto) ([]entities.StoreHours, error) {
+	hours, err := entities.NewStoreHours(hoursDto)
+
+	if err != nil {
+		return nil, custom_errors.NewValidationError(err)
+	}
+
+	hoursSaved, err := service.storeRepository.ReplaceHours(store.ID, hours)
+
+	if err != nil {
+		return nil, &custom_errors.DatabaseError{
+			Message: "update store hours on repository has failed",
+		}
+	}
+
+	return hoursSaved, nil
+}
+
+// GetCalendar lists the calendar days from today on. Days gone by no longer change the hours.
+func (service *storeService) GetCalendar(store entities.Store) ([]entities.StoreCalendarDay, error) {
+	days, err := service.storeRepository.GetCalendar(store.ID, store.Now())
+
+	if err != nil {
+		return []entities.StoreCalendarDay{}, &custom_errors.DatabaseError{
+			Message: "get store calendar from repository has failed",
+		}
+	}
+
+	return days, nil
+}
+
+func (service *storeService) CreateCalendarDay(store entities.Store, dayDto dto.StoreCalendarDayDto) (*entities.StoreCalendarDay, error) {
+	newDay, err := entities.NewStoreCalendarDay(dayDto)
+
+	if err != nil {
+		return nil, custom_errors.NewValidationError(err)
+	}
+
+	newDay.StoreID = store.ID
+
+	if err = service.checkDateAvailable(*newDay, 0); err != nil {
+		return nil, err
+	}
+
+	daySaved, err := service.storeRepository.CreateCalendarDay(*newDay)
+
+	if err != nil {
+		return nil, errors.New("create store calendar day on repository has failed")
+	}
+
+	return daySaved, nil
+}
+
+func (service *storeService) UpdateCalendarDay(store entities.Store, dayId uint32, dayDto dto.StoreCalendarDayDto) (*entities.StoreCalendarDay, error) {
+	dayToUpdate, err := entities.NewStoreCalendarDay(dayDto)
+
+	if err != nil {
+		return nil, custom_errors.NewValidationError(err)
+	}
+
+	dayToUpdate.StoreID = store.ID
+
+	_, err = service.storeRepository.GetCalendarDay(store.ID, dayId)
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, &custom_errors.NotFoundError{
+			Message: "store calendar day not found to update",
+		}
+	}
+
+	if err != nil {
+		log.Println(err.Error())
+		return nil, &custom_errors.DatabaseError{
+			Message: "error on obtain store calendar day to update in repository",
+		}
+	}
+
+	if err = service.checkDateAvailable(*dayToUpdate, dayId); err != nil {
+		return nil, err
+	}
+
+	dayUpdated, err := service.storeRepository.UpdateCalendarDay(dayId, *dayToUpdate)
+
+	if err != nil {
+		return nil, &custom_errors.DatabaseError{
+			Message: "updated store calendar day on repository has failed",
+		}
+	}
+
+	return dayUpdated, nil
+}
+
+func (service *storeService) DeleteCalendarDay(store entities.Store, dayId uint32) error {
+	_, err := service.storeRepository.GetCalendarDay(store.ID, dayId)
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &custom_errors.NotFoundError{
+			Message: "store calendar day not found to delete",
+		}
+	}
+
+	if err != nil {
+		log.Println(err.Error())
+		return &custom_errors.DatabaseError{
+			Message: "error on obtain store calendar day to delete in repository",
+		}
+	}
+
+	err = service.storeRepository.DeleteCalendarDay(dayId)
+
+	if err != nil {
+		return &custom_errors.DatabaseError{
+			Message: "error on delete in repository",
+		}
+	}
+
+	return nil
+}
+
+// checkDateAvailable rejects a date another calendar day of the store already has.
+func (service *storeService) checkDateAvailable(day entities.StoreCalendarDay, dayId uint32) error {
+	existing, err := service.storeRepository.GetCalendarDayByDate(day.StoreID, day.Date)
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return &custom_errors.DatabaseError{
+			Message: "get store calendar day by date from repository has failed",
+		}
+	}
+
+	if existing.ID != dayId {
+		return &custom_errors.ConflictError{
+			Message: "store calendar already has the date",
+		}
+	}
+
+	return nil
+}
+
+// storeStatus loads the schedule of the store around now. The calendar is loaded from the day before, as
+// its hours may go past midnight.
+func storeStatus(storeRepository repository.StoreRepository, store entities.Store, now time.Time) (*entities.StoreStatus, error) {
+	location := store.Location()
+
+	hours, err := storeRepository.GetHours(store.ID)
+
+	if err != nil {
+		return nil, &custom_errors.DatabaseError{
+			Message: "get store hours from repository has failed",
+		}
+	}
+
+	calendar, err := storeRepository.GetCalendar(store.ID, now.In(location).AddDate(0, 0, -1))
+
+	if err != nil {
+		return nil, &custom_errors.DatabaseError{
+			Message: "get store calendar from repository has failed",
+		}
+	}
+
+	schedule := entities.StoreSchedule{Hours: hours, Calendar: calendar, Location: location}
+	status := schedule.StatusAt(now)
+
+	return &status, nil
+}
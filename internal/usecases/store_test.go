@@ -0,0 +1,234 @@
+package usecases
+
+import (
+	"errors"
+	"github.com/8soat-grupo35/fastfood-order/internal/adapters/dto"
+	custom_errors "github.com/8soat-grupo35/fastfood-order/internal/api/errors"
+	"github.com/8soat-grupo35/fastfood-order/internal/entities"
+	mockRepository "github.com/8soat-grupo35/fastfood-order/internal/interfaces/repository/mock"
+	"github.com/8soat-grupo35/fastfood-order/internal/interfaces/usecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+	"testing"
+	"time"
+)
+
+var christmas = time.Date(2026, time.December, 25, 0, 0, 0, 0, time.UTC)
+
+// matriz is the store the requests of the tests are made to.
+var matriz = entities.Store{ID: 1, Name: "Matriz", TimeZone: "UTC", PickupCodePrefix: "A", Active: true}
+
+type StoreUseCaseSuite struct {
+	suite.Suite
+	ctrl    *gomock.Controller
+	repo    *mockRepository.MockStoreRepository
+	useCase usecase.StoreUseCase
+}
+
+func (suite *StoreUseCaseSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.repo = mockRepository.NewMockStoreRepository(suite.ctrl)
+	suite.useCase = NewStoreUseCase(suite.repo)
+}
+
+func (suite *StoreUseCaseSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func christmasDto() dto.StoreCalendarDayDto {
+	return dto.StoreCalendarDayDto{Date: "2026-12-25", Closed: true, Description: "Natal"}
+}
+
+func (suite *StoreUseCaseSuite) TestGetAll() {
+	expectedStores := []entities.Store{matriz}
+
+	suite.repo.EXPECT().GetAll().Return(expectedStores, nil)
+
+	stores, err := suite.useCase.GetAll()
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedStores, stores)
+}
+
+func (suite *StoreUseCaseSuite) TestResolve() {
+	suite.repo.EXPECT().GetOne(entities.Store{ID: 1}).Return(&matriz, nil)
+
+	store, err := suite.useCase.Resolve(1)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), matriz, *store)
+}
+
+func (suite *StoreUseCaseSuite) TestResolveReturnsNotFoundOnInactiveStore() {
+	closedStore := entities.Store{ID: 2, Name: "Centro", TimeZone: "UTC", PickupCodePrefix: "C"}
+	suite.repo.EXPECT().GetOne(entities.Store{ID: 2}).Return(&closedStore, nil)
+
+	_, err := suite.useCase.Resolve(2)
+	assert.IsType(suite.T(), &custom_errors.NotFoundError{}, err)
+	assert.Equal(suite.T(), "store not found", err.Error())
+}
+
+func (suite *StoreUseCaseSuite) TestResolveReturnsNotFoundOnUnknownStore() {
+	suite.repo.EXPECT().GetOne(entities.Store{ID: 9}).Return(nil, gorm.ErrRecordNotFound)
+
+	_, err := suite.useCase.Resolve(9)
+	assert.IsType(suite.T(), &custom_errors.NotFoundError{}, err)
+}
+
+func (suite *StoreUseCaseSuite) TestCreate() {
+	expectedStore := entities.Store{Name: "Centro", TimeZone: "America/Manaus", PickupCodePrefix: "C", Active: true}
+
+	suite.repo.EXPECT().Create(expectedStore).Return(&expectedStore, nil)
+
+	store, err := suite.useCase.Create(dto.StoreDto{Name: "Centro", TimeZone: "America/Manaus", PickupCodePrefix: "c"})
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "C", store.PickupCodePrefix)
+}
+
+func (suite *StoreUseCaseSuite) TestCreateReturnsBadRequestOnUnknownTimeZone() {
+	_, err := suite.useCase.Create(dto.StoreDto{Name: "Centro", TimeZone: "America/Atlantis", PickupCodePrefix: "C"})
+	assert.IsType(suite.T(), &custom_errors.BadRequestError{}, err)
+	assert.Equal(suite.T(), []custom_errors.ErrorDetail{
+		{Field: "time_zone", Message: "must be a IANA time zone, such as America/Sao_Paulo"},
+	}, err.(*custom_errors.BadRequestError).Details)
+}
+
+func (suite *StoreUseCaseSuite) TestUpdateDeactivatesTheStore() {
+	active := false
+
+	suite.repo.EXPECT().GetOne(entities.Store{ID: 1}).Return(&matriz, nil)
+	suite.repo.EXPECT().Update(uint32(1), gomock.Any()).DoAndReturn(func(storeId uint32, store entities.Store) (*entities.Store, error) {
+		store.ID = storeId
+		return &store, nil
+	})
+
+	store, err := suite.useCase.Update(1, dto.StoreDto{Name: "Matriz", TimeZone: "UTC", PickupCodePrefix: "A", Active: &active})
+	assert.NoError(suite.T(), err)
+	assert.False(suite.T(), store.Active)
+}
+
+func (suite *StoreUseCaseSuite) TestUpdateReturnsNotFound() {
+	suite.repo.EXPECT().GetOne(entities.Store{ID: 9}).Return(nil, gorm.ErrRecordNotFound)
+
+	_, err := suite.useCase.Update(9, dto.StoreDto{Name: "Centro", TimeZone: "UTC", PickupCodePrefix: "C"})
+	assert.IsType(suite.T(), &custom_errors.NotFoundError{}, err)
+	assert.Equal(suite.T(), "store not found to update", err.Error())
+}
+
+func (suite *StoreUseCaseSuite) TestGetStatusLoadsTheCalendarFromTheDayBefore() {
+	suite.repo.EXPECT().GetHours(uint32(1)).Return(nil, nil)
+	suite.repo.EXPECT().GetCalendar(uint32(1), gomock.Any()).DoAndReturn(func(storeId uint32, from time.Time) ([]entities.StoreCalendarDay, error) {
+		assert.Equal(suite.T(), time.Now().UTC().AddDate(0, 0, -1).Format(time.DateOnly), from.Format(time.DateOnly))
+		return nil, nil
+	})
+
+	status, err := suite.useCase.GetStatus(matriz)
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), status.Open)
+}
+
+func (suite *StoreUseCaseSuite) TestGetStatusReturnsErrorOnCalendarFailure() {
+	suite.repo.EXPECT().GetHours(uint32(1)).Return(nil, nil)
+	suite.repo.EXPECT().GetCalendar(uint32(1), gomock.Any()).Return(nil, errors.New("query error"))
+
+	_, err := suite.useCase.GetStatus(matriz)
+	assert.IsType(suite.T(), &custom_errors.DatabaseError{}, err)
+	assert.Equal(suite.T(), "get store calendar from repository has failed", err.Error())
+}
+
+func (suite *StoreUseCaseSuite) TestUpdateHours() {
+	hoursDto := dto.StoreHoursDto{Days: []dto.StoreDayHoursDto{{Weekday: 1, OpenTime: "10:00", CloseTime: "22:00"}}}
+	expectedHours := []entities.StoreHours{{Weekday: time.Monday, OpenTime: "10:00", CloseTime: "22:00"}}
+
+	suite.repo.EXPECT().ReplaceHours(uint32(1), expectedHours).Return(expectedHours, nil)
+
+	hours, err := suite.useCase.UpdateHours(matriz, hoursDto)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedHours, hours)
+}
+
+func (suite *StoreUseCaseSuite) TestUpdateHoursReturnsBadRequestOnInvalidDay() {
+	hoursDto := dto.StoreHoursDto{Days: []dto.StoreDayHoursDto{{Weekday: 1, OpenTime: "10:00"}}}
+
+	_, err := suite.useCase.UpdateHours(matriz, hoursDto)
+	assert.IsType(suite.T(), &custom_errors.BadRequestError{}, err)
+	assert.Equal(suite.T(), []custom_errors.ErrorDetail{
+		{Field: "days.0.close_time", Message: "cannot be blank"},
+	}, err.(*custom_errors.BadRequestError).Details)
+}
+
+func (suite *StoreUseCaseSuite) TestGetCalendarListsFromTodayInTheStoreTimeZone() {
+	expectedDays := []entities.StoreCalendarDay{{ID: 2, StoreID: 3, Date: christmas, Closed: true}}
+	tokyo := entities.Store{ID: 3, Name: "Tokyo", TimeZone: "Asia/Tokyo", PickupCodePrefix: "T", Active: true}
+
+	suite.repo.EXPECT().GetCalendar(uint32(3), gomock.Any()).DoAndReturn(func(storeId uint32, from time.Time) ([]entities.StoreCalendarDay, error) {
+		assert.Equal(suite.T(), time.Now().In(tokyo.Location()).Format(time.DateOnly), from.Format(time.DateOnly))
+		return expectedDays, nil
+	})
+
+	days, err := suite.useCase.GetCalendar(tokyo)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedDays, days)
+}
+
+func (suite *StoreUseCaseSuite) TestCreateCalendarDay() {
+	suite.repo.EXPECT().GetCalendarDayByDate(uint32(1), christmas).Return(nil, gorm.ErrRecordNotFound)
+	suite.repo.EXPECT().CreateCalendarDay(gomock.Any()).DoAndReturn(func(day entities.StoreCalendarDay) (*entities.StoreCalendarDay, error) {
+		day.ID = 2
+		return &day, nil
+	})
+
+	day, err := suite.useCase.CreateCalendarDay(matriz, christmasDto())
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), uint32(2), day.ID)
+	assert.Equal(suite.T(), uint32(1), day.StoreID)
+	assert.True(suite.T(), day.Closed)
+}
+
+func (suite *StoreUseCaseSuite) TestCreateCalendarDayReturnsConflictOnDateTaken() {
+	suite.repo.EXPECT().GetCalendarDayByDate(uint32(1), christmas).Return(&entities.StoreCalendarDay{ID: 2, Date: christmas}, nil)
+
+	_, err := suite.useCase.CreateCalendarDay(matriz, christmasDto())
+	assert.IsType(suite.T(), &custom_errors.ConflictError{}, err)
+	assert.Equal(suite.T(), "store calendar already has the date", err.Error())
+}
+
+func (suite *StoreUseCaseSuite) TestUpdateCalendarDayKeepsItsOwnDate() {
+	suite.repo.EXPECT().GetCalendarDay(uint32(1), uint32(2)).Return(&entities.StoreCalendarDay{ID: 2, Date: christmas}, nil)
+	suite.repo.EXPECT().GetCalendarDayByDate(uint32(1), christmas).Return(&entities.StoreCalendarDay{ID: 2, Date: christmas}, nil)
+	suite.repo.EXPECT().UpdateCalendarDay(uint32(2), gomock.Any()).DoAndReturn(func(dayId uint32, day entities.StoreCalendarDay) (*entities.StoreCalendarDay, error) {
+		day.ID = dayId
+		return &day, nil
+	})
+
+	day, err := suite.useCase.UpdateCalendarDay(matriz, 2, christmasDto())
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "Natal", day.Description)
+}
+
+func (suite *StoreUseCaseSuite) TestUpdateCalendarDayReturnsNotFoundOnDayOfAnotherStore() {
+	suite.repo.EXPECT().GetCalendarDay(uint32(1), uint32(9)).Return(nil, gorm.ErrRecordNotFound)
+
+	_, err := suite.useCase.UpdateCalendarDay(matriz, 9, christmasDto())
+	assert.IsType(suite.T(), &custom_errors.NotFoundError{}, err)
+}
+
+func (suite *StoreUseCaseSuite) TestDeleteCalendarDay() {
+	suite.repo.EXPECT().GetCalendarDay(uint32(1), uint32(2)).Return(&entities.StoreCalendarDay{ID: 2}, nil)
+	suite.repo.EXPECT().DeleteCalendarDay(uint32(2)).Return(nil)
+
+	err := suite.useCase.DeleteCalendarDay(matriz, 2)
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *StoreUseCaseSuite) TestDeleteCalendarDayReturnsNotFound() {
+	suite.repo.EXPECT().GetCalendarDay(uint32(1), uint32(9)).Return(nil, gorm.ErrRecordNotFound)
+
+	err := suite.useCase.DeleteCalendarDay(matriz, 9)
+	assert.IsType(suite.T(), &custom_errors.NotFoundError{}, err)
+	assert.Equal(suite.T(), "store calendar day not found to delete", err.Error())
+}
+
+func TestStoreUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(StoreUseCaseSuite))
+}
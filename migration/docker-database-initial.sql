@@ -8,36 +8,252 @@ CREATE TABLE IF NOT EXISTS customers(
 	deleted_at timestamptz NULL
 );
 
+CREATE TABLE IF NOT EXISTS categories(
+    id serial primary key,
+    name varchar(30) NOT NULL,
+    display_order int NOT NULL DEFAULT 0,
+    active boolean NOT NULL DEFAULT true,
+    image_url varchar(255) NOT NULL DEFAULT '',
+    created_at timestamptz NULL,
+	updated_at timestamptz NULL,
+	deleted_at timestamptz NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_categories_name ON categories (name) WHERE deleted_at IS NULL;
+
 CREATE TABLE IF NOT EXISTS items(
     id serial primary key,
     name varchar(255) NOT NULL,
     category varchar(30) NOT NULL,
-    price numeric NOT NULL,
+    category_id int NOT NULL,
+    price numeric(12,2) NOT NULL,
+    image_url varchar(255) NOT NULL,
+    paused boolean NOT NULL DEFAULT false,
+    created_at timestamptz NULL,
+	updated_at timestamptz NULL,
+	deleted_at timestamptz NULL,
+
+    CONSTRAINT fk_items_categories
+      FOREIGN KEY(category_id)
+      REFERENCES categories(id)
+);
+
+CREATE TABLE IF NOT EXISTS item_variants(
+    id serial primary key,
+    item_id int NOT NULL,
+    label varchar(30) NOT NULL,
+    price_delta numeric(12,2) NOT NULL DEFAULT 0,
+    available boolean NOT NULL DEFAULT true,
+
+    CONSTRAINT uq_item_variants_item_label UNIQUE (item_id, label),
+    CONSTRAINT fk_item_variants_items
+      FOREIGN KEY(item_id)
+      REFERENCES items(id)
+      ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS item_modifiers(
+    id serial primary key,
+    item_id int NOT NULL,
+    name varchar(100) NOT NULL,
+    price numeric(12,2) NOT NULL DEFAULT 0,
+
+    CONSTRAINT uq_item_modifiers_item_name UNIQUE (item_id, name),
+    CONSTRAINT fk_item_modifiers_items
+      FOREIGN KEY(item_id)
+      REFERENCES items(id)
+      ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS stores(
+    id serial primary key,
+    name varchar(100) NOT NULL,
+    time_zone varchar(64) NOT NULL,
+    pickup_code_prefix varchar(3) NOT NULL,
+    active boolean NOT NULL DEFAULT true,
+    created_at timestamptz NULL,
+	updated_at timestamptz NULL,
+	deleted_at timestamptz NULL
+);
+
+CREATE TABLE IF NOT EXISTS menus(
+    id serial primary key,
+    store_id int NOT NULL,
+    name varchar(100) NOT NULL,
+    start_time varchar(5) NOT NULL,
+    end_time varchar(5) NOT NULL,
+    exclusive boolean NOT NULL DEFAULT false,
+    created_at timestamptz NULL,
+	updated_at timestamptz NULL,
+	deleted_at timestamptz NULL,
+
+    CONSTRAINT fk_menus_stores
+      FOREIGN KEY(store_id)
+      REFERENCES stores(id)
+);
+
+CREATE TABLE IF NOT EXISTS menu_items(
+    id serial primary key,
+    menu_id int NOT NULL,
+    item_id int NOT NULL,
+    price numeric(12,2) NULL,
+
+    CONSTRAINT uq_menu_items_menu_item UNIQUE (menu_id, item_id),
+    CONSTRAINT fk_menu_items_menus
+      FOREIGN KEY(menu_id)
+      REFERENCES menus(id)
+      ON DELETE CASCADE,
+    CONSTRAINT fk_menu_items_items
+      FOREIGN KEY(item_id)
+      REFERENCES items(id)
+      ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS ingredients(
+    id serial primary key,
+    name varchar(100) NOT NULL,
+    unit varchar(5) NOT NULL,
+    low_stock_threshold numeric(12,3) NOT NULL DEFAULT 0,
+    created_at timestamptz NULL,
+	updated_at timestamptz NULL,
+	deleted_at timestamptz NULL
+);
+
+CREATE TABLE IF NOT EXISTS recipe_ingredients(
+    id serial primary key,
+    item_id int NOT NULL,
+    ingredient_id int NOT NULL,
+    quantity numeric(12,3) NOT NULL,
+
+    CONSTRAINT uq_recipe_ingredients_item_ingredient UNIQUE (item_id, ingredient_id),
+    CONSTRAINT fk_recipe_ingredients_items
+      FOREIGN KEY(item_id)
+      REFERENCES items(id)
+      ON DELETE CASCADE,
+    CONSTRAINT fk_recipe_ingredients_ingredients
+      FOREIGN KEY(ingredient_id)
+      REFERENCES ingredients(id)
+      ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS store_item_stocks(
+    store_id int NOT NULL,
+    item_id int NOT NULL,
+    stock int NOT NULL CHECK (stock >= 0),
+
+    PRIMARY KEY (store_id, item_id),
+    CONSTRAINT fk_store_item_stocks_stores
+      FOREIGN KEY(store_id)
+      REFERENCES stores(id),
+    CONSTRAINT fk_store_item_stocks_items
+      FOREIGN KEY(item_id)
+      REFERENCES items(id)
+      ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS store_ingredient_stocks(
+    store_id int NOT NULL,
+    ingredient_id int NOT NULL,
+    stock numeric(12,3) NOT NULL DEFAULT 0 CHECK (stock >= 0),
+
+    PRIMARY KEY (store_id, ingredient_id),
+    CONSTRAINT fk_store_ingredient_stocks_stores
+      FOREIGN KEY(store_id)
+      REFERENCES stores(id),
+    CONSTRAINT fk_store_ingredient_stocks_ingredients
+      FOREIGN KEY(ingredient_id)
+      REFERENCES ingredients(id)
+      ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS combos(
+    id serial primary key,
+    name varchar(255) NOT NULL,
     image_url varchar(255) NOT NULL,
+    pricing_rule varchar(20) NOT NULL,
+    price numeric(12,2) NOT NULL DEFAULT 0,
+    percentage_off numeric NOT NULL DEFAULT 0,
     created_at timestamptz NULL,
 	updated_at timestamptz NULL,
 	deleted_at timestamptz NULL
 );
 
+CREATE TABLE IF NOT EXISTS combo_slots(
+    id serial primary key,
+    combo_id int NOT NULL,
+    category varchar(30) NOT NULL,
+
+    CONSTRAINT fk_combo_slots_combos
+      FOREIGN KEY(combo_id)
+      REFERENCES combos(id)
+      ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS combo_slot_items(
+    id serial primary key,
+    combo_slot_id int NOT NULL,
+    item_id int NOT NULL,
+
+    CONSTRAINT uq_combo_slot_items_slot_item UNIQUE (combo_slot_id, item_id),
+    CONSTRAINT fk_combo_slot_items_combo_slots
+      FOREIGN KEY(combo_slot_id)
+      REFERENCES combo_slots(id)
+      ON DELETE CASCADE,
+    CONSTRAINT fk_combo_slot_items_items
+      FOREIGN KEY(item_id)
+      REFERENCES items(id)
+      ON DELETE CASCADE
+);
+
 CREATE TABLE IF NOT EXISTS orders(
     id serial primary key,
+    store_id int NOT NULL,
+    tracking_code varchar(12) NULL UNIQUE,
+    pickup_code varchar(20) NULL,
     status varchar(50) NOT NULL,
-    customer_id int NOT NULL,
+    payment_status varchar(50) NOT NULL DEFAULT 'PENDENTE',
+    customer_id int NULL,
+    customer_name varchar(100) NULL,
+    subtotal numeric(12,2) NOT NULL DEFAULT 0,
+    discount numeric(12,2) NOT NULL DEFAULT 0,
+    total numeric(12,2) NOT NULL DEFAULT 0,
+    canceled_by varchar(255) NULL,
+    cancellation_reason varchar(255) NULL,
+    canceled_at timestamptz NULL,
+    preparation_started_at timestamptz NULL,
+    ready_at timestamptz NULL,
     created_at timestamptz NULL,
 	updated_at timestamptz NULL,
 	deleted_at timestamptz NULL,
 
+    CONSTRAINT chk_orders_customer
+      CHECK (customer_id IS NOT NULL OR customer_name IS NOT NULL),
     CONSTRAINT fk_customer_orders
       FOREIGN KEY(customer_id) 
       REFERENCES customers(id)
-      ON DELETE SET NULL
+      ON DELETE SET NULL,
+    CONSTRAINT fk_orders_stores
+      FOREIGN KEY(store_id)
+      REFERENCES stores(id)
 );
 
+CREATE INDEX IF NOT EXISTS idx_orders_status_created_at ON orders (store_id, status, created_at, id);
+
 CREATE TABLE IF NOT EXISTS order_items(
     id serial primary key,
     order_id int NOT NULL,
     item_id int NOT NULL,
+    item_name varchar(255) NULL,
+    variant_id int NULL,
+    variant_label varchar(30) NULL,
+    unit_price numeric(12,2) NOT NULL DEFAULT 0,
     quantity int NOT NULL,
+    notes varchar(140) NULL,
+    combo_id int NULL,
+    combo_name varchar(255) NULL,
+    combo_group int NULL,
+    discount numeric(12,2) NOT NULL DEFAULT 0,
+    from_recipe boolean NOT NULL DEFAULT false,
     created_at timestamptz NULL,
 	updated_at timestamptz NULL,
 	deleted_at timestamptz NULL,
@@ -50,11 +266,191 @@ CREATE TABLE IF NOT EXISTS order_items(
     CONSTRAINT fk_item_orders_items
       FOREIGN KEY(item_id) 
       REFERENCES items(id)
+      ON DELETE SET NULL,
+
+    CONSTRAINT fk_combo_orders_items
+      FOREIGN KEY(combo_id)
+      REFERENCES combos(id)
       ON DELETE SET NULL
 );
 
-INSERT INTO items (name, category, price, image_url, created_at, updated_at, deleted_at) VALUES ('X-Burguer', 'LANCHE', 28, 'https://fastly.picsum.photos/id/8/200/200.jpg?hmac=7z37E8o2M_U09oSFIN5CdqKXlYXuLeWxTHJVlT9UUlY', 'NOW'::timestamptz, 'NOW'::timestamptz, null);
+CREATE TABLE IF NOT EXISTS order_item_modifiers(
+    id serial primary key,
+    order_item_id int NOT NULL,
+    name varchar(100) NOT NULL,
+    price numeric(12,2) NOT NULL DEFAULT 0,
+
+    CONSTRAINT fk_order_item_modifiers_order_items
+      FOREIGN KEY(order_item_id)
+      REFERENCES order_items(id)
+      ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS promotions(
+    id serial primary key,
+    store_id int NOT NULL,
+    name varchar(100) NOT NULL,
+    code varchar(30) NOT NULL DEFAULT '',
+    kind varchar(30) NOT NULL,
+    category varchar(30) NULL,
+    percentage_off numeric NOT NULL DEFAULT 0,
+    buy_quantity int NOT NULL DEFAULT 0,
+    free_quantity int NOT NULL DEFAULT 0,
+    amount numeric(12,2) NOT NULL DEFAULT 0,
+    minimum_total numeric(12,2) NOT NULL DEFAULT 0,
+    first_order_only boolean NOT NULL DEFAULT false,
+    starts_at timestamptz NULL,
+    ends_at timestamptz NULL,
+    usage_limit int NOT NULL DEFAULT 0,
+    per_customer_limit int NOT NULL DEFAULT 0,
+    times_used int NOT NULL DEFAULT 0,
+    created_at timestamptz NULL,
+	updated_at timestamptz NULL,
+	deleted_at timestamptz NULL,
+
+    CONSTRAINT fk_promotions_stores
+      FOREIGN KEY(store_id)
+      REFERENCES stores(id)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_promotions_code ON promotions (store_id, code) WHERE code <> '' AND deleted_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS order_discounts(
+    id serial primary key,
+    order_id int NOT NULL,
+    promotion_id int NOT NULL,
+    promotion_name varchar(100) NOT NULL,
+    code varchar(30) NULL,
+    amount numeric(12,2) NOT NULL DEFAULT 0,
+
+    CONSTRAINT fk_order_discounts_orders
+      FOREIGN KEY(order_id)
+      REFERENCES orders(id)
+      ON DELETE CASCADE,
+    CONSTRAINT fk_order_discounts_promotions
+      FOREIGN KEY(promotion_id)
+      REFERENCES promotions(id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_order_discounts_promotion_id ON order_discounts (promotion_id);
+
+CREATE TABLE IF NOT EXISTS order_ingredients(
+    id serial primary key,
+    order_id int NOT NULL,
+    ingredient_id int NOT NULL,
+    quantity numeric(12,3) NOT NULL,
+
+    CONSTRAINT fk_order_ingredients_orders
+      FOREIGN KEY(order_id)
+      REFERENCES orders(id)
+      ON DELETE CASCADE,
+    CONSTRAINT fk_order_ingredients_ingredients
+      FOREIGN KEY(ingredient_id)
+      REFERENCES ingredients(id)
+);
+
+CREATE TABLE IF NOT EXISTS order_status_history(
+    id serial primary key,
+    order_id int NOT NULL,
+    previous_status varchar(50) NULL,
+    status varchar(50) NOT NULL,
+    changed_by varchar(255) NOT NULL,
+    changed_at timestamptz NOT NULL,
+
+    CONSTRAINT fk_order_status_history_orders
+      FOREIGN KEY(order_id)
+      REFERENCES orders(id)
+      ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_order_status_history_order ON order_status_history (order_id, changed_at);
+
+CREATE TABLE IF NOT EXISTS outbox_messages(
+    id serial primary key,
+    event_type varchar(50) NOT NULL,
+    aggregate_id int NOT NULL,
+    payload text NOT NULL,
+    status varchar(20) NOT NULL,
+    attempts int NOT NULL DEFAULT 0,
+    last_error varchar(255) NULL,
+    next_attempt_at timestamptz NOT NULL,
+    sent_at timestamptz NULL,
+    created_at timestamptz NULL,
+    updated_at timestamptz NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_outbox_messages_pending ON outbox_messages (next_attempt_at) WHERE status = 'PENDENTE';
+
+CREATE TABLE IF NOT EXISTS store_hours(
+    id serial primary key,
+    store_id int NOT NULL,
+    weekday smallint NOT NULL,
+    open_time varchar(5) NOT NULL,
+    close_time varchar(5) NOT NULL,
+
+    CONSTRAINT uq_store_hours_weekday UNIQUE (store_id, weekday),
+    CONSTRAINT ck_store_hours_weekday CHECK (weekday BETWEEN 0 AND 6),
+    CONSTRAINT fk_store_hours_stores
+      FOREIGN KEY(store_id)
+      REFERENCES stores(id)
+      ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS store_calendar_days(
+    id serial primary key,
+    store_id int NOT NULL,
+    date date NOT NULL,
+    closed boolean NOT NULL DEFAULT false,
+    open_time varchar(5) NOT NULL DEFAULT '',
+    close_time varchar(5) NOT NULL DEFAULT '',
+    description varchar(100) NOT NULL DEFAULT '',
+    created_at timestamptz NULL,
+    updated_at timestamptz NULL,
+
+    CONSTRAINT uq_store_calendar_days_date UNIQUE (store_id, date),
+    CONSTRAINT fk_store_calendar_days_stores
+      FOREIGN KEY(store_id)
+      REFERENCES stores(id)
+      ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS pickup_code_sequences(
+    store_id int NOT NULL,
+    business_date date NOT NULL,
+    last_number int NOT NULL,
+
+    PRIMARY KEY (store_id, business_date)
+);
+
+CREATE TABLE IF NOT EXISTS idempotency_keys(
+    id serial primary key,
+    idempotency_key varchar(255) NOT NULL,
+    scope varchar(255) NOT NULL,
+    request_hash varchar(64) NOT NULL,
+    response_status int NOT NULL DEFAULT 0,
+    response_body text NULL,
+    locked_until timestamptz NOT NULL,
+    expires_at timestamptz NOT NULL,
+    created_at timestamptz NULL,
+    updated_at timestamptz NULL,
+
+    CONSTRAINT uq_idempotency_keys_key_scope UNIQUE (idempotency_key, scope)
+);
+
+CREATE INDEX IF NOT EXISTS idx_idempotency_keys_expires_at ON idempotency_keys (expires_at);
+
+INSERT INTO stores (name, time_zone, pickup_code_prefix, active, created_at, updated_at, deleted_at) VALUES ('Matriz', 'America/Sao_Paulo', 'A', true, 'NOW'::timestamptz, 'NOW'::timestamptz, null);
+
+INSERT INTO categories (name, display_order, active, created_at, updated_at, deleted_at) VALUES ('LANCHE', 1, true, 'NOW'::timestamptz, 'NOW'::timestamptz, null);
+
+INSERT INTO categories (name, display_order, active, created_at, updated_at, deleted_at) VALUES ('ACOMPANHAMENTO', 2, true, 'NOW'::timestamptz, 'NOW'::timestamptz, null);
+
+INSERT INTO categories (name, display_order, active, created_at, updated_at, deleted_at) VALUES ('BEBIDA', 3, true, 'NOW'::timestamptz, 'NOW'::timestamptz, null);
+
+INSERT INTO categories (name, display_order, active, created_at, updated_at, deleted_at) VALUES ('SOBREMESA', 4, true, 'NOW'::timestamptz, 'NOW'::timestamptz, null);
+
+INSERT INTO items (name, category, category_id, price, image_url, created_at, updated_at, deleted_at) VALUES ('X-Burguer', 'LANCHE', (SELECT id FROM categories WHERE name = 'LANCHE'), 28, 'https://fastly.picsum.photos/id/8/200/200.jpg?hmac=7z37E8o2M_U09oSFIN5CdqKXlYXuLeWxTHJVlT9UUlY', 'NOW'::timestamptz, 'NOW'::timestamptz, null);
 
-INSERT INTO items (name, category, price, image_url, created_at, updated_at, deleted_at) VALUES ('X-Bacon', 'LANCHE', 35, 'https://fastly.picsum.photos/id/8/200/200.jpg?hmac=7z37E8o2M_U09oSFIN5CdqKXlYXuLeWxTHJVlT9UUlY', 'NOW'::timestamptz, 'NOW'::timestamptz, null);
+INSERT INTO items (name, category, category_id, price, image_url, created_at, updated_at, deleted_at) VALUES ('X-Bacon', 'LANCHE', (SELECT id FROM categories WHERE name = 'LANCHE'), 35, 'https://fastly.picsum.photos/id/8/200/200.jpg?hmac=7z37E8o2M_U09oSFIN5CdqKXlYXuLeWxTHJVlT9UUlY', 'NOW'::timestamptz, 'NOW'::timestamptz, null);
 
 INSERT INTO customers (name, email, cpf, created_at, updated_at, deleted_at) VALUES ('John Doe', 'john@gmail.com', '12345678911', 'NOW'::timestamptz, 'NOW'::timestamptz, null);
\ No newline at end of file
@@ -0,0 +1,44 @@
+-- Moves the item categories of databases created by an older docker-database-initial.sql to the
+-- categories table. Every category the items use becomes an active category, the default ones keeping
+-- their order on the menu, and the items are linked to it. It is safe to run more than once.
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS categories(
+    id serial primary key,
+    name varchar(30) NOT NULL,
+    display_order int NOT NULL DEFAULT 0,
+    active boolean NOT NULL DEFAULT true,
+    image_url varchar(255) NOT NULL DEFAULT '',
+    created_at timestamptz NULL,
+    updated_at timestamptz NULL,
+    deleted_at timestamptz NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_categories_name ON categories (name) WHERE deleted_at IS NULL;
+
+INSERT INTO categories (name, display_order, active, created_at, updated_at, deleted_at)
+SELECT used.name,
+       CASE used.name WHEN 'LANCHE' THEN 1 WHEN 'ACOMPANHAMENTO' THEN 2 WHEN 'BEBIDA' THEN 3 WHEN 'SOBREMESA' THEN 4 ELSE 5 END,
+       true, now(), now(), null
+FROM (SELECT DISTINCT upper(trim(category)) AS name FROM items) AS used
+WHERE NOT EXISTS (SELECT 1 FROM categories WHERE categories.name = used.name AND categories.deleted_at IS NULL);
+
+ALTER TABLE items ADD COLUMN IF NOT EXISTS category_id int NULL;
+
+UPDATE items SET category_id = categories.id, category = categories.name
+FROM categories
+WHERE items.category_id IS NULL
+  AND categories.name = upper(trim(items.category))
+  AND categories.deleted_at IS NULL;
+
+ALTER TABLE items ALTER COLUMN category_id SET NOT NULL;
+
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_constraint WHERE conname = 'fk_items_categories') THEN
+        ALTER TABLE items ADD CONSTRAINT fk_items_categories FOREIGN KEY (category_id) REFERENCES categories(id);
+    END IF;
+END
+$$;
+
+COMMIT;
@@ -0,0 +1,18 @@
+-- Adds the lease and expiry columns to the idempotency_keys table of databases created by an older
+-- docker-database-initial.sql. Keys that are still being processed get a lease that has already run out,
+-- so a retry can take them over, and every key expires a day after it was last written. It is safe to run
+-- more than once.
+BEGIN;
+
+ALTER TABLE idempotency_keys ADD COLUMN IF NOT EXISTS locked_until timestamptz NULL;
+ALTER TABLE idempotency_keys ADD COLUMN IF NOT EXISTS expires_at timestamptz NULL;
+
+UPDATE idempotency_keys SET locked_until = now() WHERE locked_until IS NULL;
+UPDATE idempotency_keys SET expires_at = COALESCE(updated_at, created_at, now()) + interval '24 hours' WHERE expires_at IS NULL;
+
+ALTER TABLE idempotency_keys ALTER COLUMN locked_until SET NOT NULL;
+ALTER TABLE idempotency_keys ALTER COLUMN expires_at SET NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_idempotency_keys_expires_at ON idempotency_keys (expires_at);
+
+COMMIT;
@@ -0,0 +1,12 @@
+-- Moves the ingredient quantity columns of databases created by an older docker-database-initial.sql to
+-- numeric(12,3). Quantities with more than three places, such as the float residue left by earlier
+-- orders, are rounded half away from zero, as the service rounds them. Run it after
+-- migration/upgrade-stores.sql. It is safe to run more than once.
+BEGIN;
+
+ALTER TABLE ingredients ALTER COLUMN low_stock_threshold TYPE numeric(12,3) USING round(low_stock_threshold, 3);
+ALTER TABLE recipe_ingredients ALTER COLUMN quantity TYPE numeric(12,3) USING round(quantity, 3);
+ALTER TABLE store_ingredient_stocks ALTER COLUMN stock TYPE numeric(12,3) USING round(stock, 3);
+ALTER TABLE order_ingredients ALTER COLUMN quantity TYPE numeric(12,3) USING round(quantity, 3);
+
+COMMIT;
@@ -0,0 +1,22 @@
+-- Moves the amount columns of databases created by an older docker-database-initial.sql to numeric(12,2).
+-- Amounts with more than two places are rounded to the cent, half away from zero, as the service rounds
+-- them. Orders keep the totals they were charged. It is safe to run more than once.
+BEGIN;
+
+ALTER TABLE items ALTER COLUMN price TYPE numeric(12,2) USING round(price, 2);
+ALTER TABLE item_variants ALTER COLUMN price_delta TYPE numeric(12,2) USING round(price_delta, 2);
+ALTER TABLE item_modifiers ALTER COLUMN price TYPE numeric(12,2) USING round(price, 2);
+ALTER TABLE menu_items ALTER COLUMN price TYPE numeric(12,2) USING round(price, 2);
+ALTER TABLE combos ALTER COLUMN price TYPE numeric(12,2) USING round(price, 2);
+ALTER TABLE promotions ALTER COLUMN amount TYPE numeric(12,2) USING round(amount, 2);
+ALTER TABLE promotions ALTER COLUMN minimum_total TYPE numeric(12,2) USING round(minimum_total, 2);
+
+ALTER TABLE orders ALTER COLUMN subtotal TYPE numeric(12,2) USING round(subtotal, 2);
+ALTER TABLE orders ALTER COLUMN discount TYPE numeric(12,2) USING round(discount, 2);
+ALTER TABLE orders ALTER COLUMN total TYPE numeric(12,2) USING round(total, 2);
+ALTER TABLE order_items ALTER COLUMN unit_price TYPE numeric(12,2) USING round(unit_price, 2);
+ALTER TABLE order_items ALTER COLUMN discount TYPE numeric(12,2) USING round(discount, 2);
+ALTER TABLE order_item_modifiers ALTER COLUMN price TYPE numeric(12,2) USING round(price, 2);
+ALTER TABLE order_discounts ALTER COLUMN amount TYPE numeric(12,2) USING round(amount, 2);
+
+COMMIT;
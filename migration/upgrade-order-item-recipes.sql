@@ -0,0 +1,9 @@
+-- Adds the from_recipe column to the order_items table of databases created by an older
+-- docker-database-initial.sql. Lines of earlier orders took the units of their items from the stock,
+-- so they are left as not made from a recipe and give those units back when the order is canceled.
+-- It is safe to run more than once.
+BEGIN;
+
+ALTER TABLE order_items ADD COLUMN IF NOT EXISTS from_recipe boolean NOT NULL DEFAULT false;
+
+COMMIT;
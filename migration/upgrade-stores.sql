@@ -0,0 +1,150 @@
+-- Moves databases created by an older docker-database-initial.sql to stores. The Matriz store is created
+-- when there is none, and the menus, orders, promotions, store hours, calendar days and pickup code
+-- sequences already saved are given to the first store, which also keeps the stock of the items and
+-- ingredients. It is safe to run more than once.
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS stores(
+    id serial primary key,
+    name varchar(100) NOT NULL,
+    time_zone varchar(64) NOT NULL,
+    pickup_code_prefix varchar(3) NOT NULL,
+    active boolean NOT NULL DEFAULT true,
+    created_at timestamptz NULL,
+    updated_at timestamptz NULL,
+    deleted_at timestamptz NULL
+);
+
+INSERT INTO stores (name, time_zone, pickup_code_prefix, active, created_at, updated_at, deleted_at)
+SELECT 'Matriz', 'America/Sao_Paulo', 'A', true, now(), now(), null
+WHERE NOT EXISTS (SELECT 1 FROM stores);
+
+CREATE TABLE IF NOT EXISTS store_hours(
+    id serial primary key,
+    weekday smallint NOT NULL,
+    open_time varchar(5) NOT NULL,
+    close_time varchar(5) NOT NULL,
+
+    CONSTRAINT ck_store_hours_weekday CHECK (weekday BETWEEN 0 AND 6)
+);
+
+CREATE TABLE IF NOT EXISTS store_calendar_days(
+    id serial primary key,
+    date date NOT NULL,
+    closed boolean NOT NULL DEFAULT false,
+    open_time varchar(5) NOT NULL DEFAULT '',
+    close_time varchar(5) NOT NULL DEFAULT '',
+    description varchar(100) NOT NULL DEFAULT '',
+    created_at timestamptz NULL,
+    updated_at timestamptz NULL
+);
+
+CREATE TABLE IF NOT EXISTS pickup_code_sequences(
+    business_date date NOT NULL,
+    last_number int NOT NULL
+);
+
+ALTER TABLE menus ADD COLUMN IF NOT EXISTS store_id int NULL;
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS store_id int NULL;
+ALTER TABLE promotions ADD COLUMN IF NOT EXISTS store_id int NULL;
+ALTER TABLE store_hours ADD COLUMN IF NOT EXISTS store_id int NULL;
+ALTER TABLE store_calendar_days ADD COLUMN IF NOT EXISTS store_id int NULL;
+ALTER TABLE pickup_code_sequences ADD COLUMN IF NOT EXISTS store_id int NULL;
+
+UPDATE menus SET store_id = (SELECT min(id) FROM stores) WHERE store_id IS NULL;
+UPDATE orders SET store_id = (SELECT min(id) FROM stores) WHERE store_id IS NULL;
+UPDATE promotions SET store_id = (SELECT min(id) FROM stores) WHERE store_id IS NULL;
+UPDATE store_hours SET store_id = (SELECT min(id) FROM stores) WHERE store_id IS NULL;
+UPDATE store_calendar_days SET store_id = (SELECT min(id) FROM stores) WHERE store_id IS NULL;
+UPDATE pickup_code_sequences SET store_id = (SELECT min(id) FROM stores) WHERE store_id IS NULL;
+
+ALTER TABLE menus ALTER COLUMN store_id SET NOT NULL;
+ALTER TABLE orders ALTER COLUMN store_id SET NOT NULL;
+ALTER TABLE promotions ALTER COLUMN store_id SET NOT NULL;
+ALTER TABLE store_hours ALTER COLUMN store_id SET NOT NULL;
+ALTER TABLE store_calendar_days ALTER COLUMN store_id SET NOT NULL;
+ALTER TABLE pickup_code_sequences ALTER COLUMN store_id SET NOT NULL;
+
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_constraint WHERE conname = 'fk_menus_stores') THEN
+        ALTER TABLE menus ADD CONSTRAINT fk_menus_stores FOREIGN KEY (store_id) REFERENCES stores(id);
+    END IF;
+    IF NOT EXISTS (SELECT 1 FROM pg_constraint WHERE conname = 'fk_orders_stores') THEN
+        ALTER TABLE orders ADD CONSTRAINT fk_orders_stores FOREIGN KEY (store_id) REFERENCES stores(id);
+    END IF;
+    IF NOT EXISTS (SELECT 1 FROM pg_constraint WHERE conname = 'fk_promotions_stores') THEN
+        ALTER TABLE promotions ADD CONSTRAINT fk_promotions_stores FOREIGN KEY (store_id) REFERENCES stores(id);
+    END IF;
+    IF NOT EXISTS (SELECT 1 FROM pg_constraint WHERE conname = 'fk_store_hours_stores') THEN
+        ALTER TABLE store_hours ADD CONSTRAINT fk_store_hours_stores FOREIGN KEY (store_id) REFERENCES stores(id) ON DELETE CASCADE;
+    END IF;
+    IF NOT EXISTS (SELECT 1 FROM pg_constraint WHERE conname = 'fk_store_calendar_days_stores') THEN
+        ALTER TABLE store_calendar_days ADD CONSTRAINT fk_store_calendar_days_stores FOREIGN KEY (store_id) REFERENCES stores(id) ON DELETE CASCADE;
+    END IF;
+END
+$$;
+
+ALTER TABLE store_hours DROP CONSTRAINT IF EXISTS uq_store_hours_weekday;
+ALTER TABLE store_hours ADD CONSTRAINT uq_store_hours_weekday UNIQUE (store_id, weekday);
+
+ALTER TABLE store_calendar_days DROP CONSTRAINT IF EXISTS uq_store_calendar_days_date;
+ALTER TABLE store_calendar_days ADD CONSTRAINT uq_store_calendar_days_date UNIQUE (store_id, date);
+
+ALTER TABLE pickup_code_sequences DROP CONSTRAINT IF EXISTS pickup_code_sequences_pkey;
+ALTER TABLE pickup_code_sequences ADD PRIMARY KEY (store_id, business_date);
+
+DROP INDEX IF EXISTS idx_orders_status_created_at;
+CREATE INDEX idx_orders_status_created_at ON orders (store_id, status, created_at, id);
+
+DROP INDEX IF EXISTS uq_promotions_code;
+CREATE UNIQUE INDEX uq_promotions_code ON promotions (store_id, code) WHERE code <> '' AND deleted_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS store_item_stocks(
+    store_id int NOT NULL,
+    item_id int NOT NULL,
+    stock int NOT NULL CHECK (stock >= 0),
+
+    PRIMARY KEY (store_id, item_id),
+    CONSTRAINT fk_store_item_stocks_stores
+      FOREIGN KEY(store_id)
+      REFERENCES stores(id),
+    CONSTRAINT fk_store_item_stocks_items
+      FOREIGN KEY(item_id)
+      REFERENCES items(id)
+      ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS store_ingredient_stocks(
+    store_id int NOT NULL,
+    ingredient_id int NOT NULL,
+    stock numeric(12,3) NOT NULL DEFAULT 0 CHECK (stock >= 0),
+
+    PRIMARY KEY (store_id, ingredient_id),
+    CONSTRAINT fk_store_ingredient_stocks_stores
+      FOREIGN KEY(store_id)
+      REFERENCES stores(id),
+    CONSTRAINT fk_store_ingredient_stocks_ingredients
+      FOREIGN KEY(ingredient_id)
+      REFERENCES ingredients(id)
+      ON DELETE CASCADE
+);
+
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM information_schema.columns WHERE table_name = 'items' AND column_name = 'stock') THEN
+        INSERT INTO store_item_stocks (store_id, item_id, stock)
+        SELECT (SELECT min(id) FROM stores), id, stock FROM items WHERE stock IS NOT NULL
+        ON CONFLICT DO NOTHING;
+        ALTER TABLE items DROP COLUMN stock;
+    END IF;
+    IF EXISTS (SELECT 1 FROM information_schema.columns WHERE table_name = 'ingredients' AND column_name = 'stock') THEN
+        INSERT INTO store_ingredient_stocks (store_id, ingredient_id, stock)
+        SELECT (SELECT min(id) FROM stores), id, stock FROM ingredients
+        ON CONFLICT DO NOTHING;
+        ALTER TABLE ingredients DROP COLUMN stock;
+    END IF;
+END
+$$;
+
+COMMIT;